@@ -1,42 +1,144 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/di"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/infra"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
-	// パスワード、本番か検証か
+	// 設定ファイル、パスワード、本番か検証か
+	configFile := flag.String("config", "", "config file (yaml)")
 	isProd := flag.String("e", "d", "environment d(develop) or p(production)")
 	password := flag.String("p", "", "password")
+	passwordFile := flag.String("password-file", "", "file containing the password")
 	port := flag.String("port", "18082", "port")
+	quota := flag.String("quota", "", "quotas per requester. requester:category:perSecond:perMinute separated by comma (e.g. screener:info:5:100,*:order:2:0)")
+	retry := flag.Int("retry", infra.DefaultRetryPolicy.MaxAttempts, "max attempts including the first call when kabusapi returns a retry code")
+	retryCodes := flag.String("retry-codes", "4001009", "kabusapi error codes to refresh token and retry. separated by comma (e.g. 4001009,4001017)")
+	tokenReset := flag.String("token-reset", "06:30", "daily time (HH:MM) when kabu station invalidates the token")
+	tokenTZ := flag.String("token-tz", "", "timezone of -token-reset (e.g. Asia/Tokyo). local timezone if empty")
+	tlsCert := flag.String("tls-cert", "", "server certificate file. listen with TLS if specified")
+	tlsKey := flag.String("tls-key", "", "private key file of -tls-cert")
+	tlsClientCA := flag.String("tls-client-ca", "", "CA certificate file to verify client certificates. require client certificates (mTLS) if specified")
 	flag.Parse()
 
-	if *password == "" {
-		fmt.Println("-p is required")
+	// デフォルト値 < 設定ファイル < 環境変数 < コマンドライン引数 の順に上書きする
+	config := infra.DefaultConfig()
+	if *configFile != "" {
+		if err := infra.LoadConfigFile(*configFile, &config); err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	if err := infra.LoadConfigEnv(os.LookupEnv, &config); err != nil {
+		fmt.Println(err)
 		return
 	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "e":
+			config.Environment = *isProd
+		case "p":
+			config.Password = *password
+		case "password-file":
+			config.PasswordFile = *passwordFile
+		case "port":
+			config.Listen = ":" + *port
+		case "quota":
+			config.Quotas = *quota
+		case "retry":
+			config.RetryMaxAttempts = *retry
+		case "retry-codes":
+			config.RetryCodes = *retryCodes
+		case "token-reset":
+			config.TokenReset = *tokenReset
+		case "token-tz":
+			config.TokenTimezone = *tokenTZ
+		case "tls-cert":
+			config.TLSCertFile = *tlsCert
+		case "tls-key":
+			config.TLSKeyFile = *tlsKey
+		case "tls-client-ca":
+			config.TLSClientCAFile = *tlsClientCA
+		}
+	})
 
 	// 設定の初期化
-	infra.InitSetting(*isProd == "p", *password)
+	if err := infra.InitSetting(config); err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	// サーバーの起動
-	ln, err := net.Listen("tcp", ":"+*port)
+	ln, err := net.Listen("tcp", config.Listen)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	kabusServer := di.InjectedServer()
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(kabusServer.UnaryInterceptor),
+		grpc.StreamInterceptor(kabusServer.StreamInterceptor),
+	}
+	tlsConfig, err := config.TLSConfig()
 	if err != nil {
 		log.Fatalln(err)
 	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := grpc.NewServer(opts...)
+	kabuspb.RegisterKabusServiceServer(s, kabusServer)
 
-	s := grpc.NewServer()
-	kabuspb.RegisterKabusServiceServer(s, di.InjectedServer())
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- s.Serve(ln) }()
 
-	if err := s.Serve(ln); err != nil {
+	// SIGINT, SIGTERMを受けたら後片付けをしてから終了する
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serveErr:
 		log.Fatalln(err)
+	case v := <-sig:
+		log.Printf("received %s, shutting down\n", v)
+	}
+	shutdown(s, kabusServer, config.ShutdownTimeout)
+}
+
+// shutdown - 新しい呼出しを止めて、ストリームを閉じ、実行中の呼出しが終わるのを待ってから登録した銘柄を解除する
+func shutdown(s *grpc.Server, kabusServer server.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	kabusServer.CloseStreams()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Println("in-flight calls did not finish in time, stopping forcibly")
+		s.Stop()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := kabusServer.Shutdown(ctx); err != nil {
+		log.Println(err)
 	}
 }
@@ -6,28 +6,116 @@ import (
 	"gitlab.com/tsuchinaga/kabus-grpc-server/infra/security"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/infra/stores"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/infra/virtual"
-	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/services"
 	vs "gitlab.com/tsuchinaga/kabus-virtual-security"
 )
 
-func InjectedServer() kabuspb.KabusServiceServer {
+func InjectedServer() server.Server {
 	setting := infra.GetSetting()
+	tokenService := services.NewTokenService(
+		stores.GetTokenStore(),
+		security.NewSecurity(
+			kabus.NewRESTClient(setting.IsProduction())),
+		infra.NewClock(),
+		setting)
+	throttleService := services.NewThrottleService(setting)
+
+	// トークンの先行取得とkabuステーションの死活監視を始める
+	stationService := services.NewStationService(
+		tokenService,
+		security.NewSecurity(
+			kabus.NewRESTClient(setting.IsProduction())),
+		throttleService,
+		infra.NewClock())
+	stationService.Start()
+
+	clientOrderService := services.NewClientOrderService(stores.GetClientOrderStore())
+	orderEventService := services.NewOrderEventService(
+		stores.GetOrderEventStreamStore(),
+		security.NewSecurity(
+			kabus.NewRESTClient(setting.IsProduction())),
+		virtualSecurity(setting),
+		tokenService,
+		throttleService,
+		clientOrderService,
+		infra.NewClock())
+
+	// 建玉の定期的な取得を始め、約定を検知したら取得し直す
+	positionService := services.NewPositionService(
+		stores.GetPositionEventStreamStore(),
+		security.NewSecurity(
+			kabus.NewRESTClient(setting.IsProduction())),
+		virtualSecurity(setting),
+		tokenService,
+		throttleService,
+		infra.NewClock())
+	positionService.Start()
+	orderEventService.AddListener(positionService)
+
+	// 注文履歴の定期的な保存を始め、注文の変化を検知したら保存し直す
+	orderHistoryService := services.NewOrderHistoryService(
+		stores.GetOrderHistoryStore(setting.OrderHistoryFile()),
+		security.NewSecurity(
+			kabus.NewRESTClient(setting.IsProduction())),
+		virtualSecurity(setting),
+		tokenService,
+		throttleService,
+		clientOrderService,
+		infra.NewClock())
+	orderHistoryService.Start()
+	orderEventService.AddListener(orderHistoryService)
+	orderEventService.Start()
+
 	return server.NewServer(
 		security.NewSecurity(
 			kabus.NewRESTClient(setting.IsProduction())),
-		virtual.NewSecurity(vs.NewVirtualSecurity()),
-		services.NewTokenService(
-			stores.GetTokenStore(),
-			security.NewSecurity(
-				kabus.NewRESTClient(setting.IsProduction())),
-			infra.NewClock(),
-			setting),
+		virtualSecurity(setting),
+		tokenService,
 		services.NewRegisterSymbolService(
 			stores.GetRegisterSymbolStore()),
 		services.NewBoardStreamService(
 			stores.GetBoardStreamStore(),
+			stores.GetBoardStore(),
 			security.GetBoardWS(setting.IsProduction()),
-			virtual.NewSecurity(vs.NewVirtualSecurity())))
+			virtualSecurity(setting),
+			stores.GetRegisterSymbolStore(),
+			setting,
+			infra.NewClock()),
+		throttleService,
+		services.NewQuotaService(
+			infra.NewClock(),
+			setting),
+		stationService,
+		services.NewAuthService(setting),
+		services.NewRiskService(
+			security.NewSecurity(
+				kabus.NewRESTClient(setting.IsProduction())),
+			tokenService,
+			throttleService,
+			infra.NewClock(),
+			setting),
+		services.NewKillSwitchService(
+			stores.GetKillSwitchStore(setting.KillSwitchFile()),
+			security.NewSecurity(
+				kabus.NewRESTClient(setting.IsProduction())),
+			tokenService,
+			throttleService,
+			infra.NewClock()),
+		clientOrderService,
+		orderEventService,
+		positionService,
+		services.NewAuditService(
+			stores.GetAuditLogStore(setting.AuditLogFile()),
+			infra.NewClock()),
+		orderHistoryService)
+}
+
+// virtualSecurity - 仮想証券会社を使わない設定なら、全てのリクエストを拒否する仮想証券会社を返す
+func virtualSecurity(setting repositories.Setting) repositories.VirtualSecurity {
+	if !setting.IsVirtualEnabled() {
+		return virtual.NewDisabledSecurity()
+	}
+	return virtual.NewSecurity(vs.NewVirtualSecurity())
 }
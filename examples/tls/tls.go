@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"log"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func main() {
+	// クライアント証明書(サーバーが-tls-client-caを指定しているときだけ必要)
+	cert, err := tls.LoadX509KeyPair("client.pem", "client-key.pem")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	// サーバー証明書を検証するCA証明書
+	ca, err := ioutil.ReadFile("ca.pem")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		log.Fatalln("no certificates in ca.pem")
+	}
+
+	creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: pool})
+	conn, err := grpc.DialContext(context.Background(), "localhost:18082", grpc.WithTransportCredentials(creds))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	cli := kabuspb.NewKabusServiceClient(conn)
+
+	// 注文一覧
+	{
+		res, err := cli.GetOrders(context.Background(), &kabuspb.GetOrdersRequest{GetDetails: true})
+		if err != nil {
+			panic(err)
+		}
+		log.Printf("%+v\n", res)
+	}
+}
@@ -6,7 +6,9 @@ require (
 	github.com/google/uuid v1.3.0 // indirect
 	gitlab.com/tsuchinaga/go-kabusapi v1.5.1
 	gitlab.com/tsuchinaga/kabus-virtual-security v0.0.6
+	go.etcd.io/bbolt v1.3.6
 	google.golang.org/genproto v0.0.0-20210903162649-d08c68adba83 // indirect
 	google.golang.org/grpc v1.40.0
 	google.golang.org/protobuf v1.27.1
+	gopkg.in/yaml.v2 v2.4.0
 )
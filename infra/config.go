@@ -0,0 +1,335 @@
+package infra
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+// ConfigEnvPrefix - 設定を上書きする環境変数の接頭辞、KABUS_PASSWORDのように設定ファイルのキーを大文字にして付ける
+const ConfigEnvPrefix = "KABUS_"
+
+// Config - サーバーの設定、デフォルト値 < 設定ファイル(YAML) < 環境変数 < コマンドライン引数 の順に上書きする
+type Config struct {
+	Environment      string         `yaml:"environment"`        // 環境。本番がp、検証がd
+	Password         string         `yaml:"password"`           // パスワード
+	PasswordFile     string         `yaml:"password_file"`      // パスワードを書いたファイル、passwordが空のときに使う
+	Listen           string         `yaml:"listen"`             // 待ち受けるアドレス
+	Quotas           string         `yaml:"quotas"`             // ツールごとの利用枠、書式はParseQuotasを参照
+	RetryMaxAttempts int            `yaml:"retry_max_attempts"` // トークンを再発行して再実行する初回を含めた最大実行回数
+	RetryCodes       string         `yaml:"retry_codes"`        // トークンを再発行して再実行するkabusapiのエラーコード、カンマ区切り
+	TokenReset       string         `yaml:"token_reset"`        // トークンが無効になる毎日の時刻、HH:MM
+	TokenTimezone    string         `yaml:"token_timezone"`     // token_resetのタイムゾーン、空ならサーバーのタイムゾーン
+	OrderInterval    time.Duration  `yaml:"order_interval"`     // 発注系のリクエストの間隔
+	WalletInterval   time.Duration  `yaml:"wallet_interval"`    // 余力系のリクエストの間隔
+	InfoInterval     time.Duration  `yaml:"info_interval"`      // 情報系のリクエストの間隔
+	VirtualDisabled  bool           `yaml:"virtual_disabled"`   // 仮想証券会社を使わない
+	ShutdownTimeout  time.Duration  `yaml:"shutdown_timeout"`   // 終了時に実行中の呼出しを待つ最大時間、過ぎたら強制的に止める
+	TLSCertFile      string         `yaml:"tls_cert_file"`      // サーバー証明書、指定があればTLSで待ち受ける
+	TLSKeyFile       string         `yaml:"tls_key_file"`       // サーバー証明書の秘密鍵
+	TLSClientCAFile  string         `yaml:"tls_client_ca_file"` // クライアント証明書を検証するCA証明書、指定があればクライアント証明書を必須にする(mTLS)
+	Clients          []ClientConfig `yaml:"clients"`            // 接続を許可するツール、指定があれば認証を必須にする。設定ファイルでだけ指定できる
+	Risk             RiskConfig     `yaml:"risk"`               // 発注前のリスクチェック、設定ファイルでだけ指定できる
+	KillSwitchFile   string         `yaml:"kill_switch_file"`   // 緊急停止の状態を保存するファイル、再起動しても停止したままにする
+	AuditLogFile     string         `yaml:"audit_log_file"`     // 注文系の呼出しを記録する監査ログのファイル、JSON Lines形式で追記する
+	OrderHistoryFile string         `yaml:"order_history_file"` // 注文履歴を保存するデータベースのファイル
+	BoardBufferSize  int            `yaml:"board_buffer_size"`  // 板情報のストリームごとに送るのを待てる板情報の数
+	BoardOverflow    string         `yaml:"board_overflow"`     // 板情報のストリームが送り切れないときの扱い(drop_oldest, conflate, disconnect)
+}
+
+// RiskConfig - 発注前のリスクチェックの設定、0や空は制限しない
+type RiskConfig struct {
+	MaxQuantity            float64  `yaml:"max_quantity"`               // 1注文の数量の上限
+	MaxNotional            float64  `yaml:"max_notional"`               // 1注文の代金(数量×価格)の上限
+	MaxDailyNotional       float64  `yaml:"max_daily_notional"`         // 1日に発注する代金の合計の上限
+	MaxOpenOrdersPerSymbol int      `yaml:"max_open_orders_per_symbol"` // 銘柄ごとの未約定の注文数の上限
+	AllowedSymbols         []string `yaml:"allowed_symbols"`            // 発注できる銘柄コード
+	AllowedProducts        []string `yaml:"allowed_products"`           // 発注できる商品(stock, margin, future, option)
+	PriceBandPercent       float64  `yaml:"price_band_percent"`         // 指値が現値から離れてもいい割合(%)
+	PriceLimitCheck        bool     `yaml:"price_limit_check"`          // 指値が値幅制限の中にあるかを確認する
+}
+
+// ClientConfig - 接続を許可するツールの設定
+type ClientConfig struct {
+	Name        string   `yaml:"name"`         // ツール名
+	APIKey      string   `yaml:"api_key"`      // メタデータkabus-api-keyで送られるAPIキー
+	TLSSubject  string   `yaml:"tls_subject"`  // クライアント証明書のCommonName、mTLSのときに使える
+	Permissions []string `yaml:"permissions"`  // 権限、書式はParsePermissionsを参照
+	MaxPriority string   `yaml:"max_priority"` // 指定できるリクエスト優先度の上限(low, normal, high)、デフォルトはnormal
+}
+
+// DefaultConfig - 何も指定されなかったときの設定
+func DefaultConfig() Config {
+	return Config{
+		Environment:      "d",
+		Listen:           ":18082",
+		RetryMaxAttempts: DefaultRetryPolicy.MaxAttempts,
+		RetryCodes:       "4001009",
+		TokenReset:       "06:30",
+		OrderInterval:    200 * time.Millisecond,
+		WalletInterval:   100 * time.Millisecond,
+		InfoInterval:     100 * time.Millisecond,
+		ShutdownTimeout:  30 * time.Second,
+		KillSwitchFile:   "kill_switch.json",
+		AuditLogFile:     "audit.jsonl",
+		OrderHistoryFile: "order_history.db",
+		BoardBufferSize:  256,
+		BoardOverflow:    "drop_oldest",
+	}
+}
+
+// LoadConfigFile - YAMLの設定ファイルを読んで、書かれている項目だけをconfigに上書きする
+func LoadConfigFile(path string, config *Config) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(b, config); err != nil {
+		return fmt.Errorf("parse config file: %w", err)
+	}
+	return nil
+}
+
+// LoadConfigEnv - 環境変数に指定されている項目だけをconfigに上書きする
+func LoadConfigEnv(lookup func(key string) (string, bool), config *Config) error {
+	strs := map[string]*string{
+		"ENVIRONMENT":        &config.Environment,
+		"PASSWORD":           &config.Password,
+		"PASSWORD_FILE":      &config.PasswordFile,
+		"LISTEN":             &config.Listen,
+		"QUOTAS":             &config.Quotas,
+		"RETRY_CODES":        &config.RetryCodes,
+		"TOKEN_RESET":        &config.TokenReset,
+		"TOKEN_TIMEZONE":     &config.TokenTimezone,
+		"TLS_CERT_FILE":      &config.TLSCertFile,
+		"TLS_KEY_FILE":       &config.TLSKeyFile,
+		"TLS_CLIENT_CA_FILE": &config.TLSClientCAFile,
+		"KILL_SWITCH_FILE":   &config.KillSwitchFile,
+		"AUDIT_LOG_FILE":     &config.AuditLogFile,
+		"ORDER_HISTORY_FILE": &config.OrderHistoryFile,
+		"BOARD_OVERFLOW":     &config.BoardOverflow,
+	}
+	for key, p := range strs {
+		if v, ok := lookup(ConfigEnvPrefix + key); ok {
+			*p = v
+		}
+	}
+
+	if v, ok := lookup(ConfigEnvPrefix + "RETRY_MAX_ATTEMPTS"); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid %sRETRY_MAX_ATTEMPTS: %s", ConfigEnvPrefix, v)
+		}
+		config.RetryMaxAttempts = n
+	}
+
+	if v, ok := lookup(ConfigEnvPrefix + "BOARD_BUFFER_SIZE"); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid %sBOARD_BUFFER_SIZE: %s", ConfigEnvPrefix, v)
+		}
+		config.BoardBufferSize = n
+	}
+
+	durations := map[string]*time.Duration{
+		"ORDER_INTERVAL":   &config.OrderInterval,
+		"WALLET_INTERVAL":  &config.WalletInterval,
+		"INFO_INTERVAL":    &config.InfoInterval,
+		"SHUTDOWN_TIMEOUT": &config.ShutdownTimeout,
+	}
+	for key, p := range durations {
+		if v, ok := lookup(ConfigEnvPrefix + key); ok {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return fmt.Errorf("invalid %s%s: %s", ConfigEnvPrefix, key, v)
+			}
+			*p = d
+		}
+	}
+
+	if v, ok := lookup(ConfigEnvPrefix + "VIRTUAL_DISABLED"); ok {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %sVIRTUAL_DISABLED: %s", ConfigEnvPrefix, v)
+		}
+		config.VirtualDisabled = b
+	}
+	return nil
+}
+
+// toSetting - 設定を検証して、アプリケーションで使う形に変換する
+func (c Config) toSetting() (*setting, error) {
+	if c.Environment != "d" && c.Environment != "p" {
+		return nil, fmt.Errorf("invalid environment: %s", c.Environment)
+	}
+
+	password := c.Password
+	if password == "" && c.PasswordFile != "" {
+		b, err := ioutil.ReadFile(c.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("read password file: %w", err)
+		}
+		password = strings.TrimSpace(string(b))
+	}
+	if password == "" {
+		return nil, fmt.Errorf("password is required")
+	}
+
+	if c.Listen == "" {
+		return nil, fmt.Errorf("listen is required")
+	}
+
+	quotas, err := ParseQuotas(c.Quotas)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := ParseRetryCodes(c.RetryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenReset, err := ParseTokenReset(c.TokenReset, c.TokenTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.OrderInterval <= 0 || c.WalletInterval <= 0 || c.InfoInterval <= 0 {
+		return nil, fmt.Errorf("throttle intervals must be positive: order=%s, wallet=%s, info=%s", c.OrderInterval, c.WalletInterval, c.InfoInterval)
+	}
+
+	if _, err := c.TLSConfig(); err != nil {
+		return nil, err
+	}
+
+	clients, err := c.toClients()
+	if err != nil {
+		return nil, err
+	}
+
+	riskLimit, err := c.Risk.toRiskLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		return nil, fmt.Errorf("shutdown timeout must be positive: %s", c.ShutdownTimeout)
+	}
+
+	if c.KillSwitchFile == "" {
+		return nil, fmt.Errorf("kill switch file is required")
+	}
+
+	if c.AuditLogFile == "" {
+		return nil, fmt.Errorf("audit log file is required")
+	}
+
+	if c.OrderHistoryFile == "" {
+		return nil, fmt.Errorf("order history file is required")
+	}
+
+	if c.BoardBufferSize <= 0 {
+		return nil, fmt.Errorf("board buffer size must be positive: %d", c.BoardBufferSize)
+	}
+
+	boardOverflow, ok := kabuspb.BoardOverflowPolicy_value["BOARD_OVERFLOW_POLICY_"+strings.ToUpper(strings.TrimSpace(c.BoardOverflow))]
+	if !ok || boardOverflow == int32(kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_UNSPECIFIED) {
+		return nil, fmt.Errorf("invalid board overflow: %s", c.BoardOverflow)
+	}
+
+	return &setting{
+		isProd:      c.Environment == "p",
+		password:    password,
+		quotas:      quotas,
+		retryPolicy: RetryPolicy{MaxAttempts: c.RetryMaxAttempts, Codes: codes},
+		tokenReset:  tokenReset,
+		throttleIntervals: map[kabuspb.ThrottleCategory]time.Duration{
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER:  c.OrderInterval,
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: c.WalletInterval,
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:   c.InfoInterval,
+		},
+		virtualEnabled:   !c.VirtualDisabled,
+		clients:          clients,
+		riskLimit:        riskLimit,
+		killSwitchFile:   c.KillSwitchFile,
+		auditLogFile:     c.AuditLogFile,
+		orderHistoryFile: c.OrderHistoryFile,
+		boardBufferSize:  c.BoardBufferSize,
+		boardOverflow:    kabuspb.BoardOverflowPolicy(boardOverflow),
+	}, nil
+}
+
+// toClients - ツールの設定を検証して、認証に使う形に変換する
+func (c Config) toClients() ([]Client, error) {
+	res := make([]Client, 0)
+	apiKeys := map[string]bool{}
+	subjects := map[string]bool{}
+	for _, cc := range c.Clients {
+		if cc.Name == "" {
+			return nil, fmt.Errorf("client name is required")
+		}
+		if cc.APIKey == "" && cc.TLSSubject == "" {
+			return nil, fmt.Errorf("client api_key or tls_subject is required: %s", cc.Name)
+		}
+		if cc.APIKey != "" && apiKeys[cc.APIKey] {
+			return nil, fmt.Errorf("duplicate client api_key: %s", cc.Name)
+		}
+		if cc.TLSSubject != "" && subjects[cc.TLSSubject] {
+			return nil, fmt.Errorf("duplicate client tls_subject: %s", cc.Name)
+		}
+		if cc.TLSSubject != "" && c.TLSClientCAFile == "" {
+			return nil, fmt.Errorf("client tls_subject requires tls_client_ca_file: %s", cc.Name)
+		}
+		permissions, err := ParsePermissions(cc.Permissions)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, cc.Name)
+		}
+		maxPriority, err := ParsePriority(cc.MaxPriority)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, cc.Name)
+		}
+
+		if cc.APIKey != "" {
+			apiKeys[cc.APIKey] = true
+		}
+		if cc.TLSSubject != "" {
+			subjects[cc.TLSSubject] = true
+		}
+		res = append(res, Client{Name: cc.Name, APIKey: cc.APIKey, TLSSubject: cc.TLSSubject, Permissions: permissions, MaxPriority: maxPriority})
+	}
+	return res, nil
+}
+
+// toRiskLimit - リスクチェックの設定を検証して、リスクチェックで使う形に変換する
+func (c RiskConfig) toRiskLimit() (repositories.RiskLimit, error) {
+	if c.MaxQuantity < 0 || c.MaxNotional < 0 || c.MaxDailyNotional < 0 || c.MaxOpenOrdersPerSymbol < 0 || c.PriceBandPercent < 0 {
+		return repositories.RiskLimit{}, fmt.Errorf("risk limits must not be negative")
+	}
+
+	products := make([]kabuspb.Product, 0, len(c.AllowedProducts))
+	for _, s := range c.AllowedProducts {
+		product, ok := kabuspb.Product_value["PRODUCT_"+strings.ToUpper(strings.TrimSpace(s))]
+		if !ok || product == int32(kabuspb.Product_PRODUCT_UNSPECIFIED) || product == int32(kabuspb.Product_PRODUCT_ALL) {
+			return repositories.RiskLimit{}, fmt.Errorf("invalid risk allowed product: %s", s)
+		}
+		products = append(products, kabuspb.Product(product))
+	}
+
+	return repositories.RiskLimit{
+		MaxQuantity:            c.MaxQuantity,
+		MaxNotional:            c.MaxNotional,
+		MaxDailyNotional:       c.MaxDailyNotional,
+		MaxOpenOrdersPerSymbol: c.MaxOpenOrdersPerSymbol,
+		AllowedSymbols:         c.AllowedSymbols,
+		AllowedProducts:        products,
+		PriceBandPercent:       c.PriceBandPercent,
+		PriceLimitCheck:        c.PriceLimitCheck,
+	}, nil
+}
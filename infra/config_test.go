@@ -0,0 +1,265 @@
+package infra
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func Test_DefaultConfig(t *testing.T) {
+	t.Parallel()
+	want := Config{
+		Environment:      "d",
+		Listen:           ":18082",
+		RetryMaxAttempts: 2,
+		RetryCodes:       "4001009",
+		TokenReset:       "06:30",
+		OrderInterval:    200 * time.Millisecond,
+		WalletInterval:   100 * time.Millisecond,
+		InfoInterval:     100 * time.Millisecond,
+		ShutdownTimeout:  30 * time.Second,
+		KillSwitchFile:   "kill_switch.json",
+		AuditLogFile:     "audit.jsonl",
+		OrderHistoryFile: "order_history.db",
+		BoardBufferSize:  256,
+		BoardOverflow:    "drop_oldest",
+	}
+	got := DefaultConfig()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_LoadConfigFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		body     string
+		want     Config
+		hasError bool
+	}{
+		{name: "書かれている項目だけを上書きする",
+			body: "environment: p\npassword_file: /etc/kabus/password\nlisten: 127.0.0.1:18083\norder_interval: 250ms\nvirtual_disabled: true\nshutdown_timeout: 10s\nclients:\n  - name: screener\n    api_key: API_KEY\n    permissions: [market_data]\n",
+			want: func() Config {
+				c := DefaultConfig()
+				c.Environment = "p"
+				c.PasswordFile = "/etc/kabus/password"
+				c.Listen = "127.0.0.1:18083"
+				c.OrderInterval = 250 * time.Millisecond
+				c.VirtualDisabled = true
+				c.ShutdownTimeout = 10 * time.Second
+				c.Clients = []ClientConfig{{Name: "screener", APIKey: "API_KEY", Permissions: []string{"market_data"}}}
+				return c
+			}()},
+		{name: "未知の項目があればエラー", body: "foo: bar\n", want: DefaultConfig(), hasError: true},
+		{name: "YAMLとして不正ならエラー", body: "environment: [p\n", want: DefaultConfig(), hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := ioutil.WriteFile(path, []byte(test.body), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			got := DefaultConfig()
+			err := LoadConfigFile(path, &got)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_LoadConfigFile_notFound(t *testing.T) {
+	t.Parallel()
+	got := DefaultConfig()
+	if err := LoadConfigFile(filepath.Join(t.TempDir(), "not_found.yaml"), &got); err == nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "error", err)
+	}
+}
+
+func Test_LoadConfigEnv(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		env      map[string]string
+		want     Config
+		hasError bool
+	}{
+		{name: "環境変数がなければ何も変えない", env: map[string]string{}, want: DefaultConfig()},
+		{name: "指定されている項目だけを上書きする",
+			env: map[string]string{
+				"KABUS_ENVIRONMENT":        "p",
+				"KABUS_PASSWORD":           "Password1234",
+				"KABUS_QUOTAS":             "*:order:2:0",
+				"KABUS_RETRY_MAX_ATTEMPTS": "3",
+				"KABUS_TOKEN_TIMEZONE":     "Asia/Tokyo",
+				"KABUS_INFO_INTERVAL":      "150ms",
+				"KABUS_VIRTUAL_DISABLED":   "true",
+				"KABUS_SHUTDOWN_TIMEOUT":   "10s",
+				"KABUS_KILL_SWITCH_FILE":   "/var/lib/kabus/kill_switch.json",
+				"KABUS_AUDIT_LOG_FILE":     "/var/lib/kabus/audit.jsonl",
+				"KABUS_ORDER_HISTORY_FILE": "/var/lib/kabus/order_history.db",
+				"KABUS_BOARD_BUFFER_SIZE":  "1024",
+				"KABUS_BOARD_OVERFLOW":     "conflate",
+			},
+			want: func() Config {
+				c := DefaultConfig()
+				c.Environment = "p"
+				c.Password = "Password1234"
+				c.Quotas = "*:order:2:0"
+				c.RetryMaxAttempts = 3
+				c.TokenTimezone = "Asia/Tokyo"
+				c.InfoInterval = 150 * time.Millisecond
+				c.VirtualDisabled = true
+				c.ShutdownTimeout = 10 * time.Second
+				c.KillSwitchFile = "/var/lib/kabus/kill_switch.json"
+				c.AuditLogFile = "/var/lib/kabus/audit.jsonl"
+				c.OrderHistoryFile = "/var/lib/kabus/order_history.db"
+				c.BoardBufferSize = 1024
+				c.BoardOverflow = "conflate"
+				return c
+			}()},
+		{name: "最大実行回数が数値でなければエラー", env: map[string]string{"KABUS_RETRY_MAX_ATTEMPTS": "foo"}, want: DefaultConfig(), hasError: true},
+		{name: "間隔が時間でなければエラー", env: map[string]string{"KABUS_ORDER_INTERVAL": "200"}, want: DefaultConfig(), hasError: true},
+		{name: "板情報を待てる数が数値でなければエラー", env: map[string]string{"KABUS_BOARD_BUFFER_SIZE": "foo"}, want: DefaultConfig(), hasError: true},
+		{name: "仮想証券会社を使わないかが真偽値でなければエラー", env: map[string]string{"KABUS_VIRTUAL_DISABLED": "foo"}, want: DefaultConfig(), hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lookup := func(key string) (string, bool) {
+				v, ok := test.env[key]
+				return v, ok
+			}
+
+			got := DefaultConfig()
+			err := LoadConfigEnv(lookup, &got)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_Config_toSetting(t *testing.T) {
+	t.Parallel()
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := ioutil.WriteFile(passwordFile, []byte("FilePassword1234\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(f func(c *Config)) Config {
+		c := DefaultConfig()
+		c.Password = "Password1234"
+		f(&c)
+		return c
+	}
+	intervals := map[kabuspb.ThrottleCategory]time.Duration{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER:  200 * time.Millisecond,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: 100 * time.Millisecond,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:   100 * time.Millisecond,
+	}
+
+	noRiskLimit := repositories.RiskLimit{AllowedProducts: []kabuspb.Product{}}
+
+	tests := []struct {
+		name     string
+		config   Config
+		want     *setting
+		hasError bool
+	}{
+		{name: "デフォルト値とパスワードだけで作れる",
+			config: config(func(*Config) {}),
+			want: &setting{password: "Password1234", quotas: []Quota{}, retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+				tokenReset: TokenReset{Hour: 6, Minute: 30}, throttleIntervals: intervals, virtualEnabled: true, clients: []Client{}, riskLimit: noRiskLimit, killSwitchFile: "kill_switch.json", auditLogFile: "audit.jsonl", orderHistoryFile: "order_history.db",
+				boardBufferSize: 256, boardOverflow: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST}},
+		{name: "パスワードがなければパスワードファイルから読む",
+			config: config(func(c *Config) {
+				c.Password = ""
+				c.PasswordFile = passwordFile
+				c.Environment = "p"
+				c.VirtualDisabled = true
+			}),
+			want: &setting{isProd: true, password: "FilePassword1234", quotas: []Quota{}, retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+				tokenReset: TokenReset{Hour: 6, Minute: 30}, throttleIntervals: intervals, virtualEnabled: false, clients: []Client{}, riskLimit: noRiskLimit, killSwitchFile: "kill_switch.json", auditLogFile: "audit.jsonl", orderHistoryFile: "order_history.db",
+				boardBufferSize: 256, boardOverflow: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST}},
+		{name: "板情報を送り切れないときの扱いを解釈する",
+			config: config(func(c *Config) { c.BoardOverflow = "Disconnect" }),
+			want: &setting{password: "Password1234", quotas: []Quota{}, retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+				tokenReset: TokenReset{Hour: 6, Minute: 30}, throttleIntervals: intervals, virtualEnabled: true, clients: []Client{}, riskLimit: noRiskLimit, killSwitchFile: "kill_switch.json", auditLogFile: "audit.jsonl", orderHistoryFile: "order_history.db",
+				boardBufferSize: 256, boardOverflow: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT}},
+		{name: "リスクチェックの商品を解釈する",
+			config: config(func(c *Config) {
+				c.Risk = RiskConfig{MaxQuantity: 100, AllowedSymbols: []string{"1320"}, AllowedProducts: []string{"stock", "Margin"}, PriceLimitCheck: true}
+			}),
+			want: &setting{password: "Password1234", quotas: []Quota{}, retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+				tokenReset: TokenReset{Hour: 6, Minute: 30}, throttleIntervals: intervals, virtualEnabled: true, clients: []Client{},
+				riskLimit:      repositories.RiskLimit{MaxQuantity: 100, AllowedSymbols: []string{"1320"}, AllowedProducts: []kabuspb.Product{kabuspb.Product_PRODUCT_STOCK, kabuspb.Product_PRODUCT_MARGIN}, PriceLimitCheck: true},
+				killSwitchFile: "kill_switch.json", auditLogFile: "audit.jsonl", orderHistoryFile: "order_history.db",
+				boardBufferSize: 256, boardOverflow: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST}},
+		{name: "ツールの権限を解釈する",
+			config: config(func(c *Config) {
+				c.Clients = []ClientConfig{{Name: "screener", APIKey: "API_KEY", Permissions: []string{"market_data", "virtual_trading"}}}
+			}),
+			want: &setting{password: "Password1234", quotas: []Quota{}, retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+				tokenReset: TokenReset{Hour: 6, Minute: 30}, throttleIntervals: intervals, virtualEnabled: true,
+				clients: []Client{{Name: "screener", APIKey: "API_KEY", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA, kabuspb.Permission_PERMISSION_VIRTUAL_TRADING},
+					MaxPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL}},
+				riskLimit: noRiskLimit, killSwitchFile: "kill_switch.json", auditLogFile: "audit.jsonl", orderHistoryFile: "order_history.db",
+				boardBufferSize: 256, boardOverflow: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST}},
+		{name: "環境が不正ならエラー", config: config(func(c *Config) { c.Environment = "x" }), hasError: true},
+		{name: "パスワードがなければエラー", config: config(func(c *Config) { c.Password = "" }), hasError: true},
+		{name: "パスワードファイルが読めなければエラー", config: config(func(c *Config) { c.Password = ""; c.PasswordFile = passwordFile + ".not_found" }), hasError: true},
+		{name: "待ち受けるアドレスがなければエラー", config: config(func(c *Config) { c.Listen = "" }), hasError: true},
+		{name: "利用枠が不正ならエラー", config: config(func(c *Config) { c.Quotas = "foo" }), hasError: true},
+		{name: "再実行するエラーコードが不正ならエラー", config: config(func(c *Config) { c.RetryCodes = "foo" }), hasError: true},
+		{name: "トークンが無効になる時刻が不正ならエラー", config: config(func(c *Config) { c.TokenReset = "foo" }), hasError: true},
+		{name: "リクエストの間隔が0ならエラー", config: config(func(c *Config) { c.WalletInterval = 0 }), hasError: true},
+		{name: "TLSの秘密鍵がなければエラー", config: config(func(c *Config) { c.TLSCertFile = passwordFile }), hasError: true},
+		{name: "ツール名がなければエラー", config: config(func(c *Config) { c.Clients = []ClientConfig{{APIKey: "API_KEY", Permissions: []string{"all"}}} }), hasError: true},
+		{name: "ツールにAPIキーもCommonNameもなければエラー", config: config(func(c *Config) { c.Clients = []ClientConfig{{Name: "screener", Permissions: []string{"all"}}} }), hasError: true},
+		{name: "ツールのAPIキーが重複していればエラー", config: config(func(c *Config) {
+			c.Clients = []ClientConfig{{Name: "a", APIKey: "API_KEY", Permissions: []string{"all"}}, {Name: "b", APIKey: "API_KEY", Permissions: []string{"all"}}}
+		}), hasError: true},
+		{name: "クライアント証明書のCAがないのにCommonNameを指定したらエラー", config: config(func(c *Config) {
+			c.Clients = []ClientConfig{{Name: "trader", TLSSubject: "trader", Permissions: []string{"all"}}}
+		}), hasError: true},
+		{name: "ツールの権限が不正ならエラー", config: config(func(c *Config) {
+			c.Clients = []ClientConfig{{Name: "screener", APIKey: "API_KEY", Permissions: []string{"foo"}}}
+		}), hasError: true},
+		{name: "ツールのリクエスト優先度の上限が不正ならエラー", config: config(func(c *Config) {
+			c.Clients = []ClientConfig{{Name: "screener", APIKey: "API_KEY", Permissions: []string{"all"}, MaxPriority: "foo"}}
+		}), hasError: true},
+		{name: "リスクチェックの上限が負ならエラー", config: config(func(c *Config) { c.Risk.MaxNotional = -1 }), hasError: true},
+		{name: "リスクチェックの商品が不正ならエラー", config: config(func(c *Config) { c.Risk.AllowedProducts = []string{"all"} }), hasError: true},
+		{name: "終了を待つ時間が0ならエラー", config: config(func(c *Config) { c.ShutdownTimeout = 0 }), hasError: true},
+		{name: "緊急停止の状態を保存するファイルがなければエラー", config: config(func(c *Config) { c.KillSwitchFile = "" }), hasError: true},
+		{name: "監査ログのファイルがなければエラー", config: config(func(c *Config) { c.AuditLogFile = "" }), hasError: true},
+		{name: "注文履歴のファイルがなければエラー", config: config(func(c *Config) { c.OrderHistoryFile = "" }), hasError: true},
+		{name: "板情報を待てる数が0ならエラー", config: config(func(c *Config) { c.BoardBufferSize = 0 }), hasError: true},
+		{name: "板情報を送り切れないときの扱いが不正ならエラー", config: config(func(c *Config) { c.BoardOverflow = "foo" }), hasError: true},
+		{name: "板情報を送り切れないときの扱いが未指定ならエラー", config: config(func(c *Config) { c.BoardOverflow = "unspecified" }), hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := test.config.toSetting()
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
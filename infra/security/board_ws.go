@@ -1,6 +1,7 @@
 package security
 
 import (
+	"errors"
 	"sync"
 
 	"gitlab.com/tsuchinaga/go-kabusapi/kabus"
@@ -24,6 +25,9 @@ func GetBoardWS(isProduction bool) repositories.BoardWS {
 	return boardWSSingleton
 }
 
+// errEmptyPriceMessage - kabuステーションが再起動するとwebsocketがEOFのまま空の板情報を受け取り続けるので、切断として扱うためのエラー
+var errEmptyPriceMessage = errors.New("received an empty price message, the websocket seems to be closed")
+
 type boardWS struct {
 	ws     kabus.WSRequester
 	onNext func(board *kabuspb.Board) error
@@ -40,10 +44,19 @@ func (s *boardWS) Connect(onNext func(board *kabuspb.Board) error) error {
 	s.onNext = onNext
 	s.ws.SetOnNext(s.callOnNext)
 
-	return s.ws.Open()
+	err := s.ws.Open()
+	// エラーで切断されると開いたままの扱いになって再接続できないので、閉じたことにしておく
+	if err != nil && s.ws.IsOpened() {
+		_ = s.ws.Close()
+	}
+	return err
 }
 
+// callOnNext - 銘柄コードのない空の板情報を受け取ったら、エラーを返してOpenを終わらせる
 func (s *boardWS) callOnNext(message kabus.PriceMessage) error {
+	if message.Symbol == "" {
+		return errEmptyPriceMessage
+	}
 	return s.onNext(fromPriceMessage(message))
 }
 
@@ -16,14 +16,23 @@ type testWSRequester struct {
 	Foo           string
 	isOpened      bool
 	open          error
+	openedOnOpen  bool
 	close         error
 	callSetOnNext int
+	callClose     int
 }
 
 func (t *testWSRequester) SetOnNext(func(kabus.PriceMessage) error) { t.callSetOnNext++ }
 func (t *testWSRequester) IsOpened() bool                           { return t.isOpened }
-func (t *testWSRequester) Open() error                              { return t.open }
-func (t *testWSRequester) Close() error                             { return t.close }
+func (t *testWSRequester) Open() error {
+	t.isOpened = t.openedOnOpen
+	return t.open
+}
+func (t *testWSRequester) Close() error {
+	t.callClose++
+	t.isOpened = false
+	return t.close
+}
 
 func Test_GetBoardWS(t *testing.T) {
 	t.Parallel()
@@ -107,11 +116,13 @@ func Test_boardWS_Connect(t *testing.T) {
 		name            string
 		ws              *testWSRequester
 		isCallSetOnNext bool
+		isCallClose     bool
 		hasError        bool
 	}{
 		{name: "isOpenedがtrueなら何もせずnilを返す", ws: &testWSRequester{isOpened: true}, hasError: false},
 		{name: "isOpenがfalseならSetOnNextしてからopenし、openがnilならnil", ws: &testWSRequester{}, isCallSetOnNext: true, hasError: false},
 		{name: "openがerrorならerrorを返す", ws: &testWSRequester{open: errors.New("error message")}, isCallSetOnNext: true, hasError: true},
+		{name: "openがerrorで開いたままならcloseしてからerrorを返す", ws: &testWSRequester{open: errors.New("error message"), openedOnOpen: true}, isCallSetOnNext: true, isCallClose: true, hasError: true},
 	}
 
 	for _, test := range tests {
@@ -120,8 +131,8 @@ func Test_boardWS_Connect(t *testing.T) {
 			t.Parallel()
 			ws := &boardWS{ws: test.ws}
 			got := ws.Connect(nil)
-			if (got != nil) != test.hasError || (test.ws.callSetOnNext > 0) != test.isCallSetOnNext {
-				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.hasError, test.isCallSetOnNext, got, test.ws.callSetOnNext)
+			if (got != nil) != test.hasError || (test.ws.callSetOnNext > 0) != test.isCallSetOnNext || (test.ws.callClose > 0) != test.isCallClose {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.hasError, test.isCallSetOnNext, test.isCallClose, got, test.ws.callSetOnNext, test.ws.callClose)
 			}
 		})
 	}
@@ -131,11 +142,13 @@ func Test_boardWS_callOnNext(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name     string
+		message  kabus.PriceMessage
 		onNext   func(board *kabuspb.Board) error
 		hasError bool
 	}{
-		{name: "onNextがerrorを返したらerrorあり", onNext: func(*kabuspb.Board) error { return errors.New("error message") }, hasError: true},
-		{name: "onNextがnilを返したらerrorなし", onNext: func(*kabuspb.Board) error { return nil }, hasError: false},
+		{name: "onNextがerrorを返したらerrorあり", message: kabus.PriceMessage{Symbol: "1320"}, onNext: func(*kabuspb.Board) error { return errors.New("error message") }, hasError: true},
+		{name: "onNextがnilを返したらerrorなし", message: kabus.PriceMessage{Symbol: "1320"}, onNext: func(*kabuspb.Board) error { return nil }, hasError: false},
+		{name: "EOFで届く空の板情報ならonNextを呼ばずにerrorあり", message: kabus.PriceMessage{}, onNext: func(*kabuspb.Board) error { panic("onNext is called") }, hasError: true},
 	}
 
 	for _, test := range tests {
@@ -143,7 +156,7 @@ func Test_boardWS_callOnNext(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			ws := &boardWS{onNext: test.onNext}
-			got := ws.callOnNext(kabus.PriceMessage{})
+			got := ws.callOnNext(test.message)
 			if (got != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.hasError, got)
 			}
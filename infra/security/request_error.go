@@ -0,0 +1,61 @@
+package security
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+// requestErrorKind - kabusapiのエラーの理由とgRPCのステータスコードの組
+type requestErrorKind struct {
+	reason kabuspb.RequestErrorReason
+	code   codes.Code
+}
+
+// requestErrorCatalog - 既知のkabusapiのエラーコードの分類
+var requestErrorCatalog = map[int]requestErrorKind{
+	47:      {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_ALREADY_CANCELED, code: codes.FailedPrecondition}, // 該当注文は既に取消済です
+	4001001: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable},             // 内部エラー
+	4001002: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable},             // 内部エラー
+	4001003: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable},             // トークン取得失敗
+	4001005: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER, code: codes.InvalidArgument},   // パラメータ変換エラー
+	4001006: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER, code: codes.InvalidArgument},   // 引数エラー
+	4001007: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_LOGIN_FAILED, code: codes.Unauthenticated},        // ログイン認証エラー
+	4001009: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_API_KEY_MISMATCH, code: codes.Unauthenticated},    // APIキー不一致
+	4001013: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable},             // トークン取得失敗 ※kabuステーションにログインしていない
+	4001017: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_TOO_MANY_REQUESTS, code: codes.ResourceExhausted}, // 要求超過
+	4002001: {reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_SYMBOL, code: codes.NotFound},             // 銘柄が見つからない
+}
+
+// orderResultCodeLimit - これより小さいエラーコードは発注・取消の結果コード
+const orderResultCodeLimit = 1000000
+
+// classifyRequestError - kabusapiのエラーを分類する、既知のエラーコードでなければ、発注・取消なら注文の結果コードか、HTTPステータスから判断する
+func classifyRequestError(statusCode int, code int, isOrder bool) requestErrorKind {
+	if kind, ok := requestErrorCatalog[code]; ok {
+		return kind
+	}
+	// 注文の結果コードは発注先ごとに番号が変わるので、理由までは分けずに注文の受付拒否にする
+	if isOrder && 0 < code && code < orderResultCodeLimit {
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_ORDER_REJECTED, code: codes.FailedPrecondition}
+	}
+
+	switch statusCode {
+	case http.StatusBadRequest:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER, code: codes.InvalidArgument}
+	case http.StatusUnauthorized:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.Unauthenticated}
+	case http.StatusForbidden:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.PermissionDenied}
+	case http.StatusNotFound:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_NOT_FOUND, code: codes.NotFound}
+	case http.StatusTooManyRequests:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_TOO_MANY_REQUESTS, code: codes.ResourceExhausted}
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable}
+	default:
+		return requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.Internal}
+	}
+}
@@ -0,0 +1,63 @@
+package security
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_classifyRequestError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+		code       int
+		isOrder    bool
+		want       requestErrorKind
+	}{
+		{name: "既知のエラーコードならカタログの分類を返す", statusCode: 401, code: 4001009,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_API_KEY_MISMATCH, code: codes.Unauthenticated}},
+		{name: "既知の注文の結果コードならカタログの分類を返す", statusCode: 500, code: 47, isOrder: true,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_ALREADY_CANCELED, code: codes.FailedPrecondition}},
+		{name: "銘柄が見つからなければ銘柄の誤り", statusCode: 404, code: 4002001,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_SYMBOL, code: codes.NotFound}},
+		{name: "kabuステーションの内部エラーならUnavailable", statusCode: 500, code: 4001001,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable}},
+		{name: "kabuステーションにログインしていなければUnavailable", statusCode: 500, code: 4001013,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable}},
+		{name: "要求超過ならResourceExhausted", statusCode: 400, code: 4001017,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_TOO_MANY_REQUESTS, code: codes.ResourceExhausted}},
+		{name: "発注・取消の未知の注文の結果コードなら注文の受付拒否", statusCode: 500, code: 8, isOrder: true,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_ORDER_REJECTED, code: codes.FailedPrecondition}},
+		{name: "発注・取消以外なら小さいエラーコードでも注文の受付拒否にせずHTTPステータスから判断する", statusCode: 400, code: 8,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER, code: codes.InvalidArgument}},
+		{name: "未知のエラーコードで400ならパラメータ不正", statusCode: 400, code: 4001999,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER, code: codes.InvalidArgument}},
+		{name: "未知のエラーコードで401ならUnauthenticated", statusCode: 401, code: 4001999,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.Unauthenticated}},
+		{name: "未知のエラーコードで403ならPermissionDenied", statusCode: 403, code: 4001999,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.PermissionDenied}},
+		{name: "未知のエラーコードで404なら対象が見つからない", statusCode: 404, code: 4002999,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_NOT_FOUND, code: codes.NotFound}},
+		{name: "429なら流量制限超過", statusCode: 429, code: 0,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_TOO_MANY_REQUESTS, code: codes.ResourceExhausted}},
+		{name: "503ならkabuステーションが利用できない", statusCode: 503, code: 0,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE, code: codes.Unavailable}},
+		{name: "500で未知のエラーコードなら未分類", statusCode: 500, code: 5001999,
+			want: requestErrorKind{reason: kabuspb.RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED, code: codes.Internal}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := classifyRequestError(test.statusCode, test.code, test.isOrder)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"time"
 
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -306,7 +305,7 @@ func (s *security) IndustryRanking(ctx context.Context, token string, req *kabus
 func (s *security) SendOrderStock(ctx context.Context, token string, req *kabuspb.SendStockOrderRequest) (*kabuspb.OrderResponse, error) {
 	res, err := s.restClient.SendOrderStockWithContext(ctx, token, toSendOrderStockRequestFromSendStockOrderRequest(req))
 	if err != nil {
-		return nil, s.toRequestError(err)
+		return nil, s.toOrderRequestError(err)
 	}
 	return &kabuspb.OrderResponse{ResultCode: int32(res.Result), OrderId: res.OrderID}, nil
 }
@@ -314,7 +313,7 @@ func (s *security) SendOrderStock(ctx context.Context, token string, req *kabusp
 func (s *security) SendOrderMargin(ctx context.Context, token string, req *kabuspb.SendMarginOrderRequest) (*kabuspb.OrderResponse, error) {
 	res, err := s.restClient.SendOrderStockWithContext(ctx, token, toSendOrderStockRequestFromSendMarginOrderRequest(req))
 	if err != nil {
-		return nil, s.toRequestError(err)
+		return nil, s.toOrderRequestError(err)
 	}
 	return &kabuspb.OrderResponse{ResultCode: int32(res.Result), OrderId: res.OrderID}, nil
 }
@@ -322,7 +321,7 @@ func (s *security) SendOrderMargin(ctx context.Context, token string, req *kabus
 func (s *security) SendOrderFuture(ctx context.Context, token string, req *kabuspb.SendFutureOrderRequest) (*kabuspb.OrderResponse, error) {
 	res, err := s.restClient.SendOrderFutureWithContext(ctx, token, toSendOrderFutureRequest(req))
 	if err != nil {
-		return nil, s.toRequestError(err)
+		return nil, s.toOrderRequestError(err)
 	}
 	return &kabuspb.OrderResponse{ResultCode: int32(res.Result), OrderId: res.OrderID}, nil
 }
@@ -330,7 +329,7 @@ func (s *security) SendOrderFuture(ctx context.Context, token string, req *kabus
 func (s *security) SendOrderOption(ctx context.Context, token string, req *kabuspb.SendOptionOrderRequest) (*kabuspb.OrderResponse, error) {
 	res, err := s.restClient.SendOrderOptionWithContext(ctx, token, toSendOrderOptionRequest(req))
 	if err != nil {
-		return nil, s.toRequestError(err)
+		return nil, s.toOrderRequestError(err)
 	}
 	return &kabuspb.OrderResponse{ResultCode: int32(res.Result), OrderId: res.OrderID}, nil
 }
@@ -338,7 +337,7 @@ func (s *security) SendOrderOption(ctx context.Context, token string, req *kabus
 func (s *security) CancelOrder(ctx context.Context, token string, req *kabuspb.CancelOrderRequest) (*kabuspb.OrderResponse, error) {
 	res, err := s.restClient.CancelOrderWithContext(ctx, token, toCancelOrderRequest(req))
 	if err != nil {
-		return nil, s.toRequestError(err)
+		return nil, s.toOrderRequestError(err)
 	}
 	return &kabuspb.OrderResponse{ResultCode: int32(res.Result), OrderId: res.OrderID}, nil
 }
@@ -483,32 +482,41 @@ func (s *security) MarginPremium(ctx context.Context, token string, req *kabuspb
 
 // toRequestError - エラーコードをprotobuf定義のエラーに変えれるなら変えて返す、変えれないならそのまま返す
 func (s *security) toRequestError(err error) error {
+	return s.newRequestError(err, false)
+}
+
+// toOrderRequestError - 発注・取消のエラーを、注文の結果コードも見てprotobuf定義のエラーに変える
+func (s *security) toOrderRequestError(err error) error {
+	return s.newRequestError(err, true)
+}
+
+func (s *security) newRequestError(err error, isOrder bool) error {
 	switch e := err.(type) {
 	case kabus.ErrorResponse:
-		st := status.New(codes.Internal, e.Message)
+		kind := classifyRequestError(e.StatusCode, e.Code, isOrder)
+		st := status.New(kind.code, e.Message)
 		dt, _ := st.WithDetails(&kabuspb.RequestError{
 			StatusCode: int32(e.StatusCode),
 			Body:       e.Body,
 			Code:       int32(e.Code),
 			Message:    e.Message,
+			Reason:     kind.reason,
 		})
 		return dt.Err()
 	}
 	return err
 }
 
-// IsMissMatchApiKeyError - 引数のエラーがAPIキー不一致エラーかを返す
-func (s *security) IsMissMatchApiKeyError(err error) bool {
+// ErrorCode - 引数のエラーがkabusapiのエラーならエラーコードを返す、kabusapiのエラーでなければ0を返す
+func (s *security) ErrorCode(err error) int {
 	if st, ok := status.FromError(err); ok { // grpcのエラーならハンドリング処理に入る
 		// 詳細をループする
 		for _, d := range st.Details() {
 			switch e := d.(type) {
 			case *kabuspb.RequestError:
-				if e.Code == 4001009 { // 4001009: APIキー不一致
-					return true
-				}
+				return int(e.Code)
 			}
 		}
 	}
-	return false
+	return 0
 }
@@ -1552,7 +1552,10 @@ func Test_security_toRequestError(t *testing.T) {
 			want1: "custom error"},
 		{name: "ErrorResponse型が返されたら、詰めて返す",
 			arg1:  kabus.ErrorResponse{StatusCode: 500, Body: `{"Code":47,"Message":"該当注文は既に取消済です"}`, Code: 47, Message: "該当注文は既に取消済です"},
-			want1: `rpc error: code = Internal desc = 該当注文は既に取消済です`},
+			want1: `rpc error: code = FailedPrecondition desc = 該当注文は既に取消済です`},
+		{name: "未知のエラーコードでHTTPステータスからも判断できなければInternalで返す",
+			arg1:  kabus.ErrorResponse{StatusCode: 500, Body: `{"Code":5001999,"Message":"内部エラー"}`, Code: 5001999, Message: "内部エラー"},
+			want1: `rpc error: code = Internal desc = 内部エラー`},
 	}
 
 	for _, test := range tests {
@@ -1568,7 +1571,35 @@ func Test_security_toRequestError(t *testing.T) {
 	}
 }
 
-func Test_security_IsMissMatchApiKeyError(t *testing.T) {
+func Test_security_toOrderRequestError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		arg1  error
+		want1 string
+	}{
+		{name: "パース対象でない型が返されたら、そのまま返す",
+			arg1:  errors.New("custom error"),
+			want1: "custom error"},
+		{name: "注文の結果コードならFailedPreconditionで返す",
+			arg1:  kabus.ErrorResponse{StatusCode: 500, Body: `{"Code":8,"Message":"発注できません"}`, Code: 8, Message: "発注できません"},
+			want1: `rpc error: code = FailedPrecondition desc = 発注できません`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			security := &security{}
+			got1 := security.toOrderRequestError(test.arg1).Error()
+			if !reflect.DeepEqual(test.want1, got1) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want1, got1)
+			}
+		})
+	}
+}
+
+func Test_security_ErrorCode(t *testing.T) {
 	t.Parallel()
 
 	st := status.New(codes.Internal, "エラーメッセージ")
@@ -1590,23 +1621,23 @@ func Test_security_IsMissMatchApiKeyError(t *testing.T) {
 	tests := []struct {
 		name  string
 		arg1  error
-		want1 bool
+		want1 int
 	}{
-		{name: "grpcのエラーでなければfalse",
+		{name: "grpcのエラーでなければ0",
 			arg1:  errors.New("テストエラー"),
-			want1: false},
-		{name: "grpcのエラーでも詳細がなければfalse",
+			want1: 0},
+		{name: "grpcのエラーでも詳細がなければ0",
 			arg1:  status.New(codes.Internal, "エラーメッセージ").Err(),
-			want1: false},
-		{name: "grpcのエラーの詳細でも、RequestErrorタイプでなければfalse",
+			want1: 0},
+		{name: "grpcのエラーの詳細でも、RequestErrorタイプでなければ0",
 			arg1:  st1.Err(),
-			want1: false},
-		{name: "grpcのエラーの詳細でRequestErrorタイプでも、APIキー不一致エラーでなければfalse",
+			want1: 0},
+		{name: "grpcのエラーの詳細でRequestErrorタイプならエラーコードを返す",
 			arg1:  st2.Err(),
-			want1: false},
-		{name: "grpcのエラーの詳細でRequestErrorタイプでAPIキー不一致エラーが含まれていればtrue",
+			want1: 4001007},
+		{name: "grpcのエラーの詳細でRequestErrorタイプでAPIキー不一致エラーならAPIキー不一致のエラーコードを返す",
 			arg1:  st3.Err(),
-			want1: true},
+			want1: 4001009},
 	}
 
 	for _, test := range tests {
@@ -1614,7 +1645,7 @@ func Test_security_IsMissMatchApiKeyError(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			security := &security{}
-			got1 := security.IsMissMatchApiKeyError(test.arg1)
+			got1 := security.ErrorCode(test.arg1)
 			if !reflect.DeepEqual(test.want1, got1) {
 				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want1, got1)
 			}
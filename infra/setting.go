@@ -1,8 +1,14 @@
 package infra
 
 import (
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
 )
 
@@ -11,11 +17,18 @@ var (
 	settingSingletonMutex sync.Mutex
 )
 
-func InitSetting(isProd bool, password string) {
+// InitSetting - 設定を検証して初期化する、不正な設定ならエラーを返す
+func InitSetting(config Config) error {
+	s, err := config.toSetting()
+	if err != nil {
+		return err
+	}
+
 	settingSingletonMutex.Lock()
 	defer settingSingletonMutex.Unlock()
 
-	settingSingleton = &setting{isProd: isProd, password: password}
+	settingSingleton = s
+	return nil
 }
 
 func GetSetting() repositories.Setting {
@@ -26,8 +39,21 @@ func GetSetting() repositories.Setting {
 }
 
 type setting struct {
-	isProd   bool
-	password string
+	isProd      bool
+	password    string
+	quotas      []Quota
+	retryPolicy RetryPolicy
+	tokenReset  TokenReset
+
+	throttleIntervals map[kabuspb.ThrottleCategory]time.Duration
+	virtualEnabled    bool
+	clients           []Client
+	riskLimit         repositories.RiskLimit
+	killSwitchFile    string
+	auditLogFile      string
+	orderHistoryFile  string
+	boardBufferSize   int
+	boardOverflow     kabuspb.BoardOverflowPolicy
 }
 
 func (s *setting) IsProduction() bool {
@@ -37,3 +63,243 @@ func (s *setting) IsProduction() bool {
 func (s *setting) Password() string {
 	return s.password
 }
+
+// Quota - ツールと区分に一致する利用枠を返す、ツール個別の指定がなければ*の指定を返す、どちらもなければ無制限(0)
+func (s *setting) Quota(requester string, category kabuspb.ThrottleCategory) (perSecond int, perMinute int) {
+	var def *Quota
+	for i, q := range s.quotas {
+		if q.Category != category {
+			continue
+		}
+		if q.Requester == requester {
+			return q.PerSecond, q.PerMinute
+		}
+		if q.Requester == QuotaDefaultRequester {
+			def = &s.quotas[i]
+		}
+	}
+	if def != nil {
+		return def.PerSecond, def.PerMinute
+	}
+	return 0, 0
+}
+
+// TokenRetryPolicy - トークンを再発行して再実行する最大実行回数と、再実行の対象にするkabusapiのエラーコードを返す
+func (s *setting) TokenRetryPolicy() (maxAttempts int, codes []int) {
+	return s.retryPolicy.MaxAttempts, s.retryPolicy.Codes
+}
+
+// TokenResetTime - トークンが無効になる毎日の時刻とタイムゾーンを返す、タイムゾーンがnilなら現在時刻のタイムゾーンを使う
+func (s *setting) TokenResetTime() (hour int, minute int, location *time.Location) {
+	return s.tokenReset.Hour, s.tokenReset.Minute, s.tokenReset.Location
+}
+
+// ThrottleInterval - 区分ごとのkabusapiへのリクエストの間隔を返す
+func (s *setting) ThrottleInterval(category kabuspb.ThrottleCategory) time.Duration {
+	return s.throttleIntervals[category]
+}
+
+// IsVirtualEnabled - 仮想証券会社を使うか
+func (s *setting) IsVirtualEnabled() bool {
+	return s.virtualEnabled
+}
+
+// IsAuthRequired - ツールの認証が必要か、接続を許可するツールの指定があれば必要
+func (s *setting) IsAuthRequired() bool {
+	return len(s.clients) > 0
+}
+
+// ClientByAPIKey - APIキーに一致するツールの名前と権限を返す
+func (s *setting) ClientByAPIKey(apiKey string) (name string, permissions []kabuspb.Permission, ok bool) {
+	if apiKey == "" {
+		return "", nil, false
+	}
+	for _, c := range s.clients {
+		if c.APIKey != "" && subtle.ConstantTimeCompare([]byte(c.APIKey), []byte(apiKey)) == 1 {
+			return c.Name, c.Permissions, true
+		}
+	}
+	return "", nil, false
+}
+
+// ClientByTLSSubject - クライアント証明書のCommonNameに一致するツールの名前と権限を返す
+func (s *setting) ClientByTLSSubject(subject string) (name string, permissions []kabuspb.Permission, ok bool) {
+	if subject == "" {
+		return "", nil, false
+	}
+	for _, c := range s.clients {
+		if c.TLSSubject == subject {
+			return c.Name, c.Permissions, true
+		}
+	}
+	return "", nil, false
+}
+
+// ClientMaxPriority - ツールが指定できるリクエスト優先度の上限を返す、知らないツールなら通常を返す
+func (s *setting) ClientMaxPriority(name string) kabuspb.RequestPriority {
+	for _, c := range s.clients {
+		if c.Name == name {
+			return c.MaxPriority
+		}
+	}
+	return kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL
+}
+
+// RiskLimit - 発注前のリスクチェックの上限を返す
+func (s *setting) RiskLimit() repositories.RiskLimit {
+	return s.riskLimit
+}
+
+// KillSwitchFile - 緊急停止の状態を保存するファイルを返す
+func (s *setting) KillSwitchFile() string {
+	return s.killSwitchFile
+}
+
+// AuditLogFile - 注文系の呼出しを記録する監査ログのファイルを返す
+func (s *setting) AuditLogFile() string {
+	return s.auditLogFile
+}
+
+// OrderHistoryFile - 注文履歴を保存するデータベースのファイルを返す
+func (s *setting) OrderHistoryFile() string {
+	return s.orderHistoryFile
+}
+
+// BoardStreamBuffer - 板情報のストリームごとに送るのを待てる板情報の数と、送り切れないときの扱いを返す
+func (s *setting) BoardStreamBuffer() (int, kabuspb.BoardOverflowPolicy) {
+	return s.boardBufferSize, s.boardOverflow
+}
+
+// QuotaDefaultRequester - 個別の指定がないツール全てに適用される利用枠のツール名
+const QuotaDefaultRequester = "*"
+
+// Quota - ツールごと区分ごとのkabusapi呼出しの利用枠、0は無制限
+type Quota struct {
+	Requester string
+	Category  kabuspb.ThrottleCategory
+	PerSecond int
+	PerMinute int
+}
+
+// ParseQuotas - "ツール名:区分:秒間上限:分間上限"をカンマ区切りで並べた文字列(例: screener:info:5:100,*:order:2:0)を利用枠のリストにする
+func ParseQuotas(str string) ([]Quota, error) {
+	res := make([]Quota, 0)
+	for _, s := range strings.Split(str, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		parts := strings.Split(s, ":")
+		if len(parts) != 4 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid quota format: %s", s)
+		}
+		category, ok := kabuspb.ThrottleCategory_value["THROTTLE_CATEGORY_"+strings.ToUpper(parts[1])]
+		if !ok || category == int32(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_UNSPECIFIED) {
+			return nil, fmt.Errorf("invalid quota category: %s", s)
+		}
+		perSecond, err := strconv.Atoi(parts[2])
+		if err != nil || perSecond < 0 {
+			return nil, fmt.Errorf("invalid quota per second: %s", s)
+		}
+		perMinute, err := strconv.Atoi(parts[3])
+		if err != nil || perMinute < 0 {
+			return nil, fmt.Errorf("invalid quota per minute: %s", s)
+		}
+
+		res = append(res, Quota{Requester: parts[0], Category: kabuspb.ThrottleCategory(category), PerSecond: perSecond, PerMinute: perMinute})
+	}
+	return res, nil
+}
+
+// RetryPolicy - kabusapiのエラーでトークンを再発行して再実行するときの方針
+type RetryPolicy struct {
+	MaxAttempts int   // 初回を含めた最大実行回数、1以下なら再実行しない
+	Codes       []int // 再実行の対象にするkabusapiのエラーコード
+}
+
+// DefaultRetryPolicy - APIキー不一致(4001009)のときだけ1回再実行する
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}}
+
+// ParseRetryCodes - カンマ区切りのエラーコードの文字列(例: 4001009,4001017)をエラーコードのリストにする
+func ParseRetryCodes(str string) ([]int, error) {
+	res := make([]int, 0)
+	for _, s := range strings.Split(str, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry code: %s", s)
+		}
+		res = append(res, code)
+	}
+	return res, nil
+}
+
+// TokenReset - トークンが無効になる毎日の時刻
+type TokenReset struct {
+	Hour     int
+	Minute   int
+	Location *time.Location // nilなら現在時刻のタイムゾーン
+}
+
+// DefaultTokenReset - kabuステーションのトークンは毎朝6:30に無効になる
+var DefaultTokenReset = TokenReset{Hour: 6, Minute: 30}
+
+// ParseTokenReset - "HH:MM"形式の時刻とタイムゾーン名(例: Asia/Tokyo)からトークンが無効になる時刻を作る、タイムゾーン名が空なら現在時刻のタイムゾーンを使う
+func ParseTokenReset(resetTime string, timezone string) (TokenReset, error) {
+	t, err := time.Parse("15:04", resetTime)
+	if err != nil {
+		return TokenReset{}, fmt.Errorf("invalid token reset time: %s", resetTime)
+	}
+
+	var location *time.Location
+	if timezone != "" {
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return TokenReset{}, fmt.Errorf("invalid token reset timezone: %s", timezone)
+		}
+	}
+	return TokenReset{Hour: t.Hour(), Minute: t.Minute(), Location: location}, nil
+}
+
+// Client - 接続を許可するツール
+type Client struct {
+	Name        string
+	APIKey      string
+	TLSSubject  string
+	Permissions []kabuspb.Permission
+	MaxPriority kabuspb.RequestPriority // 指定できるリクエスト優先度の上限
+}
+
+// ParsePermissions - 権限名(例: market_data, stock_trading)のリストを権限のリストにする
+func ParsePermissions(strs []string) ([]kabuspb.Permission, error) {
+	if len(strs) == 0 {
+		return nil, fmt.Errorf("permissions are required")
+	}
+
+	res := make([]kabuspb.Permission, 0, len(strs))
+	for _, s := range strs {
+		permission, ok := kabuspb.Permission_value["PERMISSION_"+strings.ToUpper(strings.TrimSpace(s))]
+		if !ok || permission == int32(kabuspb.Permission_PERMISSION_UNSPECIFIED) {
+			return nil, fmt.Errorf("invalid permission: %s", s)
+		}
+		res = append(res, kabuspb.Permission(permission))
+	}
+	return res, nil
+}
+
+// ParsePriority - リクエスト優先度の名前(low, normal, high)をリクエスト優先度にする、空なら通常
+func ParsePriority(str string) (kabuspb.RequestPriority, error) {
+	if strings.TrimSpace(str) == "" {
+		return kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL, nil
+	}
+	priority, ok := kabuspb.RequestPriority_value["REQUEST_PRIORITY_"+strings.ToUpper(strings.TrimSpace(str))]
+	if !ok || priority == int32(kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED) {
+		return 0, fmt.Errorf("invalid priority: %s", str)
+	}
+	return kabuspb.RequestPriority(priority), nil
+}
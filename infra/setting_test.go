@@ -3,7 +3,9 @@ package infra
 import (
 	"reflect"
 	"testing"
+	"time"
 
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
 )
 
@@ -55,10 +57,384 @@ func Test_setting_IsProduction(t *testing.T) {
 
 func Test_InitSetting_GetSetting(t *testing.T) {
 	t.Parallel()
-	InitSetting(false, "Password1234")
-	want := &setting{isProd: false, password: "Password1234"}
+	config := DefaultConfig()
+	config.Password = "Password1234"
+	config.Quotas = "*:order:2:0"
+	if err := InitSetting(config); err != nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+	want := &setting{
+		isProd:      false,
+		password:    "Password1234",
+		quotas:      []Quota{{Requester: "*", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, PerSecond: 2}},
+		retryPolicy: RetryPolicy{MaxAttempts: 2, Codes: []int{4001009}},
+		tokenReset:  TokenReset{Hour: 6, Minute: 30},
+		throttleIntervals: map[kabuspb.ThrottleCategory]time.Duration{
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER:  200 * time.Millisecond,
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: 100 * time.Millisecond,
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:   100 * time.Millisecond,
+		},
+		virtualEnabled:   true,
+		clients:          []Client{},
+		riskLimit:        repositories.RiskLimit{AllowedProducts: []kabuspb.Product{}},
+		killSwitchFile:   "kill_switch.json",
+		auditLogFile:     "audit.jsonl",
+		orderHistoryFile: "order_history.db",
+		boardBufferSize:  256,
+		boardOverflow:    kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST}
 	got := GetSetting()
 	if !reflect.DeepEqual(want, got) {
 		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
 	}
 }
+
+func Test_InitSetting_invalid(t *testing.T) {
+	t.Parallel()
+	err := InitSetting(DefaultConfig()) // パスワードがない
+	if err == nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "error", err)
+	}
+}
+
+func Test_setting_ThrottleInterval(t *testing.T) {
+	t.Parallel()
+	setting := &setting{throttleIntervals: map[kabuspb.ThrottleCategory]time.Duration{kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER: 200 * time.Millisecond}}
+	got1 := setting.ThrottleInterval(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER)
+	got2 := setting.ThrottleInterval(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO)
+	if got1 != 200*time.Millisecond || got2 != 0 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), 200*time.Millisecond, 0, got1, got2)
+	}
+}
+
+func Test_setting_IsVirtualEnabled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		setting repositories.Setting
+		want    bool
+	}{
+		{name: "virtualEnabledがtrueならtrue", setting: &setting{virtualEnabled: true}, want: true},
+		{name: "virtualEnabledがfalseならfalse", setting: &setting{virtualEnabled: false}, want: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.setting.IsVirtualEnabled()
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_setting_Quota(t *testing.T) {
+	t.Parallel()
+	quotas := []Quota{
+		{Requester: "*", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, PerSecond: 5, PerMinute: 100},
+		{Requester: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, PerSecond: 2, PerMinute: 50},
+		{Requester: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, PerSecond: 0, PerMinute: 10},
+	}
+	tests := []struct {
+		name          string
+		quotas        []Quota
+		requester     string
+		category      kabuspb.ThrottleCategory
+		wantPerSecond int
+		wantPerMinute int
+	}{
+		{name: "利用枠の指定がなければ無制限", quotas: nil, requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, wantPerSecond: 0, wantPerMinute: 0},
+		{name: "ツール個別の指定があればそれを返す", quotas: quotas, requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, wantPerSecond: 2, wantPerMinute: 50},
+		{name: "ツール個別の指定がなければ*の指定を返す", quotas: quotas, requester: "bot", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, wantPerSecond: 5, wantPerMinute: 100},
+		{name: "区分が一致しなければ使わない", quotas: quotas, requester: "bot", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, wantPerSecond: 0, wantPerMinute: 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			setting := &setting{quotas: test.quotas}
+			gotPerSecond, gotPerMinute := setting.Quota(test.requester, test.category)
+			if !reflect.DeepEqual(test.wantPerSecond, gotPerSecond) || !reflect.DeepEqual(test.wantPerMinute, gotPerMinute) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantPerSecond, test.wantPerMinute, gotPerSecond, gotPerMinute)
+			}
+		})
+	}
+}
+
+func Test_ParseQuotas(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		str      string
+		want     []Quota
+		hasError bool
+	}{
+		{name: "空文字なら空配列", str: "", want: []Quota{}},
+		{name: "カンマ区切りで複数の利用枠を指定できる", str: "screener:info:5:100, *:ORDER:2:0", want: []Quota{
+			{Requester: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, PerSecond: 5, PerMinute: 100},
+			{Requester: "*", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, PerSecond: 2, PerMinute: 0},
+		}},
+		{name: "要素が足りなければエラー", str: "screener:info:5", hasError: true},
+		{name: "ツール名が空ならエラー", str: ":info:5:100", hasError: true},
+		{name: "未知の区分ならエラー", str: "screener:foo:5:100", hasError: true},
+		{name: "区分が未指定ならエラー", str: "screener:unspecified:5:100", hasError: true},
+		{name: "秒間上限が数値でなければエラー", str: "screener:info:a:100", hasError: true},
+		{name: "分間上限が負数ならエラー", str: "screener:info:5:-1", hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseQuotas(test.str)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_setting_TokenRetryPolicy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		setting         repositories.Setting
+		wantMaxAttempts int
+		wantCodes       []int
+	}{
+		{name: "指定がなければ0回とnil", setting: &setting{}, wantMaxAttempts: 0, wantCodes: nil},
+		{name: "指定があれば指定された内容を返す", setting: &setting{retryPolicy: RetryPolicy{MaxAttempts: 3, Codes: []int{4001009, 4001017}}}, wantMaxAttempts: 3, wantCodes: []int{4001009, 4001017}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotMaxAttempts, gotCodes := test.setting.TokenRetryPolicy()
+			if !reflect.DeepEqual(test.wantMaxAttempts, gotMaxAttempts) || !reflect.DeepEqual(test.wantCodes, gotCodes) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantMaxAttempts, test.wantCodes, gotMaxAttempts, gotCodes)
+			}
+		})
+	}
+}
+
+func Test_ParseRetryCodes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		str      string
+		want     []int
+		hasError bool
+	}{
+		{name: "空文字なら空配列", str: "", want: []int{}},
+		{name: "カンマ区切りで複数のエラーコードを指定できる", str: "4001009, 4001017", want: []int{4001009, 4001017}},
+		{name: "数値でなければエラー", str: "4001009,foo", hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseRetryCodes(test.str)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_setting_TokenResetTime(t *testing.T) {
+	t.Parallel()
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name         string
+		setting      repositories.Setting
+		wantHour     int
+		wantMinute   int
+		wantLocation *time.Location
+	}{
+		{name: "タイムゾーンの指定がなければnil", setting: &setting{tokenReset: TokenReset{Hour: 6, Minute: 30}}, wantHour: 6, wantMinute: 30, wantLocation: nil},
+		{name: "タイムゾーンの指定があればそれを返す", setting: &setting{tokenReset: TokenReset{Hour: 5, Minute: 0, Location: jst}}, wantHour: 5, wantMinute: 0, wantLocation: jst},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotHour, gotMinute, gotLocation := test.setting.TokenResetTime()
+			if test.wantHour != gotHour || test.wantMinute != gotMinute || test.wantLocation != gotLocation {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantHour, test.wantMinute, test.wantLocation, gotHour, gotMinute, gotLocation)
+			}
+		})
+	}
+}
+
+func Test_ParseTokenReset(t *testing.T) {
+	t.Parallel()
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	tests := []struct {
+		name      string
+		resetTime string
+		timezone  string
+		want      TokenReset
+		hasError  bool
+	}{
+		{name: "タイムゾーンが空ならnil", resetTime: "06:30", timezone: "", want: TokenReset{Hour: 6, Minute: 30}},
+		{name: "タイムゾーンがあれば読み込む", resetTime: "05:05", timezone: "Asia/Tokyo", want: TokenReset{Hour: 5, Minute: 5, Location: tokyo}},
+		{name: "時刻が不正ならエラー", resetTime: "6時30分", timezone: "", want: TokenReset{}, hasError: true},
+		{name: "タイムゾーンが不正ならエラー", resetTime: "06:30", timezone: "Foo/Bar", want: TokenReset{}, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseTokenReset(test.resetTime, test.timezone)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_setting_ClientByAPIKey(t *testing.T) {
+	t.Parallel()
+	s := &setting{clients: []Client{
+		{Name: "screener", APIKey: "API_KEY", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}},
+		{Name: "trader", TLSSubject: "trader", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}},
+	}}
+	tests := []struct {
+		name            string
+		apiKey          string
+		wantName        string
+		wantPermissions []kabuspb.Permission
+		wantOK          bool
+	}{
+		{name: "一致するAPIキーがあればツールを返す", apiKey: "API_KEY", wantName: "screener", wantPermissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}, wantOK: true},
+		{name: "一致するAPIキーがなければfalse", apiKey: "UNKNOWN"},
+		{name: "APIキーが空ならAPIキーのないツールに一致しない", apiKey: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got1, got2, got3 := s.ClientByAPIKey(test.apiKey)
+			if test.wantName != got1 || !reflect.DeepEqual(test.wantPermissions, got2) || test.wantOK != got3 {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantName, test.wantPermissions, test.wantOK, got1, got2, got3)
+			}
+		})
+	}
+}
+
+func Test_setting_ClientByTLSSubject(t *testing.T) {
+	t.Parallel()
+	s := &setting{clients: []Client{
+		{Name: "screener", APIKey: "API_KEY", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}},
+		{Name: "trader", TLSSubject: "trader.local", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}},
+	}}
+	tests := []struct {
+		name            string
+		subject         string
+		wantName        string
+		wantPermissions []kabuspb.Permission
+		wantOK          bool
+	}{
+		{name: "一致するCommonNameがあればツールを返す", subject: "trader.local", wantName: "trader", wantPermissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}, wantOK: true},
+		{name: "一致するCommonNameがなければfalse", subject: "unknown.local"},
+		{name: "CommonNameが空ならCommonNameのないツールに一致しない", subject: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got1, got2, got3 := s.ClientByTLSSubject(test.subject)
+			if test.wantName != got1 || !reflect.DeepEqual(test.wantPermissions, got2) || test.wantOK != got3 {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantName, test.wantPermissions, test.wantOK, got1, got2, got3)
+			}
+		})
+	}
+}
+
+func Test_ParsePermissions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		strs     []string
+		want     []kabuspb.Permission
+		hasError bool
+	}{
+		{name: "小文字の権限名を解釈できる", strs: []string{"market_data", " STOCK_TRADING "},
+			want: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA, kabuspb.Permission_PERMISSION_STOCK_TRADING}},
+		{name: "権限がなければエラー", strs: []string{}, hasError: true},
+		{name: "知らない権限ならエラー", strs: []string{"foo"}, hasError: true},
+		{name: "未指定はエラー", strs: []string{"unspecified"}, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParsePermissions(test.strs)
+			if !reflect.DeepEqual(test.want, got) || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_setting_ClientMaxPriority(t *testing.T) {
+	t.Parallel()
+	s := &setting{clients: []Client{
+		{Name: "screener", APIKey: "API_KEY", MaxPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW},
+		{Name: "trader", APIKey: "API_KEY2", MaxPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+	}}
+	tests := []struct {
+		name       string
+		clientName string
+		want       kabuspb.RequestPriority
+	}{
+		{name: "ツールの上限を返す", clientName: "trader", want: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+		{name: "知らないツールなら通常を返す", clientName: "unknown", want: kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := s.ClientMaxPriority(test.clientName)
+			if test.want != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_ParsePriority(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		str      string
+		want     kabuspb.RequestPriority
+		hasError bool
+	}{
+		{name: "空なら通常", str: "", want: kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL},
+		{name: "小文字の優先度名を解釈できる", str: " high ", want: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+		{name: "知らない優先度ならエラー", str: "foo", hasError: true},
+		{name: "未指定はエラー", str: "unspecified", hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParsePriority(test.str)
+			if test.want != got || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package stores
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	auditLogSingleton      repositories.AuditLogStore
+	auditLogSingletonMutex sync.Mutex
+)
+
+// GetAuditLogStore - 監査ログをJSON Lines形式でファイルに追記するストア
+func GetAuditLogStore(path string) repositories.AuditLogStore {
+	auditLogSingletonMutex.Lock()
+	defer auditLogSingletonMutex.Unlock()
+
+	if auditLogSingleton == nil {
+		auditLogSingleton = &auditLog{path: path}
+	}
+
+	return auditLogSingleton
+}
+
+type auditLog struct {
+	path string
+	mtx  sync.Mutex
+}
+
+// Append - 1行に1件の監査ログを追記して、ディスクに書き込まれるまで待つ
+func (s *auditLog) Append(log *kabuspb.AuditLog) error {
+	b, err := protojson.Marshal(log)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// All - ファイルにある全ての監査ログを書いた順に返す、ファイルがなければ空、書き込み途中で落ちたような読めない行は飛ばす
+func (s *auditLog) All() ([]*kabuspb.AuditLog, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make([]*kabuspb.AuditLog, 0)
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		log := &kabuspb.AuditLog{}
+		if err := protojson.Unmarshal(scanner.Bytes(), log); err != nil {
+			continue
+		}
+		res = append(res, log)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,71 @@
+package stores
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_auditLog_Append(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	store := &auditLog{path: path}
+	want := []*kabuspb.AuditLog{
+		{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", SymbolCode: "1320", OrderId: "ORDER-ID",
+			Request: `{"symbolCode":"1320"}`, RequestedAt: timestamppb.New(time.Date(2021, 9, 13, 9, 0, 0, 0, time.UTC))},
+		{Method: "/kabuspb.KabusService/CancelOrder", Requester: "tool", Error: "rpc error"},
+	}
+	for _, log := range want {
+		if err := store.Append(log); err != nil {
+			t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+		}
+	}
+
+	got, err := (&auditLog{path: path}).All()
+	if err != nil || len(got) != len(want) {
+		t.Fatalf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, nil, got, err)
+	}
+	for i := range want {
+		if !proto.Equal(want[i], got[i]) {
+			t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want[i], got[i])
+		}
+	}
+}
+
+func Test_auditLog_All(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	broken := filepath.Join(dir, "broken.jsonl")
+	if err := ioutil.WriteFile(broken, []byte("{\"method\":\"SendStockOrder\"}\n{\"method\":\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		path      string
+		wantCount int
+		hasError  bool
+	}{
+		{name: "ファイルがなければ空", path: filepath.Join(dir, "not_found.jsonl"), wantCount: 0},
+		{name: "読めない行は飛ばす", path: broken, wantCount: 1},
+		{name: "ファイルが開けなければエラー", path: dir + string(os.PathSeparator) + "broken.jsonl" + string(os.PathSeparator) + "audit.jsonl", hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := (&auditLog{path: test.path}).All()
+			if len(got) != test.wantCount || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantCount, test.hasError, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package stores
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	boardSingleton      repositories.BoardStore
+	boardSingletonMutex sync.Mutex
+)
+
+// GetBoardStore - 銘柄・市場ごとに最後に受け取った板情報を保持するストア
+func GetBoardStore() repositories.BoardStore {
+	boardSingletonMutex.Lock()
+	defer boardSingletonMutex.Unlock()
+
+	if boardSingleton == nil {
+		boardSingleton = &board{store: map[boardKey]boardEntry{}}
+	}
+
+	return boardSingleton
+}
+
+type boardKey struct {
+	symbolCode string
+	exchange   kabuspb.Exchange
+}
+
+type boardEntry struct {
+	board      *kabuspb.Board
+	receivedAt time.Time
+}
+
+type board struct {
+	store map[boardKey]boardEntry
+	mtx   sync.Mutex
+}
+
+// Save - 板情報を銘柄・市場ごとに上書きする
+func (s *board) Save(board *kabuspb.Board, receivedAt time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.store[boardKey{symbolCode: board.SymbolCode, exchange: board.Exchange}] = boardEntry{board: board, receivedAt: receivedAt}
+}
+
+// Get - 銘柄・市場の板情報と受け取った日時を返す、なければfalseを返す
+func (s *board) Get(symbolCode string, exchange kabuspb.Exchange) (*kabuspb.Board, time.Time, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entry, ok := s.store[boardKey{symbolCode: symbolCode, exchange: exchange}]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return entry.board, entry.receivedAt, true
+}
+
+// All - 保持している全ての板情報を銘柄コード・市場の順に返す
+func (s *board) All() []*kabuspb.Board {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make([]*kabuspb.Board, 0, len(s.store))
+	for _, entry := range s.store {
+		res = append(res, entry.board)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].SymbolCode != res[j].SymbolCode {
+			return res[i].SymbolCode < res[j].SymbolCode
+		}
+		return res[i].Exchange < res[j].Exchange
+	})
+	return res
+}
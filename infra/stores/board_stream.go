@@ -32,6 +32,7 @@ type boardStream struct {
 
 type streamChan struct {
 	stream kabuspb.KabusService_GetBoardsStreamingServer
+	req    *kabuspb.GetBoardsStreamingRequest
 	ch     chan error
 }
 
@@ -52,11 +53,26 @@ func (s *boardStream) All() map[int]kabuspb.KabusService_GetBoardsStreamingServe
 	return res
 }
 
-func (s *boardStream) Add(stream kabuspb.KabusService_GetBoardsStreamingServer, ch chan error) {
+// Request - ストリームを開いたときのリクエストを返す、ストリームがなければnilを返す
+func (s *boardStream) Request(seq int) *kabuspb.GetBoardsStreamingRequest {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
-	s.store[s.seq] = streamChan{stream: stream, ch: ch}
+
+	stream, ok := s.store[seq]
+	if !ok {
+		return nil
+	}
+	return stream.req
+}
+
+// Add - ストリームを追加して、追加したストリームの番号を返す
+func (s *boardStream) Add(stream kabuspb.KabusService_GetBoardsStreamingServer, req *kabuspb.GetBoardsStreamingRequest, ch chan error) int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	seq := s.seq
+	s.store[seq] = streamChan{stream: stream, req: req, ch: ch}
 	s.seq++
+	return seq
 }
 
 func (s *boardStream) Remove(seq int, err error) {
@@ -58,15 +58,18 @@ func Test_boardStream_Add(t *testing.T) {
 		name  string
 		store *boardStream
 		arg   kabuspb.KabusService_GetBoardsStreamingServer
+		want1 int
 		want  map[int]streamChan
 	}{
 		{name: "store空配列でも要素が追加される",
 			store: &boardStream{store: map[int]streamChan{}},
 			arg:   &testBoardStreamServer{Foo: "foo"},
+			want1: 0,
 			want:  map[int]streamChan{0: {stream: &testBoardStreamServer{Foo: "foo"}}}},
 		{name: "storeに要素があっても要素が追加される",
 			store: &boardStream{seq: 2, store: map[int]streamChan{0: {stream: &testBoardStreamServer{Foo: "foo"}}, 1: {stream: &testBoardStreamServer{Foo: "bar"}}}},
 			arg:   &testBoardStreamServer{Foo: "foo"},
+			want1: 2,
 			want:  map[int]streamChan{0: {stream: &testBoardStreamServer{Foo: "foo"}}, 1: {stream: &testBoardStreamServer{Foo: "bar"}}, 2: {stream: &testBoardStreamServer{Foo: "foo"}}}},
 	}
 
@@ -74,8 +77,33 @@ func Test_boardStream_Add(t *testing.T) {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			test.store.Add(test.arg, nil)
+			got1 := test.store.Add(test.arg, nil, nil)
 			got := test.store.store
+			if test.want1 != got1 || !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want1, test.want, got1, got)
+			}
+		})
+	}
+}
+
+func Test_boardStream_Request(t *testing.T) {
+	t.Parallel()
+	req := &kabuspb.GetBoardsStreamingRequest{RequesterName: "foo", OnlyRegistered: true}
+	tests := []struct {
+		name  string
+		store *boardStream
+		arg   int
+		want  *kabuspb.GetBoardsStreamingRequest
+	}{
+		{name: "ストリームがなければnilを返す", store: &boardStream{store: map[int]streamChan{0: {req: req}}}, arg: 1, want: nil},
+		{name: "ストリームがあればリクエストを返す", store: &boardStream{store: map[int]streamChan{0: {req: req}}}, arg: 0, want: req},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.store.Request(test.arg)
 			if !reflect.DeepEqual(test.want, got) {
 				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
 			}
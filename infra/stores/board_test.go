@@ -0,0 +1,107 @@
+package stores
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_GetBoardStore(t *testing.T) {
+	t.Parallel()
+	got := GetBoardStore()
+	want := &board{store: map[boardKey]boardEntry{}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_board_Save(t *testing.T) {
+	t.Parallel()
+	at1 := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	at2 := time.Date(2021, 9, 13, 9, 0, 1, 0, time.Local)
+	old := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 100}
+	other := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_MEISHOU, CurrentPrice: 99}
+	cur := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 101}
+
+	tests := []struct {
+		name  string
+		store *board
+		arg   *kabuspb.Board
+		want  map[boardKey]boardEntry
+	}{
+		{name: "なければ追加する",
+			store: &board{store: map[boardKey]boardEntry{}},
+			arg:   cur,
+			want:  map[boardKey]boardEntry{{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: cur, receivedAt: at2}}},
+		{name: "同じ銘柄・市場なら上書きし、市場が違えば残す",
+			store: &board{store: map[boardKey]boardEntry{
+				{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: old, receivedAt: at1},
+				{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_MEISHOU}: {board: other, receivedAt: at1},
+			}},
+			arg: cur,
+			want: map[boardKey]boardEntry{
+				{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: cur, receivedAt: at2},
+				{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_MEISHOU}: {board: other, receivedAt: at1},
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			test.store.Save(test.arg, at2)
+			if !reflect.DeepEqual(test.want, test.store.store) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, test.store.store)
+			}
+		})
+	}
+}
+
+func Test_board_Get(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	b := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	store := &board{store: map[boardKey]boardEntry{{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: b, receivedAt: at}}}
+
+	tests := []struct {
+		name       string
+		symbolCode string
+		exchange   kabuspb.Exchange
+		want1      *kabuspb.Board
+		want2      time.Time
+		want3      bool
+	}{
+		{name: "あれば板情報と受け取った日時を返す", symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, want1: b, want2: at, want3: true},
+		{name: "市場が違えばfalseを返す", symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_MEISHOU, want1: nil, want2: time.Time{}, want3: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got1, got2, got3 := store.Get(test.symbolCode, test.exchange)
+			if !reflect.DeepEqual(test.want1, got1) || !test.want2.Equal(got2) || test.want3 != got3 {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.want1, test.want2, test.want3, got1, got2, got3)
+			}
+		})
+	}
+}
+
+func Test_board_All(t *testing.T) {
+	t.Parallel()
+	b1 := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	b2 := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_MEISHOU}
+	b3 := &kabuspb.Board{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	store := &board{store: map[boardKey]boardEntry{
+		{symbolCode: "7203", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: b3},
+		{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_MEISHOU}: {board: b2},
+		{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: {board: b1},
+	}}
+	want := []*kabuspb.Board{b1, b2, b3}
+	got := store.All()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
@@ -0,0 +1,69 @@
+package stores
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	clientOrderSingleton      repositories.ClientOrderStore
+	clientOrderSingletonMutex sync.Mutex
+)
+
+// GetClientOrderStore - クライアント注文IDと発注の結果の対応をメモリに保持するストア
+func GetClientOrderStore() repositories.ClientOrderStore {
+	clientOrderSingletonMutex.Lock()
+	defer clientOrderSingletonMutex.Unlock()
+
+	if clientOrderSingleton == nil {
+		clientOrderSingleton = &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{}, clientOrderIDs: map[string]clientOrderKey{}}
+	}
+
+	return clientOrderSingleton
+}
+
+// clientOrderKey - クライアント注文IDはツールごとに別々に扱う
+type clientOrderKey struct {
+	owner         string
+	clientOrderID string
+}
+
+type clientOrder struct {
+	responses      map[clientOrderKey]*kabuspb.OrderResponse // ツールとクライアント注文IDごとの発注の結果
+	clientOrderIDs map[string]clientOrderKey                 // 注文番号ごとのツールとクライアント注文ID
+	mtx            sync.Mutex
+}
+
+func (s *clientOrder) Get(owner string, clientOrderID string) (*kabuspb.OrderResponse, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res, ok := s.responses[clientOrderKey{owner: owner, clientOrderID: clientOrderID}]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(res).(*kabuspb.OrderResponse), true
+}
+
+func (s *clientOrder) GetClientOrderID(orderID string) (string, string, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	key, ok := s.clientOrderIDs[orderID]
+	return key.owner, key.clientOrderID, ok
+}
+
+func (s *clientOrder) Save(owner string, clientOrderID string, res *kabuspb.OrderResponse) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	key := clientOrderKey{owner: owner, clientOrderID: clientOrderID}
+	s.responses[key] = proto.Clone(res).(*kabuspb.OrderResponse)
+	if res.OrderId != "" {
+		s.clientOrderIDs[res.OrderId] = key
+	}
+}
@@ -0,0 +1,95 @@
+package stores
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_GetClientOrderStore(t *testing.T) {
+	t.Parallel()
+	got := GetClientOrderStore()
+	want := &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{}, clientOrderIDs: map[string]clientOrderKey{}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_clientOrder_Get(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		store *clientOrder
+		want1 *kabuspb.OrderResponse
+		want2 bool
+	}{
+		{name: "保存されていなければfalseを返す",
+			store: &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{}}},
+		{name: "保存されていれば発注の結果を返す",
+			store: &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{{owner: "trader", clientOrderID: "client-1"}: {ResultCode: 0, OrderId: "ORDER-ID"}}},
+			want1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
+			want2: true},
+		{name: "他のツールが同じクライアント注文IDで保存していてもfalseを返す",
+			store: &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{{owner: "screener", clientOrderID: "client-1"}: {ResultCode: 0, OrderId: "ORDER-ID"}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got1, got2 := test.store.Get("trader", "client-1")
+			if !proto.Equal(test.want1, got1) || test.want2 != got2 {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want1, test.want2, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_clientOrder_Get_clone(t *testing.T) {
+	t.Parallel()
+	key := clientOrderKey{owner: "trader", clientOrderID: "client-1"}
+	store := &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{key: {OrderId: "ORDER-ID"}}}
+	got, _ := store.Get("trader", "client-1")
+	got.OrderId = "CHANGED"
+	if store.responses[key].OrderId != "ORDER-ID" {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "ORDER-ID", store.responses[key].OrderId)
+	}
+}
+
+func Test_clientOrder_Save(t *testing.T) {
+	t.Parallel()
+	key := clientOrderKey{owner: "trader", clientOrderID: "client-1"}
+	tests := []struct {
+		name               string
+		res                *kabuspb.OrderResponse
+		wantResponses      map[clientOrderKey]*kabuspb.OrderResponse
+		wantClientOrderIDs map[string]clientOrderKey
+	}{
+		{name: "注文番号があれば注文番号からも引けるようにする",
+			res:                &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantResponses:      map[clientOrderKey]*kabuspb.OrderResponse{key: {OrderId: "ORDER-ID"}},
+			wantClientOrderIDs: map[string]clientOrderKey{"ORDER-ID": key}},
+		{name: "注文番号がなければ結果だけ保存する",
+			res:                &kabuspb.OrderResponse{ResultCode: 4},
+			wantResponses:      map[clientOrderKey]*kabuspb.OrderResponse{key: {ResultCode: 4}},
+			wantClientOrderIDs: map[string]clientOrderKey{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &clientOrder{responses: map[clientOrderKey]*kabuspb.OrderResponse{}, clientOrderIDs: map[string]clientOrderKey{}}
+			store.Save("trader", "client-1", test.res)
+			got1, got2, _ := store.GetClientOrderID("ORDER-ID")
+			want := test.wantClientOrderIDs["ORDER-ID"]
+			if len(test.wantResponses) != len(store.responses) || !proto.Equal(test.wantResponses[key], store.responses[key]) ||
+				!reflect.DeepEqual(test.wantClientOrderIDs, store.clientOrderIDs) || got1 != want.owner || got2 != want.clientOrderID {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantResponses, test.wantClientOrderIDs, store.responses, store.clientOrderIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package stores
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	killSwitchSingleton      repositories.KillSwitchStore
+	killSwitchSingletonMutex sync.Mutex
+)
+
+// GetKillSwitchStore - 緊急停止の状態をファイルに保存するストア、初回だけファイルから状態を読み込む
+func GetKillSwitchStore(path string) repositories.KillSwitchStore {
+	killSwitchSingletonMutex.Lock()
+	defer killSwitchSingletonMutex.Unlock()
+
+	if killSwitchSingleton == nil {
+		killSwitchSingleton = newKillSwitch(path)
+	}
+
+	return killSwitchSingleton
+}
+
+// newKillSwitch - ファイルから状態を読み込む、ファイルがなければ停止していない状態にし、読めなければ安全のため停止中の状態にする
+func newKillSwitch(path string) *killSwitch {
+	s := &killSwitch{path: path, status: &kabuspb.KillSwitchStatus{}}
+
+	b, err := ioutil.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s
+	}
+	if err == nil {
+		status := &kabuspb.KillSwitchStatus{}
+		if err = protojson.Unmarshal(b, status); err == nil {
+			s.status = status
+			return s
+		}
+	}
+	s.status = &kabuspb.KillSwitchStatus{Active: true, Reason: fmt.Sprintf("failed to load kill switch state: %v", err)}
+	return s
+}
+
+type killSwitch struct {
+	path   string
+	status *kabuspb.KillSwitchStatus
+	mtx    sync.Mutex
+}
+
+func (s *killSwitch) Get() *kabuspb.KillSwitchStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return proto.Clone(s.status).(*kabuspb.KillSwitchStatus)
+}
+
+// Save - 状態を保持してからファイルに書く、書けなくても保持した状態は戻さずにエラーを状態に含める
+func (s *killSwitch) Save(status *kabuspb.KillSwitchStatus) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.status = proto.Clone(status).(*kabuspb.KillSwitchStatus)
+	s.status.SaveError = ""
+	if err := s.write(s.status); err != nil {
+		s.status.SaveError = err.Error()
+		return err
+	}
+	return nil
+}
+
+// write - 状態をファイルに書く、書き込み途中で落ちても壊れないように一時ファイルを経由する
+func (s *killSwitch) write(status *kabuspb.KillSwitchStatus) error {
+	b, err := protojson.Marshal(status)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
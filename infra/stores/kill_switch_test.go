@@ -0,0 +1,87 @@
+package stores
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_newKillSwitch(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	saved := filepath.Join(dir, "saved.json")
+	if err := ioutil.WriteFile(saved, []byte(`{"active":true,"virtualOnly":true,"reason":"maintenance"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	broken := filepath.Join(dir, "broken.json")
+	if err := ioutil.WriteFile(broken, []byte(`{"active":`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		wantActive  bool
+		wantVirtual bool
+		wantReason  string
+	}{
+		{name: "ファイルがなければ停止していない", path: filepath.Join(dir, "not_found.json")},
+		{name: "ファイルがあれば状態を引き継ぐ", path: saved, wantActive: true, wantVirtual: true, wantReason: "maintenance"},
+		{name: "ファイルが読めなければ停止中にする", path: broken, wantActive: true, wantReason: "failed to load kill switch state"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := newKillSwitch(test.path).Get()
+			if got.Active != test.wantActive || got.VirtualOnly != test.wantVirtual || !strings.HasPrefix(got.Reason, test.wantReason) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v\n", t.Name(), test.wantActive, test.wantVirtual, test.wantReason, got)
+			}
+		})
+	}
+}
+
+func Test_killSwitch_Save(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "kill_switch.json")
+	store := newKillSwitch(path)
+	want := &kabuspb.KillSwitchStatus{
+		Active:      true,
+		Reason:      "emergency",
+		ActivatedAt: timestamppb.New(time.Date(2021, 9, 13, 9, 0, 0, 0, time.UTC)),
+		Cancels:     []*kabuspb.KillSwitchCancel{{OrderId: "ORDER-ID", Canceled: true}},
+	}
+	if err := store.Save(want); err != nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+
+	// 保存した状態を返し、再起動しても引き継ぐ
+	if got := store.Get(); !proto.Equal(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+	if got := newKillSwitch(path).Get(); !proto.Equal(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_killSwitch_Save_error(t *testing.T) {
+	t.Parallel()
+	store := newKillSwitch(filepath.Join(t.TempDir(), "not_found", "kill_switch.json"))
+	err := store.Save(&kabuspb.KillSwitchStatus{Active: true})
+	if err == nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "error", err)
+	}
+
+	// 保存できなくても状態を変えて、保存のエラーを状態に含める
+	if got := store.Get(); !got.Active || got.SaveError != err.Error() {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v\n", t.Name(), true, err, got)
+	}
+}
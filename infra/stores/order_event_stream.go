@@ -0,0 +1,74 @@
+package stores
+
+import (
+	"sync"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	orderEventStreamSingleton      repositories.OrderEventStreamStore
+	orderEventStreamSingletonMutex sync.Mutex
+)
+
+func GetOrderEventStreamStore() repositories.OrderEventStreamStore {
+	orderEventStreamSingletonMutex.Lock()
+	defer orderEventStreamSingletonMutex.Unlock()
+
+	if orderEventStreamSingleton == nil {
+		orderEventStreamSingleton = &orderEventStream{store: map[int]orderEventStreamChan{}}
+	}
+
+	return orderEventStreamSingleton
+}
+
+type orderEventStream struct {
+	store map[int]orderEventStreamChan
+	seq   int
+	mtx   sync.Mutex
+}
+
+type orderEventStreamChan struct {
+	stream kabuspb.KabusService_GetOrderEventsStreamingServer
+	ch     chan error
+}
+
+func (s *orderEventStream) HasStream() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return len(s.store) > 0
+}
+
+func (s *orderEventStream) All() map[int]kabuspb.KabusService_GetOrderEventsStreamingServer {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make(map[int]kabuspb.KabusService_GetOrderEventsStreamingServer)
+	for i, stream := range s.store {
+		res[i] = stream.stream
+	}
+	return res
+}
+
+func (s *orderEventStream) Add(stream kabuspb.KabusService_GetOrderEventsStreamingServer, ch chan error) int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	seq := s.seq
+	s.store[seq] = orderEventStreamChan{stream: stream, ch: ch}
+	s.seq++
+	return seq
+}
+
+func (s *orderEventStream) Remove(seq int, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.store[seq]; !ok {
+		return
+	}
+
+	stream := s.store[seq]
+	stream.ch <- err
+	delete(s.store, seq)
+}
@@ -0,0 +1,77 @@
+package stores
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_GetOrderEventStreamStore(t *testing.T) {
+	t.Parallel()
+	got := GetOrderEventStreamStore()
+	want := &orderEventStream{store: map[int]orderEventStreamChan{}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+type testOrderEventStreamServer struct {
+	Foo string
+	grpc.ServerStream
+}
+
+func (t *testOrderEventStreamServer) Send(*kabuspb.OrderEvent) error { return nil }
+
+func Test_orderEventStream_HasStream(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		store *orderEventStream
+		want  bool
+	}{
+		{name: "storeが空ならfalse", store: &orderEventStream{store: map[int]orderEventStreamChan{}}, want: false},
+		{name: "storeに要素があればtrue", store: &orderEventStream{store: map[int]orderEventStreamChan{0: {stream: &testOrderEventStreamServer{}}}}, want: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.store.HasStream()
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_orderEventStream_Add_All(t *testing.T) {
+	t.Parallel()
+	store := &orderEventStream{seq: 1, store: map[int]orderEventStreamChan{0: {stream: &testOrderEventStreamServer{Foo: "foo"}}}}
+	seq := store.Add(&testOrderEventStreamServer{Foo: "bar"}, nil)
+	want := map[int]kabuspb.KabusService_GetOrderEventsStreamingServer{0: &testOrderEventStreamServer{Foo: "foo"}, 1: &testOrderEventStreamServer{Foo: "bar"}}
+	got := store.All()
+	if !reflect.DeepEqual(want, got) || store.seq != 2 || seq != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), want, 2, 1, got, store.seq, seq)
+	}
+}
+
+func Test_orderEventStream_Remove(t *testing.T) {
+	t.Parallel()
+	ch := make(chan error, 1)
+	store := &orderEventStream{store: map[int]orderEventStreamChan{0: {stream: &testOrderEventStreamServer{Foo: "foo"}, ch: ch}, 1: {stream: &testOrderEventStreamServer{Foo: "bar"}}}}
+
+	store.Remove(1000, nil)
+	store.Remove(0, errors.New("error message"))
+	want := map[int]orderEventStreamChan{1: {stream: &testOrderEventStreamServer{Foo: "bar"}}}
+	if !reflect.DeepEqual(want, store.store) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, store.store)
+	}
+	if got := <-ch; got == nil || got.Error() != "error message" {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "error message", got)
+	}
+}
@@ -0,0 +1,115 @@
+package stores
+
+import (
+	"sync"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	orderHistorySingleton      repositories.OrderHistoryStore
+	orderHistorySingletonMutex sync.Mutex
+)
+
+// orderHistoryBucket - 注文履歴を保存するバケット
+var orderHistoryBucket = []byte("orders")
+
+// GetOrderHistoryStore - 注文履歴をBoltDBのファイルに保存するストア、ファイルは初めて使うときに開く
+func GetOrderHistoryStore(path string) repositories.OrderHistoryStore {
+	orderHistorySingletonMutex.Lock()
+	defer orderHistorySingletonMutex.Unlock()
+
+	if orderHistorySingleton == nil {
+		orderHistorySingleton = &orderHistory{path: path}
+	}
+
+	return orderHistorySingleton
+}
+
+type orderHistory struct {
+	path string
+	db   *bolt.DB
+	mtx  sync.Mutex
+}
+
+// open - データベースを開いていなければ開く、他のプロセスが開いていたら待たずにエラーを返す
+func (s *orderHistory) open() (*bolt.DB, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.db != nil {
+		return s.db, nil
+	}
+	db, err := bolt.Open(s.path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(orderHistoryBucket)
+		return err
+	}); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	s.db = db
+	return s.db, nil
+}
+
+// Save - 注文を実注文か仮想証券会社の注文かと注文番号ごとに上書きして保存する
+func (s *orderHistory) Save(records []*kabuspb.OrderHistoryRecord) error {
+	db, err := s.open()
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(orderHistoryBucket)
+		for _, r := range records {
+			b, err := proto.Marshal(r)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(orderHistoryKey(r), b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// All - 保存している全ての注文を返す
+func (s *orderHistory) All() ([]*kabuspb.OrderHistoryRecord, error) {
+	db, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*kabuspb.OrderHistoryRecord, 0)
+	err = db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(orderHistoryBucket).ForEach(func(_, v []byte) error {
+			r := &kabuspb.OrderHistoryRecord{}
+			if err := proto.Unmarshal(v, r); err != nil {
+				return err
+			}
+			res = append(res, r)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// orderHistoryKey - 実注文と仮想証券会社の注文で注文番号が重なっても別に保存できるようにしたキー
+func orderHistoryKey(r *kabuspb.OrderHistoryRecord) []byte {
+	if r.IsVirtual {
+		return []byte("virtual/" + r.Order.GetId())
+	}
+	return []byte("real/" + r.Order.GetId())
+}
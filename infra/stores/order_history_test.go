@@ -0,0 +1,68 @@
+package stores
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_orderHistory_Save(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "order_history.db")
+	store := &orderHistory{path: path}
+	savedAt := timestamppb.New(time.Date(2021, 9, 13, 9, 0, 0, 0, time.UTC))
+
+	first := []*kabuspb.OrderHistoryRecord{
+		{Order: &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED}, Product: kabuspb.Product_PRODUCT_STOCK, SavedAt: savedAt},
+		{Order: &kabuspb.Order{Id: "ORDER-1"}, Product: kabuspb.Product_PRODUCT_MARGIN, IsVirtual: true, SavedAt: savedAt},
+	}
+	if err := store.Save(first); err != nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+	updated := &kabuspb.OrderHistoryRecord{
+		Order:   &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, Details: []*kabuspb.OrderDetail{{SequenceNumber: 1}}},
+		Product: kabuspb.Product_PRODUCT_STOCK, SavedAt: savedAt}
+	if err := store.Save([]*kabuspb.OrderHistoryRecord{updated}); err != nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+
+	want := []*kabuspb.OrderHistoryRecord{updated, first[1]}
+	got, err := store.All()
+	if err != nil || len(got) != len(want) {
+		t.Fatalf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, nil, got, err)
+	}
+	for i := range want {
+		if !proto.Equal(want[i], got[i]) {
+			t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want[i], got[i])
+		}
+	}
+}
+
+func Test_orderHistory_All(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		path      string
+		wantCount int
+		hasError  bool
+	}{
+		{name: "ファイルがなければ作って空を返す", path: filepath.Join(t.TempDir(), "order_history.db"), wantCount: 0},
+		{name: "ファイルが開けなければエラー", path: filepath.Join(t.TempDir(), "not_found", "order_history.db"), hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := (&orderHistory{path: test.path}).All()
+			if len(got) != test.wantCount || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantCount, test.hasError, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package stores
+
+import (
+	"sync"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+var (
+	positionEventStreamSingleton      repositories.PositionEventStreamStore
+	positionEventStreamSingletonMutex sync.Mutex
+)
+
+func GetPositionEventStreamStore() repositories.PositionEventStreamStore {
+	positionEventStreamSingletonMutex.Lock()
+	defer positionEventStreamSingletonMutex.Unlock()
+
+	if positionEventStreamSingleton == nil {
+		positionEventStreamSingleton = &positionEventStream{store: map[int]positionEventStreamChan{}}
+	}
+
+	return positionEventStreamSingleton
+}
+
+type positionEventStream struct {
+	store map[int]positionEventStreamChan
+	seq   int
+	mtx   sync.Mutex
+}
+
+type positionEventStreamChan struct {
+	stream kabuspb.KabusService_GetPositionEventsStreamingServer
+	ch     chan error
+}
+
+func (s *positionEventStream) HasStream() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return len(s.store) > 0
+}
+
+func (s *positionEventStream) All() map[int]kabuspb.KabusService_GetPositionEventsStreamingServer {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make(map[int]kabuspb.KabusService_GetPositionEventsStreamingServer)
+	for i, stream := range s.store {
+		res[i] = stream.stream
+	}
+	return res
+}
+
+func (s *positionEventStream) Add(stream kabuspb.KabusService_GetPositionEventsStreamingServer, ch chan error) int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	seq := s.seq
+	s.store[seq] = positionEventStreamChan{stream: stream, ch: ch}
+	s.seq++
+	return seq
+}
+
+func (s *positionEventStream) Remove(seq int, err error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.store[seq]; !ok {
+		return
+	}
+
+	stream := s.store[seq]
+	stream.ch <- err
+	delete(s.store, seq)
+}
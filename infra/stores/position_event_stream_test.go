@@ -0,0 +1,77 @@
+package stores
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_GetPositionEventStreamStore(t *testing.T) {
+	t.Parallel()
+	got := GetPositionEventStreamStore()
+	want := &positionEventStream{store: map[int]positionEventStreamChan{}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+type testPositionEventStreamServer struct {
+	Foo string
+	grpc.ServerStream
+}
+
+func (t *testPositionEventStreamServer) Send(*kabuspb.PositionEvent) error { return nil }
+
+func Test_positionEventStream_HasStream(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		store *positionEventStream
+		want  bool
+	}{
+		{name: "storeが空ならfalse", store: &positionEventStream{store: map[int]positionEventStreamChan{}}, want: false},
+		{name: "storeに要素があればtrue", store: &positionEventStream{store: map[int]positionEventStreamChan{0: {stream: &testPositionEventStreamServer{}}}}, want: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.store.HasStream()
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_positionEventStream_Add_All(t *testing.T) {
+	t.Parallel()
+	store := &positionEventStream{seq: 1, store: map[int]positionEventStreamChan{0: {stream: &testPositionEventStreamServer{Foo: "foo"}}}}
+	seq := store.Add(&testPositionEventStreamServer{Foo: "bar"}, nil)
+	want := map[int]kabuspb.KabusService_GetPositionEventsStreamingServer{0: &testPositionEventStreamServer{Foo: "foo"}, 1: &testPositionEventStreamServer{Foo: "bar"}}
+	got := store.All()
+	if !reflect.DeepEqual(want, got) || store.seq != 2 || seq != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), want, 2, 1, got, store.seq, seq)
+	}
+}
+
+func Test_positionEventStream_Remove(t *testing.T) {
+	t.Parallel()
+	ch := make(chan error, 1)
+	store := &positionEventStream{store: map[int]positionEventStreamChan{0: {stream: &testPositionEventStreamServer{Foo: "foo"}, ch: ch}, 1: {stream: &testPositionEventStreamServer{Foo: "bar"}}}}
+
+	store.Remove(1000, nil)
+	store.Remove(0, errors.New("error message"))
+	want := map[int]positionEventStreamChan{1: {stream: &testPositionEventStreamServer{Foo: "bar"}}}
+	if !reflect.DeepEqual(want, store.store) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, store.store)
+	}
+	if got := <-ch; got == nil || got.Error() != "error message" {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "error message", got)
+	}
+}
@@ -0,0 +1,119 @@
+package infra
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCert - テスト用の自己署名証明書と秘密鍵を書き出して、そのパスを返す
+func writeTestCert(t *testing.T) (certFile string, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func Test_NewServerTLSConfig(t *testing.T) {
+	t.Parallel()
+	certFile, keyFile := writeTestCert(t)
+	notCertFile := filepath.Join(t.TempDir(), "not_cert.pem")
+	if err := ioutil.WriteFile(notCertFile, []byte("foo"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		certFile       string
+		keyFile        string
+		clientCAFile   string
+		wantClientAuth tls.ClientAuthType
+		hasError       bool
+	}{
+		{name: "証明書と秘密鍵だけならクライアント証明書を要求しない", certFile: certFile, keyFile: keyFile, wantClientAuth: tls.NoClientCert},
+		{name: "クライアント証明書のCAがあればクライアント証明書を必須にする", certFile: certFile, keyFile: keyFile, clientCAFile: certFile, wantClientAuth: tls.RequireAndVerifyClientCert},
+		{name: "秘密鍵がなければエラー", certFile: certFile, hasError: true},
+		{name: "証明書がなければエラー", keyFile: keyFile, hasError: true},
+		{name: "証明書が読めなければエラー", certFile: certFile + ".not_found", keyFile: keyFile, hasError: true},
+		{name: "クライアント証明書のCAが読めなければエラー", certFile: certFile, keyFile: keyFile, clientCAFile: certFile + ".not_found", hasError: true},
+		{name: "クライアント証明書のCAに証明書がなければエラー", certFile: certFile, keyFile: keyFile, clientCAFile: notCertFile, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewServerTLSConfig(test.certFile, test.keyFile, test.clientCAFile)
+			if (err != nil) != test.hasError || (err == nil && (got.ClientAuth != test.wantClientAuth || len(got.Certificates) != 1 || got.MinVersion != tls.VersionTLS12)) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantClientAuth, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_Config_TLSConfig(t *testing.T) {
+	t.Parallel()
+	certFile, keyFile := writeTestCert(t)
+
+	tests := []struct {
+		name     string
+		config   Config
+		wantNil  bool
+		hasError bool
+	}{
+		{name: "証明書の指定がなければnil", config: Config{}, wantNil: true},
+		{name: "証明書と秘密鍵があればTLSの設定を返す", config: Config{TLSCertFile: certFile, TLSKeyFile: keyFile}},
+		{name: "クライアント証明書のCAだけならエラー", config: Config{TLSClientCAFile: certFile}, wantNil: true, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := test.config.TLSConfig()
+			if (got == nil) != test.wantNil || (err != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantNil, test.hasError, got, err)
+			}
+		})
+	}
+}
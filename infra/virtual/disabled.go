@@ -0,0 +1,45 @@
+package virtual
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+// NewDisabledSecurity - 仮想証券会社を使わないときの仮想証券会社、リクエストには全てFailedPreconditionのエラーを返す
+func NewDisabledSecurity() repositories.VirtualSecurity {
+	return &disabled{}
+}
+
+type disabled struct{}
+
+var errDisabled = status.Error(codes.FailedPrecondition, "virtual security is disabled")
+
+func (d *disabled) Orders(context.Context, string, *kabuspb.GetOrdersRequest) (*kabuspb.Orders, error) {
+	return nil, errDisabled
+}
+
+func (d *disabled) Positions(context.Context, string, *kabuspb.GetPositionsRequest) (*kabuspb.Positions, error) {
+	return nil, errDisabled
+}
+
+func (d *disabled) SendOrderStock(context.Context, string, *kabuspb.SendStockOrderRequest) (*kabuspb.OrderResponse, error) {
+	return nil, errDisabled
+}
+
+func (d *disabled) SendOrderMargin(context.Context, string, *kabuspb.SendMarginOrderRequest) (*kabuspb.OrderResponse, error) {
+	return nil, errDisabled
+}
+
+func (d *disabled) CancelOrder(context.Context, string, *kabuspb.CancelOrderRequest) (*kabuspb.OrderResponse, error) {
+	return nil, errDisabled
+}
+
+// SendPrice - 価格情報は捨てる
+func (d *disabled) SendPrice(context.Context, *kabuspb.Board) error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package virtual
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewDisabledSecurity(t *testing.T) {
+	t.Parallel()
+	want := &disabled{}
+	got := NewDisabledSecurity()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_disabled(t *testing.T) {
+	t.Parallel()
+	d := &disabled{}
+	ctx := context.Background()
+	_, err1 := d.Orders(ctx, "", &kabuspb.GetOrdersRequest{})
+	_, err2 := d.Positions(ctx, "", &kabuspb.GetPositionsRequest{})
+	_, err3 := d.SendOrderStock(ctx, "", &kabuspb.SendStockOrderRequest{})
+	_, err4 := d.SendOrderMargin(ctx, "", &kabuspb.SendMarginOrderRequest{})
+	_, err5 := d.CancelOrder(ctx, "", &kabuspb.CancelOrderRequest{})
+	err6 := d.SendPrice(ctx, &kabuspb.Board{})
+
+	want := []codes.Code{codes.FailedPrecondition, codes.FailedPrecondition, codes.FailedPrecondition, codes.FailedPrecondition, codes.FailedPrecondition, codes.OK}
+	got := []codes.Code{status.Code(err1), status.Code(err2), status.Code(err3), status.Code(err4), status.Code(err5), status.Code(err6)}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
@@ -2395,6 +2395,616 @@ func (MarginPremiumType) EnumDescriptor() ([]byte, []int) {
 	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{39}
 }
 
+// 流量制御区分
+//
+//	kabusapiの流量制限の単位
+type ThrottleCategory int32
+
+const (
+	ThrottleCategory_THROTTLE_CATEGORY_UNSPECIFIED ThrottleCategory = 0 // 未指定
+	ThrottleCategory_THROTTLE_CATEGORY_ORDER       ThrottleCategory = 1 // 発注系 (秒間5件)
+	ThrottleCategory_THROTTLE_CATEGORY_WALLET      ThrottleCategory = 2 // 余力系 (秒間10件)
+	ThrottleCategory_THROTTLE_CATEGORY_INFO        ThrottleCategory = 3 // 情報系 (秒間10件)
+)
+
+// Enum value maps for ThrottleCategory.
+var (
+	ThrottleCategory_name = map[int32]string{
+		0: "THROTTLE_CATEGORY_UNSPECIFIED",
+		1: "THROTTLE_CATEGORY_ORDER",
+		2: "THROTTLE_CATEGORY_WALLET",
+		3: "THROTTLE_CATEGORY_INFO",
+	}
+	ThrottleCategory_value = map[string]int32{
+		"THROTTLE_CATEGORY_UNSPECIFIED": 0,
+		"THROTTLE_CATEGORY_ORDER":       1,
+		"THROTTLE_CATEGORY_WALLET":      2,
+		"THROTTLE_CATEGORY_INFO":        3,
+	}
+)
+
+func (x ThrottleCategory) Enum() *ThrottleCategory {
+	p := new(ThrottleCategory)
+	*p = x
+	return p
+}
+
+func (x ThrottleCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ThrottleCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[40].Descriptor()
+}
+
+func (ThrottleCategory) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[40]
+}
+
+func (x ThrottleCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ThrottleCategory.Descriptor instead.
+func (ThrottleCategory) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{40}
+}
+
+// リクエスト優先度
+//
+//	gRPCのメタデータ kabus-priority に名前(REQUEST_PRIORITY_HIGHかHIGH)で指定する
+//	流量制御の待ち行列で優先度の高いリクエストから通す、長く待っているリクエストは徐々に優先度が上がる
+type RequestPriority int32
+
+const (
+	RequestPriority_REQUEST_PRIORITY_UNSPECIFIED RequestPriority = 0 // 未指定 (NORMALと同じ扱い)
+	RequestPriority_REQUEST_PRIORITY_LOW         RequestPriority = 1 // 低
+	RequestPriority_REQUEST_PRIORITY_NORMAL      RequestPriority = 2 // 通常
+	RequestPriority_REQUEST_PRIORITY_HIGH        RequestPriority = 3 // 高
+)
+
+// Enum value maps for RequestPriority.
+var (
+	RequestPriority_name = map[int32]string{
+		0: "REQUEST_PRIORITY_UNSPECIFIED",
+		1: "REQUEST_PRIORITY_LOW",
+		2: "REQUEST_PRIORITY_NORMAL",
+		3: "REQUEST_PRIORITY_HIGH",
+	}
+	RequestPriority_value = map[string]int32{
+		"REQUEST_PRIORITY_UNSPECIFIED": 0,
+		"REQUEST_PRIORITY_LOW":         1,
+		"REQUEST_PRIORITY_NORMAL":      2,
+		"REQUEST_PRIORITY_HIGH":        3,
+	}
+)
+
+func (x RequestPriority) Enum() *RequestPriority {
+	p := new(RequestPriority)
+	*p = x
+	return p
+}
+
+func (x RequestPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[41].Descriptor()
+}
+
+func (RequestPriority) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[41]
+}
+
+func (x RequestPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestPriority.Descriptor instead.
+func (RequestPriority) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{41}
+}
+
+// リクエストエラーの理由
+//
+//	kabusapiのエラーコードのうち既知のものと、HTTPステータスから判断できるものを分類する
+//	gRPCのステータスコードもこの分類に合わせて返す
+type RequestErrorReason int32
+
+const (
+	RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED        RequestErrorReason = 0  // 未分類 (Internal)
+	RequestErrorReason_REQUEST_ERROR_REASON_INVALID_PARAMETER  RequestErrorReason = 1  // パラメータ不正 (InvalidArgument)
+	RequestErrorReason_REQUEST_ERROR_REASON_LOGIN_FAILED       RequestErrorReason = 2  // ログイン認証エラー (Unauthenticated)
+	RequestErrorReason_REQUEST_ERROR_REASON_API_KEY_MISMATCH   RequestErrorReason = 3  // APIキー不一致 (Unauthenticated)
+	RequestErrorReason_REQUEST_ERROR_REASON_NOT_FOUND          RequestErrorReason = 4  // 対象が見つからない (NotFound)
+	RequestErrorReason_REQUEST_ERROR_REASON_ORDER_REJECTED     RequestErrorReason = 5  // 注文の受付拒否 (FailedPrecondition)
+	RequestErrorReason_REQUEST_ERROR_REASON_ALREADY_CANCELED   RequestErrorReason = 6  // 注文が取消済 (FailedPrecondition)
+	RequestErrorReason_REQUEST_ERROR_REASON_TOO_MANY_REQUESTS  RequestErrorReason = 7  // 流量制限超過 (ResourceExhausted)
+	RequestErrorReason_REQUEST_ERROR_REASON_UNAVAILABLE        RequestErrorReason = 8  // kabuステーションが利用できない (Unavailable)
+	RequestErrorReason_REQUEST_ERROR_REASON_INSUFFICIENT_FUNDS RequestErrorReason = 9  // 余力不足 (FailedPrecondition) ※注文の結果コードは発注先ごとに番号が変わるので、今は注文の受付拒否として返す
+	RequestErrorReason_REQUEST_ERROR_REASON_MARKET_CLOSED      RequestErrorReason = 10 // 取引時間外 (FailedPrecondition) ※注文の結果コードは発注先ごとに番号が変わるので、今は注文の受付拒否として返す
+	RequestErrorReason_REQUEST_ERROR_REASON_INVALID_SYMBOL     RequestErrorReason = 11 // 銘柄が見つからない (NotFound)
+)
+
+// Enum value maps for RequestErrorReason.
+var (
+	RequestErrorReason_name = map[int32]string{
+		0:  "REQUEST_ERROR_REASON_UNSPECIFIED",
+		1:  "REQUEST_ERROR_REASON_INVALID_PARAMETER",
+		2:  "REQUEST_ERROR_REASON_LOGIN_FAILED",
+		3:  "REQUEST_ERROR_REASON_API_KEY_MISMATCH",
+		4:  "REQUEST_ERROR_REASON_NOT_FOUND",
+		5:  "REQUEST_ERROR_REASON_ORDER_REJECTED",
+		6:  "REQUEST_ERROR_REASON_ALREADY_CANCELED",
+		7:  "REQUEST_ERROR_REASON_TOO_MANY_REQUESTS",
+		8:  "REQUEST_ERROR_REASON_UNAVAILABLE",
+		9:  "REQUEST_ERROR_REASON_INSUFFICIENT_FUNDS",
+		10: "REQUEST_ERROR_REASON_MARKET_CLOSED",
+		11: "REQUEST_ERROR_REASON_INVALID_SYMBOL",
+	}
+	RequestErrorReason_value = map[string]int32{
+		"REQUEST_ERROR_REASON_UNSPECIFIED":        0,
+		"REQUEST_ERROR_REASON_INVALID_PARAMETER":  1,
+		"REQUEST_ERROR_REASON_LOGIN_FAILED":       2,
+		"REQUEST_ERROR_REASON_API_KEY_MISMATCH":   3,
+		"REQUEST_ERROR_REASON_NOT_FOUND":          4,
+		"REQUEST_ERROR_REASON_ORDER_REJECTED":     5,
+		"REQUEST_ERROR_REASON_ALREADY_CANCELED":   6,
+		"REQUEST_ERROR_REASON_TOO_MANY_REQUESTS":  7,
+		"REQUEST_ERROR_REASON_UNAVAILABLE":        8,
+		"REQUEST_ERROR_REASON_INSUFFICIENT_FUNDS": 9,
+		"REQUEST_ERROR_REASON_MARKET_CLOSED":      10,
+		"REQUEST_ERROR_REASON_INVALID_SYMBOL":     11,
+	}
+)
+
+func (x RequestErrorReason) Enum() *RequestErrorReason {
+	p := new(RequestErrorReason)
+	*p = x
+	return p
+}
+
+func (x RequestErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[42].Descriptor()
+}
+
+func (RequestErrorReason) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[42]
+}
+
+func (x RequestErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestErrorReason.Descriptor instead.
+func (RequestErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{42}
+}
+
+// 権限
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED     Permission = 0 // 未指定
+	Permission_PERMISSION_ALL             Permission = 1 // 全ての呼出し
+	Permission_PERMISSION_MARKET_DATA     Permission = 2 // 時価・板・銘柄などの市場情報の参照と銘柄登録
+	Permission_PERMISSION_ACCOUNT         Permission = 3 // 余力・注文・残高などの口座情報の参照
+	Permission_PERMISSION_VIRTUAL_TRADING Permission = 4 // 仮想証券会社での発注・取消と注文・残高の参照
+	Permission_PERMISSION_STOCK_TRADING   Permission = 5 // 現物の発注と注文の取消
+	Permission_PERMISSION_MARGIN_TRADING  Permission = 6 // 信用の発注と注文の取消
+	Permission_PERMISSION_FUTURE_TRADING  Permission = 7 // 先物の発注と注文の取消
+	Permission_PERMISSION_OPTION_TRADING  Permission = 8 // オプションの発注と注文の取消
+	Permission_PERMISSION_ADMIN           Permission = 9 // トークンの取得・再取得
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "PERMISSION_ALL",
+		2: "PERMISSION_MARKET_DATA",
+		3: "PERMISSION_ACCOUNT",
+		4: "PERMISSION_VIRTUAL_TRADING",
+		5: "PERMISSION_STOCK_TRADING",
+		6: "PERMISSION_MARGIN_TRADING",
+		7: "PERMISSION_FUTURE_TRADING",
+		8: "PERMISSION_OPTION_TRADING",
+		9: "PERMISSION_ADMIN",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED":     0,
+		"PERMISSION_ALL":             1,
+		"PERMISSION_MARKET_DATA":     2,
+		"PERMISSION_ACCOUNT":         3,
+		"PERMISSION_VIRTUAL_TRADING": 4,
+		"PERMISSION_STOCK_TRADING":   5,
+		"PERMISSION_MARGIN_TRADING":  6,
+		"PERMISSION_FUTURE_TRADING":  7,
+		"PERMISSION_OPTION_TRADING":  8,
+		"PERMISSION_ADMIN":           9,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[43].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[43]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{43}
+}
+
+// リスクチェックによる注文の拒否の理由
+type RiskRejectionReason int32
+
+const (
+	RiskRejectionReason_RISK_REJECTION_REASON_UNSPECIFIED         RiskRejectionReason = 0 // 未指定
+	RiskRejectionReason_RISK_REJECTION_REASON_PRODUCT_NOT_ALLOWED RiskRejectionReason = 1 // 許可されていない商品
+	RiskRejectionReason_RISK_REJECTION_REASON_SYMBOL_NOT_ALLOWED  RiskRejectionReason = 2 // 許可されていない銘柄
+	RiskRejectionReason_RISK_REJECTION_REASON_MAX_QUANTITY        RiskRejectionReason = 3 // 1注文の数量の上限超過
+	RiskRejectionReason_RISK_REJECTION_REASON_MAX_NOTIONAL        RiskRejectionReason = 4 // 1注文の代金の上限超過
+	RiskRejectionReason_RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL  RiskRejectionReason = 5 // 1日の代金の上限超過
+	RiskRejectionReason_RISK_REJECTION_REASON_MAX_OPEN_ORDERS     RiskRejectionReason = 6 // 銘柄ごとの未約定の注文数の上限超過
+	RiskRejectionReason_RISK_REJECTION_REASON_PRICE_LIMIT         RiskRejectionReason = 7 // 値幅制限の外の価格
+	RiskRejectionReason_RISK_REJECTION_REASON_PRICE_BAND          RiskRejectionReason = 8 // 現値から離れすぎた価格
+	RiskRejectionReason_RISK_REJECTION_REASON_PRICE_UNKNOWN       RiskRejectionReason = 9 // 代金を計算する価格がわからない
+)
+
+// Enum value maps for RiskRejectionReason.
+var (
+	RiskRejectionReason_name = map[int32]string{
+		0: "RISK_REJECTION_REASON_UNSPECIFIED",
+		1: "RISK_REJECTION_REASON_PRODUCT_NOT_ALLOWED",
+		2: "RISK_REJECTION_REASON_SYMBOL_NOT_ALLOWED",
+		3: "RISK_REJECTION_REASON_MAX_QUANTITY",
+		4: "RISK_REJECTION_REASON_MAX_NOTIONAL",
+		5: "RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL",
+		6: "RISK_REJECTION_REASON_MAX_OPEN_ORDERS",
+		7: "RISK_REJECTION_REASON_PRICE_LIMIT",
+		8: "RISK_REJECTION_REASON_PRICE_BAND",
+		9: "RISK_REJECTION_REASON_PRICE_UNKNOWN",
+	}
+	RiskRejectionReason_value = map[string]int32{
+		"RISK_REJECTION_REASON_UNSPECIFIED":         0,
+		"RISK_REJECTION_REASON_PRODUCT_NOT_ALLOWED": 1,
+		"RISK_REJECTION_REASON_SYMBOL_NOT_ALLOWED":  2,
+		"RISK_REJECTION_REASON_MAX_QUANTITY":        3,
+		"RISK_REJECTION_REASON_MAX_NOTIONAL":        4,
+		"RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL":  5,
+		"RISK_REJECTION_REASON_MAX_OPEN_ORDERS":     6,
+		"RISK_REJECTION_REASON_PRICE_LIMIT":         7,
+		"RISK_REJECTION_REASON_PRICE_BAND":          8,
+		"RISK_REJECTION_REASON_PRICE_UNKNOWN":       9,
+	}
+)
+
+func (x RiskRejectionReason) Enum() *RiskRejectionReason {
+	p := new(RiskRejectionReason)
+	*p = x
+	return p
+}
+
+func (x RiskRejectionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskRejectionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[44].Descriptor()
+}
+
+func (RiskRejectionReason) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[44]
+}
+
+func (x RiskRejectionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskRejectionReason.Descriptor instead.
+func (RiskRejectionReason) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{44}
+}
+
+// 注文イベントの種類
+type OrderEventType int32
+
+const (
+	OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED      OrderEventType = 0 // 未指定
+	OrderEventType_ORDER_EVENT_TYPE_ACCEPTED         OrderEventType = 1 // 受付
+	OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED OrderEventType = 2 // 一部約定
+	OrderEventType_ORDER_EVENT_TYPE_FILLED           OrderEventType = 3 // 全約定
+	OrderEventType_ORDER_EVENT_TYPE_CANCELED         OrderEventType = 4 // 取消
+	OrderEventType_ORDER_EVENT_TYPE_EXPIRED          OrderEventType = 5 // 失効・期限切れ
+	OrderEventType_ORDER_EVENT_TYPE_REJECTED         OrderEventType = 6 // 発注エラー
+)
+
+// Enum value maps for OrderEventType.
+var (
+	OrderEventType_name = map[int32]string{
+		0: "ORDER_EVENT_TYPE_UNSPECIFIED",
+		1: "ORDER_EVENT_TYPE_ACCEPTED",
+		2: "ORDER_EVENT_TYPE_PARTIALLY_FILLED",
+		3: "ORDER_EVENT_TYPE_FILLED",
+		4: "ORDER_EVENT_TYPE_CANCELED",
+		5: "ORDER_EVENT_TYPE_EXPIRED",
+		6: "ORDER_EVENT_TYPE_REJECTED",
+	}
+	OrderEventType_value = map[string]int32{
+		"ORDER_EVENT_TYPE_UNSPECIFIED":      0,
+		"ORDER_EVENT_TYPE_ACCEPTED":         1,
+		"ORDER_EVENT_TYPE_PARTIALLY_FILLED": 2,
+		"ORDER_EVENT_TYPE_FILLED":           3,
+		"ORDER_EVENT_TYPE_CANCELED":         4,
+		"ORDER_EVENT_TYPE_EXPIRED":          5,
+		"ORDER_EVENT_TYPE_REJECTED":         6,
+	}
+)
+
+func (x OrderEventType) Enum() *OrderEventType {
+	p := new(OrderEventType)
+	*p = x
+	return p
+}
+
+func (x OrderEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[45].Descriptor()
+}
+
+func (OrderEventType) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[45]
+}
+
+func (x OrderEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderEventType.Descriptor instead.
+func (OrderEventType) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{45}
+}
+
+// 建玉イベントの種類
+type PositionEventType int32
+
+const (
+	PositionEventType_POSITION_EVENT_TYPE_UNSPECIFIED PositionEventType = 0 // 未指定
+	PositionEventType_POSITION_EVENT_TYPE_OPENED      PositionEventType = 1 // 新規
+	PositionEventType_POSITION_EVENT_TYPE_CHANGED     PositionEventType = 2 // 残数量・拘束数量の変化
+	PositionEventType_POSITION_EVENT_TYPE_CLOSED      PositionEventType = 3 // 全決済
+)
+
+// Enum value maps for PositionEventType.
+var (
+	PositionEventType_name = map[int32]string{
+		0: "POSITION_EVENT_TYPE_UNSPECIFIED",
+		1: "POSITION_EVENT_TYPE_OPENED",
+		2: "POSITION_EVENT_TYPE_CHANGED",
+		3: "POSITION_EVENT_TYPE_CLOSED",
+	}
+	PositionEventType_value = map[string]int32{
+		"POSITION_EVENT_TYPE_UNSPECIFIED": 0,
+		"POSITION_EVENT_TYPE_OPENED":      1,
+		"POSITION_EVENT_TYPE_CHANGED":     2,
+		"POSITION_EVENT_TYPE_CLOSED":      3,
+	}
+)
+
+func (x PositionEventType) Enum() *PositionEventType {
+	p := new(PositionEventType)
+	*p = x
+	return p
+}
+
+func (x PositionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PositionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[46].Descriptor()
+}
+
+func (PositionEventType) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[46]
+}
+
+func (x PositionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PositionEventType.Descriptor instead.
+func (PositionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{46}
+}
+
+// 板情報ストリーミングで送り切れないときの扱い
+type BoardOverflowPolicy int32
+
+const (
+	BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_UNSPECIFIED BoardOverflowPolicy = 0 // 未指定
+	BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST BoardOverflowPolicy = 1 // 一番古い板情報を捨てる
+	BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_CONFLATE    BoardOverflowPolicy = 2 // 同じ銘柄の板情報を最新のもので置き換え、置き換えられなければ一番古い板情報を捨てる
+	BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT  BoardOverflowPolicy = 3 // ストリームをResourceExhaustedで終了する
+)
+
+// Enum value maps for BoardOverflowPolicy.
+var (
+	BoardOverflowPolicy_name = map[int32]string{
+		0: "BOARD_OVERFLOW_POLICY_UNSPECIFIED",
+		1: "BOARD_OVERFLOW_POLICY_DROP_OLDEST",
+		2: "BOARD_OVERFLOW_POLICY_CONFLATE",
+		3: "BOARD_OVERFLOW_POLICY_DISCONNECT",
+	}
+	BoardOverflowPolicy_value = map[string]int32{
+		"BOARD_OVERFLOW_POLICY_UNSPECIFIED": 0,
+		"BOARD_OVERFLOW_POLICY_DROP_OLDEST": 1,
+		"BOARD_OVERFLOW_POLICY_CONFLATE":    2,
+		"BOARD_OVERFLOW_POLICY_DISCONNECT":  3,
+	}
+)
+
+func (x BoardOverflowPolicy) Enum() *BoardOverflowPolicy {
+	p := new(BoardOverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x BoardOverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardOverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[47].Descriptor()
+}
+
+func (BoardOverflowPolicy) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[47]
+}
+
+func (x BoardOverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardOverflowPolicy.Descriptor instead.
+func (BoardOverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{47}
+}
+
+// 板情報websocketの状態
+type BoardWebSocketState int32
+
+const (
+	BoardWebSocketState_BOARD_WEB_SOCKET_STATE_UNSPECIFIED  BoardWebSocketState = 0 // 未指定 ※まだ接続していない
+	BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTING   BoardWebSocketState = 1 // 接続中 ※接続して板情報をまだ受け取っていないときも含む
+	BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED    BoardWebSocketState = 2 // 接続済み
+	BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING BoardWebSocketState = 3 // 切断して再接続を待っている
+	BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CLOSED       BoardWebSocketState = 4 // サーバーの終了で切断した
+)
+
+// Enum value maps for BoardWebSocketState.
+var (
+	BoardWebSocketState_name = map[int32]string{
+		0: "BOARD_WEB_SOCKET_STATE_UNSPECIFIED",
+		1: "BOARD_WEB_SOCKET_STATE_CONNECTING",
+		2: "BOARD_WEB_SOCKET_STATE_CONNECTED",
+		3: "BOARD_WEB_SOCKET_STATE_RECONNECTING",
+		4: "BOARD_WEB_SOCKET_STATE_CLOSED",
+	}
+	BoardWebSocketState_value = map[string]int32{
+		"BOARD_WEB_SOCKET_STATE_UNSPECIFIED":  0,
+		"BOARD_WEB_SOCKET_STATE_CONNECTING":   1,
+		"BOARD_WEB_SOCKET_STATE_CONNECTED":    2,
+		"BOARD_WEB_SOCKET_STATE_RECONNECTING": 3,
+		"BOARD_WEB_SOCKET_STATE_CLOSED":       4,
+	}
+)
+
+func (x BoardWebSocketState) Enum() *BoardWebSocketState {
+	p := new(BoardWebSocketState)
+	*p = x
+	return p
+}
+
+func (x BoardWebSocketState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardWebSocketState) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[48].Descriptor()
+}
+
+func (BoardWebSocketState) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[48]
+}
+
+func (x BoardWebSocketState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardWebSocketState.Descriptor instead.
+func (BoardWebSocketState) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{48}
+}
+
+// 監査ログの記録の種類
+type AuditLogType int32
+
+const (
+	AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED AuditLogType = 0 // 未指定
+	AuditLogType_AUDIT_LOG_TYPE_REQUESTED   AuditLogType = 1 // ハンドラを呼ぶ前のリクエスト
+	AuditLogType_AUDIT_LOG_TYPE_RESPONDED   AuditLogType = 2 // ハンドラの結果
+	AuditLogType_AUDIT_LOG_TYPE_REJECTED    AuditLogType = 3 // 認証か権限の確認で拒否した呼出し
+)
+
+// Enum value maps for AuditLogType.
+var (
+	AuditLogType_name = map[int32]string{
+		0: "AUDIT_LOG_TYPE_UNSPECIFIED",
+		1: "AUDIT_LOG_TYPE_REQUESTED",
+		2: "AUDIT_LOG_TYPE_RESPONDED",
+		3: "AUDIT_LOG_TYPE_REJECTED",
+	}
+	AuditLogType_value = map[string]int32{
+		"AUDIT_LOG_TYPE_UNSPECIFIED": 0,
+		"AUDIT_LOG_TYPE_REQUESTED":   1,
+		"AUDIT_LOG_TYPE_RESPONDED":   2,
+		"AUDIT_LOG_TYPE_REJECTED":    3,
+	}
+)
+
+func (x AuditLogType) Enum() *AuditLogType {
+	p := new(AuditLogType)
+	*p = x
+	return p
+}
+
+func (x AuditLogType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kabuspb_kabus_proto_enumTypes[49].Descriptor()
+}
+
+func (AuditLogType) Type() protoreflect.EnumType {
+	return &file_kabuspb_kabus_proto_enumTypes[49]
+}
+
+func (x AuditLogType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogType.Descriptor instead.
+func (AuditLogType) EnumDescriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{49}
+}
+
 // トークン取得リクエスト
 type GetTokenRequest struct {
 	state         protoimpl.MessageState
@@ -2507,6 +3117,10 @@ type SendStockOrderRequest struct {
 	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
 	// 逆指値条件
 	StopOrder *StockStopOrder `protobuf:"bytes,12,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+	// クライアント注文ID
+	//   指定すると、同じIDでの再送には最初の発注の結果を返し、二重に発注しません
+	//   このサーバーのメモリに保持するので、再起動すると忘れます
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 	// 仮想売買
 	IsVirtual bool `protobuf:"varint,99,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
 }
@@ -2627,6 +3241,13 @@ func (x *SendStockOrderRequest) GetStopOrder() *StockStopOrder {
 	return nil
 }
 
+func (x *SendStockOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
 func (x *SendStockOrderRequest) GetIsVirtual() bool {
 	if x != nil {
 		return x.IsVirtual
@@ -2759,6 +3380,10 @@ type SendMarginOrderRequest struct {
 	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
 	// 逆指値条件
 	StopOrder *MarginStopOrder `protobuf:"bytes,14,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+	// クライアント注文ID
+	//   指定すると、同じIDでの再送には最初の発注の結果を返し、二重に発注しません
+	//   このサーバーのメモリに保持するので、再起動すると忘れます
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 	// 仮想売買
 	IsVirtual bool `protobuf:"varint,99,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
 }
@@ -2900,7 +3525,14 @@ func (x *SendMarginOrderRequest) GetStopOrder() *MarginStopOrder {
 	return nil
 }
 
-func (x *SendMarginOrderRequest) GetIsVirtual() bool {
+func (x *SendMarginOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+func (x *SendMarginOrderRequest) GetIsVirtual() bool {
 	if x != nil {
 		return x.IsVirtual
 	}
@@ -3026,6 +3658,10 @@ type SendFutureOrderRequest struct {
 	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
 	// 逆指値条件
 	StopOrder *FutureStopOrder `protobuf:"bytes,12,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+	// クライアント注文ID
+	//   指定すると、同じIDでの再送には最初の発注の結果を返し、二重に発注しません
+	//   このサーバーのメモリに保持するので、再起動すると忘れます
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 }
 
 func (x *SendFutureOrderRequest) Reset() {
@@ -3144,6 +3780,13 @@ func (x *SendFutureOrderRequest) GetStopOrder() *FutureStopOrder {
 	return nil
 }
 
+func (x *SendFutureOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
 // 逆指値条件(先物)
 type FutureStopOrder struct {
 	state         protoimpl.MessageState
@@ -3254,6 +3897,10 @@ type SendOptionOrderRequest struct {
 	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
 	// 逆指値条件
 	StopOrder *OptionStopOrder `protobuf:"bytes,12,opt,name=stop_order,json=stopOrder,proto3" json:"stop_order,omitempty"`
+	// クライアント注文ID
+	//   指定すると、同じIDでの再送には最初の発注の結果を返し、二重に発注しません
+	//   このサーバーのメモリに保持するので、再起動すると忘れます
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 }
 
 func (x *SendOptionOrderRequest) Reset() {
@@ -3372,6 +4019,13 @@ func (x *SendOptionOrderRequest) GetStopOrder() *OptionStopOrder {
 	return nil
 }
 
+func (x *SendOptionOrderRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
 // 逆指値条件(オプション)
 type OptionStopOrder struct {
 	state         protoimpl.MessageState
@@ -3817,6 +4471,10 @@ type GetBoardRequest struct {
 
 	SymbolCode string   `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
 	Exchange   Exchange `protobuf:"varint,2,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
+	// キャッシュの許容する古さ(ミリ秒)
+	//   0より大きければ、サーバーで保持している板情報がこの時間内に届いていればkabusapiを呼ばずに返します
+	//   板情報は登録銘柄のwebsocketで届いたものだけを保持しています
+	MaxAgeMs int32 `protobuf:"varint,3,opt,name=max_age_ms,json=maxAgeMs,proto3" json:"max_age_ms,omitempty"`
 }
 
 func (x *GetBoardRequest) Reset() {
@@ -3865,6 +4523,13 @@ func (x *GetBoardRequest) GetExchange() Exchange {
 	return Exchange_EXCHANGE_UNSPECIFIED
 }
 
+func (x *GetBoardRequest) GetMaxAgeMs() int32 {
+	if x != nil {
+		return x.MaxAgeMs
+	}
+	return 0
+}
+
 // 銘柄情報リクエスト
 type GetSymbolRequest struct {
 	state         protoimpl.MessageState
@@ -3967,6 +4632,9 @@ type GetOrdersRequest struct {
 	//   指定された取引区分と一致する注文のみレスポンスします
 	//   複数の取引区分を指定することができません
 	TradeType TradeType `protobuf:"varint,8,opt,name=tradeType,proto3,enum=kabuspb.TradeType" json:"tradeType,omitempty"`
+	// クライアント注文ID
+	//   発注時に指定したクライアント注文IDと一致する注文のみレスポンスします
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 	// 仮想売買
 	IsVirtual bool `protobuf:"varint,99,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
 }
@@ -4059,6 +4727,13 @@ func (x *GetOrdersRequest) GetTradeType() TradeType {
 	return TradeType_TRADE_TYPE_UNSPECIFIED
 }
 
+func (x *GetOrdersRequest) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
 func (x *GetOrdersRequest) GetIsVirtual() bool {
 	if x != nil {
 		return x.IsVirtual
@@ -4066,6 +4741,97 @@ func (x *GetOrdersRequest) GetIsVirtual() bool {
 	return false
 }
 
+// 注文履歴リクエスト
+//
+//	指定した条件を全て満たす注文を受注日時の古い順に返す
+type GetOrderHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 受注日時の下限(この日時を含む)
+	//   ゼロ値なら制限しない
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 受注日時の上限(この日時を含まない)
+	//   ゼロ値なら制限しない
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,3,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 商品
+	//   未指定かすべてなら制限しない
+	Product Product `protobuf:"varint,4,opt,name=product,proto3,enum=kabuspb.Product" json:"product,omitempty"`
+	// 状態
+	//   未指定なら制限しない
+	State OrderState `protobuf:"varint,5,opt,name=state,proto3,enum=kabuspb.OrderState" json:"state,omitempty"`
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetOrderHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetOrderHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetOrderHistoryRequest) GetSymbolCode() string {
+	if x != nil {
+		return x.SymbolCode
+	}
+	return ""
+}
+
+func (x *GetOrderHistoryRequest) GetProduct() Product {
+	if x != nil {
+		return x.Product
+	}
+	return Product_PRODUCT_UNSPECIFIED
+}
+
+func (x *GetOrderHistoryRequest) GetState() OrderState {
+	if x != nil {
+		return x.State
+	}
+	return OrderState_ORDER_STATE_UNSPECIFIED
+}
+
 // 残高照会リクエスト
 type GetPositionsRequest struct {
 	state         protoimpl.MessageState
@@ -4080,6 +4846,10 @@ type GetPositionsRequest struct {
 	Side Side `protobuf:"varint,3,opt,name=side,proto3,enum=kabuspb.Side" json:"side,omitempty"`
 	// 追加情報出力フラグ
 	GetInfo bool `protobuf:"varint,4,opt,name=get_info,json=getInfo,proto3" json:"get_info,omitempty"`
+	// キャッシュの許容する古さ(ミリ秒)
+	//   0より大きければ、サーバーで保持している建玉がこの時間内に更新されていればkabusapiを呼ばずに返します
+	//   キャッシュから返すときは、get_infoに関わらず追加情報が入ります
+	MaxAgeMs int32 `protobuf:"varint,98,opt,name=max_age_ms,json=maxAgeMs,proto3" json:"max_age_ms,omitempty"`
 	// 仮想売買
 	IsVirtual bool `protobuf:"varint,99,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
 }
@@ -4087,7 +4857,7 @@ type GetPositionsRequest struct {
 func (x *GetPositionsRequest) Reset() {
 	*x = GetPositionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[19]
+		mi := &file_kabuspb_kabus_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4100,7 +4870,7 @@ func (x *GetPositionsRequest) String() string {
 func (*GetPositionsRequest) ProtoMessage() {}
 
 func (x *GetPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[19]
+	mi := &file_kabuspb_kabus_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4113,7 +4883,7 @@ func (x *GetPositionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPositionsRequest.ProtoReflect.Descriptor instead.
 func (*GetPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{19}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPositionsRequest) GetProduct() Product {
@@ -4144,6 +4914,13 @@ func (x *GetPositionsRequest) GetGetInfo() bool {
 	return false
 }
 
+func (x *GetPositionsRequest) GetMaxAgeMs() int32 {
+	if x != nil {
+		return x.MaxAgeMs
+	}
+	return 0
+}
+
 func (x *GetPositionsRequest) GetIsVirtual() bool {
 	if x != nil {
 		return x.IsVirtual
@@ -4168,7 +4945,7 @@ type GetFutureSymbolCodeInfoRequest struct {
 func (x *GetFutureSymbolCodeInfoRequest) Reset() {
 	*x = GetFutureSymbolCodeInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[20]
+		mi := &file_kabuspb_kabus_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4181,7 +4958,7 @@ func (x *GetFutureSymbolCodeInfoRequest) String() string {
 func (*GetFutureSymbolCodeInfoRequest) ProtoMessage() {}
 
 func (x *GetFutureSymbolCodeInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[20]
+	mi := &file_kabuspb_kabus_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4194,7 +4971,7 @@ func (x *GetFutureSymbolCodeInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFutureSymbolCodeInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetFutureSymbolCodeInfoRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{20}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetFutureSymbolCodeInfoRequest) GetFutureCode() FutureCode {
@@ -4231,7 +5008,7 @@ type GetOptionSymbolCodeInfoRequest struct {
 func (x *GetOptionSymbolCodeInfoRequest) Reset() {
 	*x = GetOptionSymbolCodeInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[21]
+		mi := &file_kabuspb_kabus_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4244,7 +5021,7 @@ func (x *GetOptionSymbolCodeInfoRequest) String() string {
 func (*GetOptionSymbolCodeInfoRequest) ProtoMessage() {}
 
 func (x *GetOptionSymbolCodeInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[21]
+	mi := &file_kabuspb_kabus_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,7 +5034,7 @@ func (x *GetOptionSymbolCodeInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOptionSymbolCodeInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetOptionSymbolCodeInfoRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{21}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetOptionSymbolCodeInfoRequest) GetDerivativeMonth() *timestamppb.Timestamp {
@@ -4296,7 +5073,7 @@ type GetPriceRankingRequest struct {
 func (x *GetPriceRankingRequest) Reset() {
 	*x = GetPriceRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[22]
+		mi := &file_kabuspb_kabus_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4309,7 +5086,7 @@ func (x *GetPriceRankingRequest) String() string {
 func (*GetPriceRankingRequest) ProtoMessage() {}
 
 func (x *GetPriceRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[22]
+	mi := &file_kabuspb_kabus_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4322,7 +5099,7 @@ func (x *GetPriceRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPriceRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetPriceRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{22}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetPriceRankingRequest) GetRankingType() PriceRankingType {
@@ -4352,7 +5129,7 @@ type GetTickRankingRequest struct {
 func (x *GetTickRankingRequest) Reset() {
 	*x = GetTickRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[23]
+		mi := &file_kabuspb_kabus_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4365,7 +5142,7 @@ func (x *GetTickRankingRequest) String() string {
 func (*GetTickRankingRequest) ProtoMessage() {}
 
 func (x *GetTickRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[23]
+	mi := &file_kabuspb_kabus_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4378,7 +5155,7 @@ func (x *GetTickRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTickRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetTickRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{23}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetTickRankingRequest) GetExchangeDivision() ExchangeDivision {
@@ -4401,7 +5178,7 @@ type GetVolumeRankingRequest struct {
 func (x *GetVolumeRankingRequest) Reset() {
 	*x = GetVolumeRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[24]
+		mi := &file_kabuspb_kabus_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4414,7 +5191,7 @@ func (x *GetVolumeRankingRequest) String() string {
 func (*GetVolumeRankingRequest) ProtoMessage() {}
 
 func (x *GetVolumeRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[24]
+	mi := &file_kabuspb_kabus_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4427,7 +5204,7 @@ func (x *GetVolumeRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVolumeRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetVolumeRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{24}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetVolumeRankingRequest) GetExchangeDivision() ExchangeDivision {
@@ -4450,7 +5227,7 @@ type GetValueRankingRequest struct {
 func (x *GetValueRankingRequest) Reset() {
 	*x = GetValueRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[25]
+		mi := &file_kabuspb_kabus_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4463,7 +5240,7 @@ func (x *GetValueRankingRequest) String() string {
 func (*GetValueRankingRequest) ProtoMessage() {}
 
 func (x *GetValueRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[25]
+	mi := &file_kabuspb_kabus_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4476,7 +5253,7 @@ func (x *GetValueRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetValueRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetValueRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{25}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetValueRankingRequest) GetExchangeDivision() ExchangeDivision {
@@ -4501,7 +5278,7 @@ type GetMarginRankingRequest struct {
 func (x *GetMarginRankingRequest) Reset() {
 	*x = GetMarginRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[26]
+		mi := &file_kabuspb_kabus_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4514,7 +5291,7 @@ func (x *GetMarginRankingRequest) String() string {
 func (*GetMarginRankingRequest) ProtoMessage() {}
 
 func (x *GetMarginRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[26]
+	mi := &file_kabuspb_kabus_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4527,7 +5304,7 @@ func (x *GetMarginRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{26}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetMarginRankingRequest) GetRankingType() MarginRankingType {
@@ -4559,7 +5336,7 @@ type GetIndustryRankingRequest struct {
 func (x *GetIndustryRankingRequest) Reset() {
 	*x = GetIndustryRankingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[27]
+		mi := &file_kabuspb_kabus_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4572,7 +5349,7 @@ func (x *GetIndustryRankingRequest) String() string {
 func (*GetIndustryRankingRequest) ProtoMessage() {}
 
 func (x *GetIndustryRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[27]
+	mi := &file_kabuspb_kabus_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4585,7 +5362,7 @@ func (x *GetIndustryRankingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIndustryRankingRequest.ProtoReflect.Descriptor instead.
 func (*GetIndustryRankingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{27}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetIndustryRankingRequest) GetRankingType() IndustryRankingType {
@@ -4615,7 +5392,7 @@ type GetRegisteredSymbolsRequest struct {
 func (x *GetRegisteredSymbolsRequest) Reset() {
 	*x = GetRegisteredSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[28]
+		mi := &file_kabuspb_kabus_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4628,7 +5405,7 @@ func (x *GetRegisteredSymbolsRequest) String() string {
 func (*GetRegisteredSymbolsRequest) ProtoMessage() {}
 
 func (x *GetRegisteredSymbolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[28]
+	mi := &file_kabuspb_kabus_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4641,7 +5418,7 @@ func (x *GetRegisteredSymbolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRegisteredSymbolsRequest.ProtoReflect.Descriptor instead.
 func (*GetRegisteredSymbolsRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{28}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetRegisteredSymbolsRequest) GetRequesterName() string {
@@ -4666,7 +5443,7 @@ type RegisterSymbolsRequest struct {
 func (x *RegisterSymbolsRequest) Reset() {
 	*x = RegisterSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[29]
+		mi := &file_kabuspb_kabus_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4679,7 +5456,7 @@ func (x *RegisterSymbolsRequest) String() string {
 func (*RegisterSymbolsRequest) ProtoMessage() {}
 
 func (x *RegisterSymbolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[29]
+	mi := &file_kabuspb_kabus_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,7 +5469,7 @@ func (x *RegisterSymbolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterSymbolsRequest.ProtoReflect.Descriptor instead.
 func (*RegisterSymbolsRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{29}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RegisterSymbolsRequest) GetRequesterName() string {
@@ -4724,7 +5501,7 @@ type UnregisterSymbolsRequest struct {
 func (x *UnregisterSymbolsRequest) Reset() {
 	*x = UnregisterSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[30]
+		mi := &file_kabuspb_kabus_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4737,7 +5514,7 @@ func (x *UnregisterSymbolsRequest) String() string {
 func (*UnregisterSymbolsRequest) ProtoMessage() {}
 
 func (x *UnregisterSymbolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[30]
+	mi := &file_kabuspb_kabus_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4750,7 +5527,7 @@ func (x *UnregisterSymbolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterSymbolsRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterSymbolsRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{30}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UnregisterSymbolsRequest) GetRequesterName() string {
@@ -4780,7 +5557,7 @@ type UnregisterAllSymbolsRequest struct {
 func (x *UnregisterAllSymbolsRequest) Reset() {
 	*x = UnregisterAllSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[31]
+		mi := &file_kabuspb_kabus_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4793,7 +5570,7 @@ func (x *UnregisterAllSymbolsRequest) String() string {
 func (*UnregisterAllSymbolsRequest) ProtoMessage() {}
 
 func (x *UnregisterAllSymbolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[31]
+	mi := &file_kabuspb_kabus_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4806,7 +5583,7 @@ func (x *UnregisterAllSymbolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterAllSymbolsRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterAllSymbolsRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{31}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UnregisterAllSymbolsRequest) GetRequesterName() string {
@@ -4829,7 +5606,7 @@ type GetExchangeRequest struct {
 func (x *GetExchangeRequest) Reset() {
 	*x = GetExchangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[32]
+		mi := &file_kabuspb_kabus_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4842,7 +5619,7 @@ func (x *GetExchangeRequest) String() string {
 func (*GetExchangeRequest) ProtoMessage() {}
 
 func (x *GetExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[32]
+	mi := &file_kabuspb_kabus_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4855,7 +5632,7 @@ func (x *GetExchangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{32}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetExchangeRequest) GetCurrency() Currency {
@@ -4880,7 +5657,7 @@ type GetRegulationRequest struct {
 func (x *GetRegulationRequest) Reset() {
 	*x = GetRegulationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[33]
+		mi := &file_kabuspb_kabus_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4893,7 +5670,7 @@ func (x *GetRegulationRequest) String() string {
 func (*GetRegulationRequest) ProtoMessage() {}
 
 func (x *GetRegulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[33]
+	mi := &file_kabuspb_kabus_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4906,7 +5683,7 @@ func (x *GetRegulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRegulationRequest.ProtoReflect.Descriptor instead.
 func (*GetRegulationRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{33}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetRegulationRequest) GetSymbolCode() string {
@@ -4936,7 +5713,7 @@ type GetPrimaryExchangeRequest struct {
 func (x *GetPrimaryExchangeRequest) Reset() {
 	*x = GetPrimaryExchangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[34]
+		mi := &file_kabuspb_kabus_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4949,7 +5726,7 @@ func (x *GetPrimaryExchangeRequest) String() string {
 func (*GetPrimaryExchangeRequest) ProtoMessage() {}
 
 func (x *GetPrimaryExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[34]
+	mi := &file_kabuspb_kabus_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4962,7 +5739,7 @@ func (x *GetPrimaryExchangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPrimaryExchangeRequest.ProtoReflect.Descriptor instead.
 func (*GetPrimaryExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{34}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetPrimaryExchangeRequest) GetSymbolCode() string {
@@ -4982,7 +5759,7 @@ type GetSoftLimitRequest struct {
 func (x *GetSoftLimitRequest) Reset() {
 	*x = GetSoftLimitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[35]
+		mi := &file_kabuspb_kabus_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4995,7 +5772,7 @@ func (x *GetSoftLimitRequest) String() string {
 func (*GetSoftLimitRequest) ProtoMessage() {}
 
 func (x *GetSoftLimitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[35]
+	mi := &file_kabuspb_kabus_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5008,7 +5785,7 @@ func (x *GetSoftLimitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSoftLimitRequest.ProtoReflect.Descriptor instead.
 func (*GetSoftLimitRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{35}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{36}
 }
 
 // プレミアム料取得リクエスト
@@ -5024,7 +5801,7 @@ type GetMarginPremiumRequest struct {
 func (x *GetMarginPremiumRequest) Reset() {
 	*x = GetMarginPremiumRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[36]
+		mi := &file_kabuspb_kabus_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5037,7 +5814,7 @@ func (x *GetMarginPremiumRequest) String() string {
 func (*GetMarginPremiumRequest) ProtoMessage() {}
 
 func (x *GetMarginPremiumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[36]
+	mi := &file_kabuspb_kabus_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5050,7 +5827,7 @@ func (x *GetMarginPremiumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMarginPremiumRequest.ProtoReflect.Descriptor instead.
 func (*GetMarginPremiumRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{36}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetMarginPremiumRequest) GetSymbolCode() string {
@@ -5065,12 +5842,23 @@ type GetBoardsStreamingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// 配信する銘柄のリスト
+	//   空なら全ての銘柄を配信する、市場が指定なしなら銘柄コードだけで絞り込む
+	Symbols []*RegisterSymbol `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// ツール名
+	RequesterName string `protobuf:"bytes,2,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"`
+	// ツールが登録した銘柄だけを配信するか
+	//   symbolsも指定したら両方に合う銘柄だけを配信する
+	OnlyRegistered bool `protobuf:"varint,3,opt,name=only_registered,json=onlyRegistered,proto3" json:"only_registered,omitempty"`
+	// 最初にサーバーで保持している条件に合う銘柄の最新の板情報を送ってから配信を始めるか
+	SendSnapshot bool `protobuf:"varint,4,opt,name=send_snapshot,json=sendSnapshot,proto3" json:"send_snapshot,omitempty"`
 }
 
 func (x *GetBoardsStreamingRequest) Reset() {
 	*x = GetBoardsStreamingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[37]
+		mi := &file_kabuspb_kabus_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5083,7 +5871,7 @@ func (x *GetBoardsStreamingRequest) String() string {
 func (*GetBoardsStreamingRequest) ProtoMessage() {}
 
 func (x *GetBoardsStreamingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[37]
+	mi := &file_kabuspb_kabus_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5096,38 +5884,61 @@ func (x *GetBoardsStreamingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBoardsStreamingRequest.ProtoReflect.Descriptor instead.
 func (*GetBoardsStreamingRequest) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{37}
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{38}
 }
 
-// トークン
-type Token struct {
+func (x *GetBoardsStreamingRequest) GetSymbols() []*RegisterSymbol {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *GetBoardsStreamingRequest) GetRequesterName() string {
+	if x != nil {
+		return x.RequesterName
+	}
+	return ""
+}
+
+func (x *GetBoardsStreamingRequest) GetOnlyRegistered() bool {
+	if x != nil {
+		return x.OnlyRegistered
+	}
+	return false
+}
+
+func (x *GetBoardsStreamingRequest) GetSendSnapshot() bool {
+	if x != nil {
+		return x.SendSnapshot
+	}
+	return false
+}
+
+// 注文イベントストリーミングリクエスト
+type GetOrderEventsStreamingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// トークン
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// 有効期限
-	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
 }
 
-func (x *Token) Reset() {
-	*x = Token{}
+func (x *GetOrderEventsStreamingRequest) Reset() {
+	*x = GetOrderEventsStreamingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[38]
+		mi := &file_kabuspb_kabus_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Token) String() string {
+func (x *GetOrderEventsStreamingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Token) ProtoMessage() {}
+func (*GetOrderEventsStreamingRequest) ProtoMessage() {}
 
-func (x *Token) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[38]
+func (x *GetOrderEventsStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5138,201 +5949,74 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Token.ProtoReflect.Descriptor instead.
-func (*Token) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetOrderEventsStreamingRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderEventsStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Token) GetToken() string {
-	if x != nil {
-		return x.Token
+// 建玉イベントストリーミングリクエスト
+type GetPositionEventsStreamingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPositionEventsStreamingRequest) Reset() {
+	*x = GetPositionEventsStreamingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Token) GetExpiredAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiredAt
+func (x *GetPositionEventsStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionEventsStreamingRequest) ProtoMessage() {}
+
+func (x *GetPositionEventsStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// 時価情報・板情報
-type Board struct {
+// Deprecated: Use GetPositionEventsStreamingRequest.ProtoReflect.Descriptor instead.
+func (*GetPositionEventsStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{40}
+}
+
+// 流量制御状態リクエスト
+type GetThrottleStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名
-	SymbolName string `protobuf:"bytes,2,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 市場コード
-	//   株式・先物・オプション銘柄の場合のみ
-	Exchange Exchange `protobuf:"varint,3,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
-	// 市場名称
-	//   株式・先物・オプション銘柄の場合のみ
-	ExchangeName string `protobuf:"bytes,4,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 現値
-	CurrentPrice float64 `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 現値時刻
-	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
-	// 現値前値比較
-	CurrentPriceChangeStatus string `protobuf:"bytes,7,opt,name=current_price_change_status,json=currentPriceChangeStatus,proto3" json:"current_price_change_status,omitempty"` // TODO enum化
-	// 現値ステータス
-	CurrentPriceStatus int32 `protobuf:"varint,8,opt,name=current_price_status,json=currentPriceStatus,proto3" json:"current_price_status,omitempty"` // TODO enum化
-	// 計算用現値
-	CalculationPrice float64 `protobuf:"fixed64,9,opt,name=calculation_price,json=calculationPrice,proto3" json:"calculation_price,omitempty"`
-	// 前日終値
-	PreviousClose float64 `protobuf:"fixed64,10,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
-	// 前日終値日付
-	PreviousCloseTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=previous_close_time,json=previousCloseTime,proto3" json:"previous_close_time,omitempty"`
-	// 前日比
-	ChangePreviousClose float64 `protobuf:"fixed64,12,opt,name=change_previous_close,json=changePreviousClose,proto3" json:"change_previous_close,omitempty"`
-	// 騰落率
-	ChangePreviousClosePer float64 `protobuf:"fixed64,13,opt,name=change_previous_close_per,json=changePreviousClosePer,proto3" json:"change_previous_close_per,omitempty"`
-	// 始値
-	OpeningPrice float64 `protobuf:"fixed64,14,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
-	// 始値時刻
-	OpeningPriceTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=opening_price_time,json=openingPriceTime,proto3" json:"opening_price_time,omitempty"`
-	// 高値
-	HighPrice float64 `protobuf:"fixed64,16,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
-	// 高値時刻
-	HighPriceTime *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=high_price_time,json=highPriceTime,proto3" json:"high_price_time,omitempty"`
-	// 安値
-	LowPrice float64 `protobuf:"fixed64,18,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
-	// 安値時刻
-	LowPriceTime *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=low_price_time,json=lowPriceTime,proto3" json:"low_price_time,omitempty"`
-	// 売買高
-	//   株式・先物・オプション銘柄の場合のみ
-	TradingVolume float64 `protobuf:"fixed64,20,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
-	// 売買高時刻
-	//   株式・先物・オプション銘柄の場合のみ
-	TradingVolumeTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=trading_volume_time,json=tradingVolumeTime,proto3" json:"trading_volume_time,omitempty"`
-	// 売買高加重平均価格（VWAP）
-	//   株式・先物・オプション銘柄の場合のみ
-	Vwap float64 `protobuf:"fixed64,22,opt,name=vwap,proto3" json:"vwap,omitempty"`
-	// 売買代金
-	//   株式・先物・オプション銘柄の場合のみ
-	TradingValue float64 `protobuf:"fixed64,23,opt,name=trading_value,json=tradingValue,proto3" json:"trading_value,omitempty"`
-	// 最良買気配数量
-	//   株式・先物・オプション銘柄の場合のみ
-	BidQuantity float64 `protobuf:"fixed64,24,opt,name=bid_quantity,json=bidQuantity,proto3" json:"bid_quantity,omitempty"`
-	// 最良買気配値段
-	//   株式・先物・オプション銘柄の場合のみ
-	BidPrice float64 `protobuf:"fixed64,25,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
-	// 最良買気配時刻
-	//  株式銘柄の場合のみ
-	BidTime *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
-	// 最良買気配フラグ
-	//   株式・先物・オプション銘柄の場合のみ
-	BidSign string `protobuf:"bytes,27,opt,name=bid_sign,json=bidSign,proto3" json:"bid_sign,omitempty"` // TODO enum化
-	// 売成行数量
-	//   株式銘柄の場合のみ
-	MarketOrderSellQuantity float64 `protobuf:"fixed64,28,opt,name=market_order_sell_quantity,json=marketOrderSellQuantity,proto3" json:"market_order_sell_quantity,omitempty"`
-	// 売気配数量1本目
-	Sell1 *FirstQuote `protobuf:"bytes,29,opt,name=sell1,proto3" json:"sell1,omitempty"`
-	// 売気配数量2本目
-	Sell2 *Quote `protobuf:"bytes,30,opt,name=sell2,proto3" json:"sell2,omitempty"`
-	// 売気配数量3本目
-	Sell3 *Quote `protobuf:"bytes,31,opt,name=sell3,proto3" json:"sell3,omitempty"`
-	// 売気配数量4本目
-	Sell4 *Quote `protobuf:"bytes,32,opt,name=sell4,proto3" json:"sell4,omitempty"`
-	// 売気配数量5本目
-	Sell5 *Quote `protobuf:"bytes,33,opt,name=sell5,proto3" json:"sell5,omitempty"`
-	// 売気配数量6本目
-	Sell6 *Quote `protobuf:"bytes,34,opt,name=sell6,proto3" json:"sell6,omitempty"`
-	// 売気配数量7本目
-	Sell7 *Quote `protobuf:"bytes,35,opt,name=sell7,proto3" json:"sell7,omitempty"`
-	// 売気配数量8本目
-	Sell8 *Quote `protobuf:"bytes,36,opt,name=sell8,proto3" json:"sell8,omitempty"`
-	// 売気配数量9本目
-	Sell9 *Quote `protobuf:"bytes,37,opt,name=sell9,proto3" json:"sell9,omitempty"`
-	// 売気配数量10本目
-	Sell10 *Quote `protobuf:"bytes,38,opt,name=sell10,proto3" json:"sell10,omitempty"`
-	// 最良売気配数量
-	//   株式・先物・オプション銘柄の場合のみ
-	AskQuantity float64 `protobuf:"fixed64,39,opt,name=ask_quantity,json=askQuantity,proto3" json:"ask_quantity,omitempty"`
-	// 最良売気配値段
-	//   株式・先物・オプション銘柄の場合のみ
-	AskPrice float64 `protobuf:"fixed64,40,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
-	// 最良売気配時刻
-	//  株式銘柄の場合のみ
-	AskTime *timestamppb.Timestamp `protobuf:"bytes,41,opt,name=ask_time,json=askTime,proto3" json:"ask_time,omitempty"`
-	// 最良売気配フラグ
-	//   株式・先物・オプション銘柄の場合のみ
-	AskSign string `protobuf:"bytes,42,opt,name=ask_sign,json=askSign,proto3" json:"ask_sign,omitempty"` // TODO enum化
-	// 買成行数量
-	//   株式銘柄の場合のみ
-	MarketOrderBuyQuantity float64 `protobuf:"fixed64,43,opt,name=market_order_buy_quantity,json=marketOrderBuyQuantity,proto3" json:"market_order_buy_quantity,omitempty"`
-	// 買気配数量1本目
-	Buy1 *FirstQuote `protobuf:"bytes,44,opt,name=buy1,proto3" json:"buy1,omitempty"`
-	// 買気配数量2本目
-	Buy2 *Quote `protobuf:"bytes,45,opt,name=buy2,proto3" json:"buy2,omitempty"`
-	// 買気配数量3本目
-	Buy3 *Quote `protobuf:"bytes,46,opt,name=buy3,proto3" json:"buy3,omitempty"`
-	// 買気配数量4本目
-	Buy4 *Quote `protobuf:"bytes,47,opt,name=buy4,proto3" json:"buy4,omitempty"`
-	// 買気配数量5本目
-	Buy5 *Quote `protobuf:"bytes,48,opt,name=buy5,proto3" json:"buy5,omitempty"`
-	// 買気配数量6本目
-	Buy6 *Quote `protobuf:"bytes,49,opt,name=buy6,proto3" json:"buy6,omitempty"`
-	// 買気配数量7本目
-	Buy7 *Quote `protobuf:"bytes,50,opt,name=buy7,proto3" json:"buy7,omitempty"`
-	// 買気配数量8本目
-	Buy8 *Quote `protobuf:"bytes,51,opt,name=buy8,proto3" json:"buy8,omitempty"`
-	// 買気配数量9本目
-	Buy9 *Quote `protobuf:"bytes,52,opt,name=buy9,proto3" json:"buy9,omitempty"`
-	// 買気配数量10本目
-	Buy10 *Quote `protobuf:"bytes,53,opt,name=buy10,proto3" json:"buy10,omitempty"`
-	// OVER気配数量
-	//   株式銘柄の場合のみ
-	OverSellQuantity float64 `protobuf:"fixed64,54,opt,name=over_sell_quantity,json=overSellQuantity,proto3" json:"over_sell_quantity,omitempty"`
-	// UNDER気配数量
-	//   株式銘柄の場合のみ
-	UnderBuyQuantity float64 `protobuf:"fixed64,55,opt,name=under_buy_quantity,json=underBuyQuantity,proto3" json:"under_buy_quantity,omitempty"`
-	// 時価総額
-	//   株式銘柄の場合のみ
-	TotalMarketValue float64 `protobuf:"fixed64,56,opt,name=total_market_value,json=totalMarketValue,proto3" json:"total_market_value,omitempty"`
-	// 清算値
-	//   先物銘柄の場合のみ
-	ClearingPrice float64 `protobuf:"fixed64,57,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
-	// インプライド・ボラティリティ
-	//   オプション銘柄かつ日通しの場合のみ
-	ImpliedVolatility float64 `protobuf:"fixed64,58,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"`
-	// ガンマ
-	//   オプション銘柄かつ日通しの場合のみ
-	Gamma float64 `protobuf:"fixed64,59,opt,name=gamma,proto3" json:"gamma,omitempty"`
-	// セータ
-	//   オプション銘柄かつ日通しの場合のみ
-	Theta float64 `protobuf:"fixed64,60,opt,name=theta,proto3" json:"theta,omitempty"`
-	// ベガ
-	//   オプション銘柄かつ日通しの場合のみ
-	Vega float64 `protobuf:"fixed64,61,opt,name=vega,proto3" json:"vega,omitempty"`
-	// デルタ
-	//   オプション銘柄かつ日通しの場合のみ
-	Delta float64 `protobuf:"fixed64,62,opt,name=delta,proto3" json:"delta,omitempty"`
-	// 銘柄種別
-	SecurityType SecurityType `protobuf:"varint,63,opt,name=security_type,json=securityType,proto3,enum=kabuspb.SecurityType" json:"security_type,omitempty"`
 }
 
-func (x *Board) Reset() {
-	*x = Board{}
+func (x *GetThrottleStatusRequest) Reset() {
+	*x = GetThrottleStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[39]
+		mi := &file_kabuspb_kabus_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Board) String() string {
+func (x *GetThrottleStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Board) ProtoMessage() {}
+func (*GetThrottleStatusRequest) ProtoMessage() {}
 
-func (x *Board) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[39]
+func (x *GetThrottleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5343,551 +6027,663 @@ func (x *Board) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Board.ProtoReflect.Descriptor instead.
-func (*Board) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetThrottleStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetThrottleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Board) GetSymbolCode() string {
-	if x != nil {
-		return x.SymbolCode
-	}
-	return ""
+// 板情報ストリーミングの配信状況リクエスト
+type GetBoardStreamStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Board) GetSymbolName() string {
-	if x != nil {
-		return x.SymbolName
+func (x *GetBoardStreamStatusRequest) Reset() {
+	*x = GetBoardStreamStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Board) GetExchange() Exchange {
-	if x != nil {
-		return x.Exchange
-	}
-	return Exchange_EXCHANGE_UNSPECIFIED
+func (x *GetBoardStreamStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
+func (*GetBoardStreamStatusRequest) ProtoMessage() {}
+
+func (x *GetBoardStreamStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetCurrentPrice() float64 {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return 0
+// Deprecated: Use GetBoardStreamStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardStreamStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Board) GetCurrentPriceTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CurrentPriceTime
-	}
-	return nil
+// 板情報websocketの接続状態リクエスト
+type GetBoardWebSocketStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Board) GetCurrentPriceChangeStatus() string {
-	if x != nil {
-		return x.CurrentPriceChangeStatus
+func (x *GetBoardWebSocketStatusRequest) Reset() {
+	*x = GetBoardWebSocketStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Board) GetCurrentPriceStatus() int32 {
-	if x != nil {
-		return x.CurrentPriceStatus
-	}
-	return 0
+func (x *GetBoardWebSocketStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetCalculationPrice() float64 {
-	if x != nil {
-		return x.CalculationPrice
+func (*GetBoardWebSocketStatusRequest) ProtoMessage() {}
+
+func (x *GetBoardWebSocketStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetPreviousClose() float64 {
-	if x != nil {
-		return x.PreviousClose
-	}
-	return 0
+// Deprecated: Use GetBoardWebSocketStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardWebSocketStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Board) GetPreviousCloseTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.PreviousCloseTime
-	}
-	return nil
+// kabusapi利用状況リクエスト
+type GetUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ツール名
+	//   空なら全ツール分を返す
+	RequesterName string `protobuf:"bytes,1,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"`
 }
 
-func (x *Board) GetChangePreviousClose() float64 {
-	if x != nil {
-		return x.ChangePreviousClose
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Board) GetChangePreviousClosePer() float64 {
-	if x != nil {
-		return x.ChangePreviousClosePer
-	}
-	return 0
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetOpeningPrice() float64 {
-	if x != nil {
-		return x.OpeningPrice
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetOpeningPriceTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.OpeningPriceTime
-	}
-	return nil
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Board) GetHighPrice() float64 {
+func (x *GetUsageRequest) GetRequesterName() string {
 	if x != nil {
-		return x.HighPrice
+		return x.RequesterName
 	}
-	return 0
+	return ""
 }
 
-func (x *Board) GetHighPriceTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.HighPriceTime
-	}
-	return nil
+// kabuステーションの死活状態リクエスト
+type GetStationStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Board) GetLowPrice() float64 {
-	if x != nil {
-		return x.LowPrice
+func (x *GetStationStatusRequest) Reset() {
+	*x = GetStationStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Board) GetLowPriceTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LowPriceTime
-	}
-	return nil
+func (x *GetStationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetTradingVolume() float64 {
-	if x != nil {
-		return x.TradingVolume
+func (*GetStationStatusRequest) ProtoMessage() {}
+
+func (x *GetStationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetTradingVolumeTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TradingVolumeTime
-	}
-	return nil
+// Deprecated: Use GetStationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Board) GetVwap() float64 {
-	if x != nil {
-		return x.Vwap
-	}
-	return 0
+// 緊急停止リクエスト
+type ActivateKillSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 停止の理由
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 未約定の注文を全て取り消すか
+	CancelOrders bool `protobuf:"varint,2,opt,name=cancel_orders,json=cancelOrders,proto3" json:"cancel_orders,omitempty"`
+	// パスワード
+	//   注文の取消に使う
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// 仮想証券会社だけにするか
+	//   停止中の現物・信用の発注を拒否せずに仮想証券会社に送る
+	VirtualOnly bool `protobuf:"varint,4,opt,name=virtual_only,json=virtualOnly,proto3" json:"virtual_only,omitempty"`
 }
 
-func (x *Board) GetTradingValue() float64 {
-	if x != nil {
-		return x.TradingValue
+func (x *ActivateKillSwitchRequest) Reset() {
+	*x = ActivateKillSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Board) GetBidQuantity() float64 {
-	if x != nil {
-		return x.BidQuantity
-	}
-	return 0
+func (x *ActivateKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetBidPrice() float64 {
-	if x != nil {
-		return x.BidPrice
+func (*ActivateKillSwitchRequest) ProtoMessage() {}
+
+func (x *ActivateKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetBidTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.BidTime
-	}
-	return nil
+// Deprecated: Use ActivateKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*ActivateKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Board) GetBidSign() string {
+func (x *ActivateKillSwitchRequest) GetReason() string {
 	if x != nil {
-		return x.BidSign
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Board) GetMarketOrderSellQuantity() float64 {
+func (x *ActivateKillSwitchRequest) GetCancelOrders() bool {
 	if x != nil {
-		return x.MarketOrderSellQuantity
+		return x.CancelOrders
 	}
-	return 0
+	return false
 }
 
-func (x *Board) GetSell1() *FirstQuote {
+func (x *ActivateKillSwitchRequest) GetPassword() string {
 	if x != nil {
-		return x.Sell1
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *Board) GetSell2() *Quote {
+func (x *ActivateKillSwitchRequest) GetVirtualOnly() bool {
 	if x != nil {
-		return x.Sell2
+		return x.VirtualOnly
 	}
-	return nil
+	return false
 }
 
-func (x *Board) GetSell3() *Quote {
-	if x != nil {
-		return x.Sell3
-	}
-	return nil
+// 緊急停止の解除リクエスト
+type DeactivateKillSwitchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Board) GetSell4() *Quote {
-	if x != nil {
-		return x.Sell4
+func (x *DeactivateKillSwitchRequest) Reset() {
+	*x = DeactivateKillSwitchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Board) GetSell5() *Quote {
-	if x != nil {
-		return x.Sell5
-	}
-	return nil
+func (x *DeactivateKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetSell6() *Quote {
-	if x != nil {
-		return x.Sell6
+func (*DeactivateKillSwitchRequest) ProtoMessage() {}
+
+func (x *DeactivateKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetSell7() *Quote {
-	if x != nil {
-		return x.Sell7
-	}
-	return nil
+// Deprecated: Use DeactivateKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Board) GetSell8() *Quote {
-	if x != nil {
-		return x.Sell8
-	}
-	return nil
+// 緊急停止の状態リクエスト
+type GetKillSwitchStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Board) GetSell9() *Quote {
-	if x != nil {
-		return x.Sell9
+func (x *GetKillSwitchStatusRequest) Reset() {
+	*x = GetKillSwitchStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Board) GetSell10() *Quote {
-	if x != nil {
-		return x.Sell10
-	}
-	return nil
+func (x *GetKillSwitchStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetAskQuantity() float64 {
-	if x != nil {
-		return x.AskQuantity
+func (*GetKillSwitchStatusRequest) ProtoMessage() {}
+
+func (x *GetKillSwitchStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetAskPrice() float64 {
-	if x != nil {
-		return x.AskPrice
-	}
-	return 0
+// Deprecated: Use GetKillSwitchStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetKillSwitchStatusRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Board) GetAskTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AskTime
-	}
-	return nil
-}
-
-func (x *Board) GetAskSign() string {
-	if x != nil {
-		return x.AskSign
-	}
-	return ""
-}
-
-func (x *Board) GetMarketOrderBuyQuantity() float64 {
-	if x != nil {
-		return x.MarketOrderBuyQuantity
-	}
-	return 0
-}
-
-func (x *Board) GetBuy1() *FirstQuote {
-	if x != nil {
-		return x.Buy1
-	}
-	return nil
-}
+// 監査ログ取得リクエスト
+//
+//	指定した条件を全て満たす記録を古い順に返す
+type GetAuditLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Board) GetBuy2() *Quote {
-	if x != nil {
-		return x.Buy2
-	}
-	return nil
+	// 呼び出した日時の下限(この日時を含む)
+	//   ゼロ値なら制限しない
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 呼び出した日時の上限(この日時を含まない)
+	//   ゼロ値なら制限しない
+	To *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,3,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// ツール
+	//   認証したツール名かメタデータで指定したツール名のどちらかが一致すれば返す
+	Requester string `protobuf:"bytes,4,opt,name=requester,proto3" json:"requester,omitempty"`
 }
 
-func (x *Board) GetBuy3() *Quote {
-	if x != nil {
-		return x.Buy3
+func (x *GetAuditLogsRequest) Reset() {
+	*x = GetAuditLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Board) GetBuy4() *Quote {
-	if x != nil {
-		return x.Buy4
-	}
-	return nil
+func (x *GetAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetBuy5() *Quote {
-	if x != nil {
-		return x.Buy5
-	}
-	return nil
-}
+func (*GetAuditLogsRequest) ProtoMessage() {}
 
-func (x *Board) GetBuy6() *Quote {
-	if x != nil {
-		return x.Buy6
+func (x *GetAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetBuy7() *Quote {
-	if x != nil {
-		return x.Buy7
-	}
-	return nil
+// Deprecated: Use GetAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Board) GetBuy8() *Quote {
+func (x *GetAuditLogsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Buy8
+		return x.From
 	}
 	return nil
 }
 
-func (x *Board) GetBuy9() *Quote {
+func (x *GetAuditLogsRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Buy9
+		return x.To
 	}
 	return nil
 }
 
-func (x *Board) GetBuy10() *Quote {
+func (x *GetAuditLogsRequest) GetSymbolCode() string {
 	if x != nil {
-		return x.Buy10
+		return x.SymbolCode
 	}
-	return nil
+	return ""
 }
 
-func (x *Board) GetOverSellQuantity() float64 {
+func (x *GetAuditLogsRequest) GetRequester() string {
 	if x != nil {
-		return x.OverSellQuantity
+		return x.Requester
 	}
-	return 0
+	return ""
 }
 
-func (x *Board) GetUnderBuyQuantity() float64 {
-	if x != nil {
-		return x.UnderBuyQuantity
-	}
-	return 0
-}
+// トークン
+type Token struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Board) GetTotalMarketValue() float64 {
-	if x != nil {
-		return x.TotalMarketValue
-	}
-	return 0
+	// トークン
+	//   kabusapiを直接呼べてしまうので、GetToken・RefreshTokenでは空にする
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// 有効期限
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
 }
 
-func (x *Board) GetClearingPrice() float64 {
-	if x != nil {
-		return x.ClearingPrice
+func (x *Token) Reset() {
+	*x = Token{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Board) GetImpliedVolatility() float64 {
-	if x != nil {
-		return x.ImpliedVolatility
-	}
-	return 0
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Board) GetGamma() float64 {
-	if x != nil {
-		return x.Gamma
-	}
-	return 0
-}
+func (*Token) ProtoMessage() {}
 
-func (x *Board) GetTheta() float64 {
-	if x != nil {
-		return x.Theta
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Board) GetVega() float64 {
-	if x != nil {
-		return x.Vega
-	}
-	return 0
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Board) GetDelta() float64 {
+func (x *Token) GetToken() string {
 	if x != nil {
-		return x.Delta
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *Board) GetSecurityType() SecurityType {
+func (x *Token) GetExpiredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SecurityType
+		return x.ExpiredAt
 	}
-	return SecurityType_SECURITY_TYPE_UNSPECIFIED
+	return nil
 }
 
-// 銘柄
-type Symbol struct {
+// 時価情報・板情報
+type Board struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 銘柄コード
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
 	// 銘柄名
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// 銘柄略称
-	//   ※株式・先物・オプション銘柄の場合のみ
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	SymbolName string `protobuf:"bytes,2,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
 	// 市場コード
 	//   株式・先物・オプション銘柄の場合のみ
-	Exchange Exchange `protobuf:"varint,4,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
+	Exchange Exchange `protobuf:"varint,3,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
 	// 市場名称
 	//   株式・先物・オプション銘柄の場合のみ
-	ExchangeName string `protobuf:"bytes,5,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 業種コード名
-	//   株式銘柄の場合のみ
-	IndustryCategory string `protobuf:"bytes,6,opt,name=industry_category,json=industryCategory,proto3" json:"industry_category,omitempty"` // TODO enum
-	// 時価総額
-	//   株式銘柄の場合のみ
-	TotalMarketValue float64 `protobuf:"fixed64,7,opt,name=total_market_value,json=totalMarketValue,proto3" json:"total_market_value,omitempty"`
-	// 発行済み株式数（千株）
-	//   株式銘柄の場合のみ
-	TotalStocks float64 `protobuf:"fixed64,8,opt,name=total_stocks,json=totalStocks,proto3" json:"total_stocks,omitempty"`
-	// 売買単位
+	ExchangeName string `protobuf:"bytes,4,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 現値
+	CurrentPrice float64 `protobuf:"fixed64,5,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// 現値時刻
+	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// 現値前値比較
+	CurrentPriceChangeStatus string `protobuf:"bytes,7,opt,name=current_price_change_status,json=currentPriceChangeStatus,proto3" json:"current_price_change_status,omitempty"` // TODO enum化
+	// 現値ステータス
+	CurrentPriceStatus int32 `protobuf:"varint,8,opt,name=current_price_status,json=currentPriceStatus,proto3" json:"current_price_status,omitempty"` // TODO enum化
+	// 計算用現値
+	CalculationPrice float64 `protobuf:"fixed64,9,opt,name=calculation_price,json=calculationPrice,proto3" json:"calculation_price,omitempty"`
+	// 前日終値
+	PreviousClose float64 `protobuf:"fixed64,10,opt,name=previous_close,json=previousClose,proto3" json:"previous_close,omitempty"`
+	// 前日終値日付
+	PreviousCloseTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=previous_close_time,json=previousCloseTime,proto3" json:"previous_close_time,omitempty"`
+	// 前日比
+	ChangePreviousClose float64 `protobuf:"fixed64,12,opt,name=change_previous_close,json=changePreviousClose,proto3" json:"change_previous_close,omitempty"`
+	// 騰落率
+	ChangePreviousClosePer float64 `protobuf:"fixed64,13,opt,name=change_previous_close_per,json=changePreviousClosePer,proto3" json:"change_previous_close_per,omitempty"`
+	// 始値
+	OpeningPrice float64 `protobuf:"fixed64,14,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
+	// 始値時刻
+	OpeningPriceTime *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=opening_price_time,json=openingPriceTime,proto3" json:"opening_price_time,omitempty"`
+	// 高値
+	HighPrice float64 `protobuf:"fixed64,16,opt,name=high_price,json=highPrice,proto3" json:"high_price,omitempty"`
+	// 高値時刻
+	HighPriceTime *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=high_price_time,json=highPriceTime,proto3" json:"high_price_time,omitempty"`
+	// 安値
+	LowPrice float64 `protobuf:"fixed64,18,opt,name=low_price,json=lowPrice,proto3" json:"low_price,omitempty"`
+	// 安値時刻
+	LowPriceTime *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=low_price_time,json=lowPriceTime,proto3" json:"low_price_time,omitempty"`
+	// 売買高
 	//   株式・先物・オプション銘柄の場合のみ
-	TradingUnit float64 `protobuf:"fixed64,9,opt,name=trading_unit,json=tradingUnit,proto3" json:"trading_unit,omitempty"`
-	// 決算期日
-	//   株式銘柄の場合のみ
-	FiscalYearEndBasic *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=fiscal_year_end_basic,json=fiscalYearEndBasic,proto3" json:"fiscal_year_end_basic,omitempty"`
-	// 呼値グループ
+	TradingVolume float64 `protobuf:"fixed64,20,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
+	// 売買高時刻
 	//   株式・先物・オプション銘柄の場合のみ
-	PriceRangeGroup string `protobuf:"bytes,11,opt,name=price_range_group,json=priceRangeGroup,proto3" json:"price_range_group,omitempty"` // todo enum
-	// 一般信用買建フラグ
-	//   trueのとき一般信用買建可能
-	//   株式銘柄の場合のみ
-	KabucomMarginBuy bool `protobuf:"varint,12,opt,name=kabucom_margin_buy,json=kabucomMarginBuy,proto3" json:"kabucom_margin_buy,omitempty"`
-	// 一般信用売建フラグ
-	//   trueのとき一般信用売建可能
-	//   株式銘柄の場合のみ
-	KabucomMarginSell bool `protobuf:"varint,13,opt,name=kabucom_margin_sell,json=kabucomMarginSell,proto3" json:"kabucom_margin_sell,omitempty"`
-	// 制度信用買建フラグ
-	//   trueのとき制度信用買建可能
-	//   株式銘柄の場合のみ
-	MarginBuy bool `protobuf:"varint,14,opt,name=margin_buy,json=marginBuy,proto3" json:"margin_buy,omitempty"`
-	// 制度信用売建フラグ
-	//   trueのとき制度信用売建可能
-	//   株式銘柄の場合のみ
-	MarginSell bool `protobuf:"varint,15,opt,name=margin_sell,json=marginSell,proto3" json:"margin_sell,omitempty"`
-	// 値幅上限
+	TradingVolumeTime *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=trading_volume_time,json=tradingVolumeTime,proto3" json:"trading_volume_time,omitempty"`
+	// 売買高加重平均価格（VWAP）
 	//   株式・先物・オプション銘柄の場合のみ
-	UpperLimit float64 `protobuf:"fixed64,16,opt,name=upper_limit,json=upperLimit,proto3" json:"upper_limit,omitempty"`
-	// 値幅下限
+	Vwap float64 `protobuf:"fixed64,22,opt,name=vwap,proto3" json:"vwap,omitempty"`
+	// 売買代金
 	//   株式・先物・オプション銘柄の場合のみ
-	LowerLimit float64 `protobuf:"fixed64,17,opt,name=lower_limit,json=lowerLimit,proto3" json:"lower_limit,omitempty"`
-	// 原資産コード
-	//   先物・オプション銘柄の場合のみ
-	Underlyer string `protobuf:"bytes,18,opt,name=underlyer,proto3" json:"underlyer,omitempty"` // todo enum
-	// 限月-年月
-	//   先物・オプション銘柄の場合のみ
-	DerivativeMonth *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=derivative_month,json=derivativeMonth,proto3" json:"derivative_month,omitempty"`
-	// 取引開始日
-	//   先物・オプション銘柄の場合のみ
-	TradeStart *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=trade_start,json=tradeStart,proto3" json:"trade_start,omitempty"`
-	// 取引終了日
-	//   先物・オプション銘柄の場合のみ
-	TradeEnd *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=trade_end,json=tradeEnd,proto3" json:"trade_end,omitempty"`
-	// 権利行使価格
-	//   オプション銘柄の場合のみ
-	StrikePrice float64 `protobuf:"fixed64,22,opt,name=strike_price,json=strikePrice,proto3" json:"strike_price,omitempty"`
-	// プット/コール区分
-	//   オプション銘柄の場合のみ
-	CallOrPut CallPut `protobuf:"varint,23,opt,name=call_or_put,json=callOrPut,proto3,enum=kabuspb.CallPut" json:"call_or_put,omitempty"`
+	TradingValue float64 `protobuf:"fixed64,23,opt,name=trading_value,json=tradingValue,proto3" json:"trading_value,omitempty"`
+	// 最良買気配数量
+	//   株式・先物・オプション銘柄の場合のみ
+	BidQuantity float64 `protobuf:"fixed64,24,opt,name=bid_quantity,json=bidQuantity,proto3" json:"bid_quantity,omitempty"`
+	// 最良買気配値段
+	//   株式・先物・オプション銘柄の場合のみ
+	BidPrice float64 `protobuf:"fixed64,25,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	// 最良買気配時刻
+	//  株式銘柄の場合のみ
+	BidTime *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	// 最良買気配フラグ
+	//   株式・先物・オプション銘柄の場合のみ
+	BidSign string `protobuf:"bytes,27,opt,name=bid_sign,json=bidSign,proto3" json:"bid_sign,omitempty"` // TODO enum化
+	// 売成行数量
+	//   株式銘柄の場合のみ
+	MarketOrderSellQuantity float64 `protobuf:"fixed64,28,opt,name=market_order_sell_quantity,json=marketOrderSellQuantity,proto3" json:"market_order_sell_quantity,omitempty"`
+	// 売気配数量1本目
+	Sell1 *FirstQuote `protobuf:"bytes,29,opt,name=sell1,proto3" json:"sell1,omitempty"`
+	// 売気配数量2本目
+	Sell2 *Quote `protobuf:"bytes,30,opt,name=sell2,proto3" json:"sell2,omitempty"`
+	// 売気配数量3本目
+	Sell3 *Quote `protobuf:"bytes,31,opt,name=sell3,proto3" json:"sell3,omitempty"`
+	// 売気配数量4本目
+	Sell4 *Quote `protobuf:"bytes,32,opt,name=sell4,proto3" json:"sell4,omitempty"`
+	// 売気配数量5本目
+	Sell5 *Quote `protobuf:"bytes,33,opt,name=sell5,proto3" json:"sell5,omitempty"`
+	// 売気配数量6本目
+	Sell6 *Quote `protobuf:"bytes,34,opt,name=sell6,proto3" json:"sell6,omitempty"`
+	// 売気配数量7本目
+	Sell7 *Quote `protobuf:"bytes,35,opt,name=sell7,proto3" json:"sell7,omitempty"`
+	// 売気配数量8本目
+	Sell8 *Quote `protobuf:"bytes,36,opt,name=sell8,proto3" json:"sell8,omitempty"`
+	// 売気配数量9本目
+	Sell9 *Quote `protobuf:"bytes,37,opt,name=sell9,proto3" json:"sell9,omitempty"`
+	// 売気配数量10本目
+	Sell10 *Quote `protobuf:"bytes,38,opt,name=sell10,proto3" json:"sell10,omitempty"`
+	// 最良売気配数量
+	//   株式・先物・オプション銘柄の場合のみ
+	AskQuantity float64 `protobuf:"fixed64,39,opt,name=ask_quantity,json=askQuantity,proto3" json:"ask_quantity,omitempty"`
+	// 最良売気配値段
+	//   株式・先物・オプション銘柄の場合のみ
+	AskPrice float64 `protobuf:"fixed64,40,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	// 最良売気配時刻
+	//  株式銘柄の場合のみ
+	AskTime *timestamppb.Timestamp `protobuf:"bytes,41,opt,name=ask_time,json=askTime,proto3" json:"ask_time,omitempty"`
+	// 最良売気配フラグ
+	//   株式・先物・オプション銘柄の場合のみ
+	AskSign string `protobuf:"bytes,42,opt,name=ask_sign,json=askSign,proto3" json:"ask_sign,omitempty"` // TODO enum化
+	// 買成行数量
+	//   株式銘柄の場合のみ
+	MarketOrderBuyQuantity float64 `protobuf:"fixed64,43,opt,name=market_order_buy_quantity,json=marketOrderBuyQuantity,proto3" json:"market_order_buy_quantity,omitempty"`
+	// 買気配数量1本目
+	Buy1 *FirstQuote `protobuf:"bytes,44,opt,name=buy1,proto3" json:"buy1,omitempty"`
+	// 買気配数量2本目
+	Buy2 *Quote `protobuf:"bytes,45,opt,name=buy2,proto3" json:"buy2,omitempty"`
+	// 買気配数量3本目
+	Buy3 *Quote `protobuf:"bytes,46,opt,name=buy3,proto3" json:"buy3,omitempty"`
+	// 買気配数量4本目
+	Buy4 *Quote `protobuf:"bytes,47,opt,name=buy4,proto3" json:"buy4,omitempty"`
+	// 買気配数量5本目
+	Buy5 *Quote `protobuf:"bytes,48,opt,name=buy5,proto3" json:"buy5,omitempty"`
+	// 買気配数量6本目
+	Buy6 *Quote `protobuf:"bytes,49,opt,name=buy6,proto3" json:"buy6,omitempty"`
+	// 買気配数量7本目
+	Buy7 *Quote `protobuf:"bytes,50,opt,name=buy7,proto3" json:"buy7,omitempty"`
+	// 買気配数量8本目
+	Buy8 *Quote `protobuf:"bytes,51,opt,name=buy8,proto3" json:"buy8,omitempty"`
+	// 買気配数量9本目
+	Buy9 *Quote `protobuf:"bytes,52,opt,name=buy9,proto3" json:"buy9,omitempty"`
+	// 買気配数量10本目
+	Buy10 *Quote `protobuf:"bytes,53,opt,name=buy10,proto3" json:"buy10,omitempty"`
+	// OVER気配数量
+	//   株式銘柄の場合のみ
+	OverSellQuantity float64 `protobuf:"fixed64,54,opt,name=over_sell_quantity,json=overSellQuantity,proto3" json:"over_sell_quantity,omitempty"`
+	// UNDER気配数量
+	//   株式銘柄の場合のみ
+	UnderBuyQuantity float64 `protobuf:"fixed64,55,opt,name=under_buy_quantity,json=underBuyQuantity,proto3" json:"under_buy_quantity,omitempty"`
+	// 時価総額
+	//   株式銘柄の場合のみ
+	TotalMarketValue float64 `protobuf:"fixed64,56,opt,name=total_market_value,json=totalMarketValue,proto3" json:"total_market_value,omitempty"`
 	// 清算値
 	//   先物銘柄の場合のみ
-	ClearingPrice float64 `protobuf:"fixed64,24,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
+	ClearingPrice float64 `protobuf:"fixed64,57,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
+	// インプライド・ボラティリティ
+	//   オプション銘柄かつ日通しの場合のみ
+	ImpliedVolatility float64 `protobuf:"fixed64,58,opt,name=implied_volatility,json=impliedVolatility,proto3" json:"implied_volatility,omitempty"`
+	// ガンマ
+	//   オプション銘柄かつ日通しの場合のみ
+	Gamma float64 `protobuf:"fixed64,59,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// セータ
+	//   オプション銘柄かつ日通しの場合のみ
+	Theta float64 `protobuf:"fixed64,60,opt,name=theta,proto3" json:"theta,omitempty"`
+	// ベガ
+	//   オプション銘柄かつ日通しの場合のみ
+	Vega float64 `protobuf:"fixed64,61,opt,name=vega,proto3" json:"vega,omitempty"`
+	// デルタ
+	//   オプション銘柄かつ日通しの場合のみ
+	Delta float64 `protobuf:"fixed64,62,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 銘柄種別
+	SecurityType SecurityType `protobuf:"varint,63,opt,name=security_type,json=securityType,proto3,enum=kabuspb.SecurityType" json:"security_type,omitempty"`
+	// 板情報ストリーミングの状態の通知
+	//   websocketが切断・再接続したときだけ入っていて、そのときは他の項目は空
+	Notice *BoardStreamNotice `protobuf:"bytes,64,opt,name=notice,proto3" json:"notice,omitempty"`
+	// 銘柄ごとの通し番号
+	//   サーバーがwebsocketで受け取った順に銘柄ごとに1から振り、再接続しても続きから振る
+	//   サーバーを再起動するとsession_idが変わって1から振り直す
+	//   ストリームで番号が飛んだら、待ち行列から捨てられた板情報がある
+	Sequence uint64 `protobuf:"varint,65,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// サーバーがwebsocketで板情報を受け取った日時
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,66,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// 板情報を受け取ったwebsocketの接続ごとのID
+	//   IDが変わったら、その間は切断していて板情報が届いていないことがある
+	SessionId string `protobuf:"bytes,67,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *Symbol) Reset() {
-	*x = Symbol{}
+func (x *Board) Reset() {
+	*x = Board{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[40]
+		mi := &file_kabuspb_kabus_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Symbol) String() string {
+func (x *Board) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Symbol) ProtoMessage() {}
+func (*Board) ProtoMessage() {}
 
-func (x *Symbol) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[40]
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5898,711 +6694,658 @@ func (x *Symbol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
-func (*Symbol) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Symbol) GetCode() string {
+func (x *Board) GetSymbolCode() string {
 	if x != nil {
-		return x.Code
+		return x.SymbolCode
 	}
 	return ""
 }
 
-func (x *Symbol) GetName() string {
+func (x *Board) GetSymbolName() string {
 	if x != nil {
-		return x.Name
+		return x.SymbolName
 	}
 	return ""
 }
 
-func (x *Symbol) GetDisplayName() string {
+func (x *Board) GetExchange() Exchange {
 	if x != nil {
-		return x.DisplayName
+		return x.Exchange
+	}
+	return Exchange_EXCHANGE_UNSPECIFIED
+}
+
+func (x *Board) GetExchangeName() string {
+	if x != nil {
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *Symbol) GetExchange() Exchange {
+func (x *Board) GetCurrentPrice() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.CurrentPrice
 	}
-	return Exchange_EXCHANGE_UNSPECIFIED
+	return 0
 }
 
-func (x *Symbol) GetExchangeName() string {
+func (x *Board) GetCurrentPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExchangeName
+		return x.CurrentPriceTime
 	}
-	return ""
+	return nil
 }
 
-func (x *Symbol) GetIndustryCategory() string {
+func (x *Board) GetCurrentPriceChangeStatus() string {
 	if x != nil {
-		return x.IndustryCategory
+		return x.CurrentPriceChangeStatus
 	}
 	return ""
 }
 
-func (x *Symbol) GetTotalMarketValue() float64 {
+func (x *Board) GetCurrentPriceStatus() int32 {
 	if x != nil {
-		return x.TotalMarketValue
+		return x.CurrentPriceStatus
 	}
 	return 0
 }
 
-func (x *Symbol) GetTotalStocks() float64 {
+func (x *Board) GetCalculationPrice() float64 {
 	if x != nil {
-		return x.TotalStocks
+		return x.CalculationPrice
 	}
 	return 0
 }
 
-func (x *Symbol) GetTradingUnit() float64 {
+func (x *Board) GetPreviousClose() float64 {
 	if x != nil {
-		return x.TradingUnit
+		return x.PreviousClose
 	}
 	return 0
 }
 
-func (x *Symbol) GetFiscalYearEndBasic() *timestamppb.Timestamp {
+func (x *Board) GetPreviousCloseTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FiscalYearEndBasic
+		return x.PreviousCloseTime
 	}
 	return nil
 }
 
-func (x *Symbol) GetPriceRangeGroup() string {
+func (x *Board) GetChangePreviousClose() float64 {
 	if x != nil {
-		return x.PriceRangeGroup
+		return x.ChangePreviousClose
 	}
-	return ""
+	return 0
 }
 
-func (x *Symbol) GetKabucomMarginBuy() bool {
+func (x *Board) GetChangePreviousClosePer() float64 {
 	if x != nil {
-		return x.KabucomMarginBuy
+		return x.ChangePreviousClosePer
 	}
-	return false
+	return 0
 }
 
-func (x *Symbol) GetKabucomMarginSell() bool {
+func (x *Board) GetOpeningPrice() float64 {
 	if x != nil {
-		return x.KabucomMarginSell
+		return x.OpeningPrice
 	}
-	return false
+	return 0
 }
 
-func (x *Symbol) GetMarginBuy() bool {
+func (x *Board) GetOpeningPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MarginBuy
+		return x.OpeningPriceTime
 	}
-	return false
+	return nil
 }
 
-func (x *Symbol) GetMarginSell() bool {
+func (x *Board) GetHighPrice() float64 {
 	if x != nil {
-		return x.MarginSell
+		return x.HighPrice
 	}
-	return false
+	return 0
 }
 
-func (x *Symbol) GetUpperLimit() float64 {
+func (x *Board) GetHighPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpperLimit
+		return x.HighPriceTime
 	}
-	return 0
+	return nil
 }
 
-func (x *Symbol) GetLowerLimit() float64 {
+func (x *Board) GetLowPrice() float64 {
 	if x != nil {
-		return x.LowerLimit
+		return x.LowPrice
 	}
 	return 0
 }
 
-func (x *Symbol) GetUnderlyer() string {
+func (x *Board) GetLowPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Underlyer
+		return x.LowPriceTime
 	}
-	return ""
+	return nil
 }
 
-func (x *Symbol) GetDerivativeMonth() *timestamppb.Timestamp {
+func (x *Board) GetTradingVolume() float64 {
 	if x != nil {
-		return x.DerivativeMonth
+		return x.TradingVolume
 	}
-	return nil
+	return 0
 }
 
-func (x *Symbol) GetTradeStart() *timestamppb.Timestamp {
+func (x *Board) GetTradingVolumeTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TradeStart
+		return x.TradingVolumeTime
 	}
 	return nil
 }
 
-func (x *Symbol) GetTradeEnd() *timestamppb.Timestamp {
+func (x *Board) GetVwap() float64 {
 	if x != nil {
-		return x.TradeEnd
+		return x.Vwap
 	}
-	return nil
+	return 0
 }
 
-func (x *Symbol) GetStrikePrice() float64 {
+func (x *Board) GetTradingValue() float64 {
 	if x != nil {
-		return x.StrikePrice
+		return x.TradingValue
 	}
 	return 0
 }
 
-func (x *Symbol) GetCallOrPut() CallPut {
+func (x *Board) GetBidQuantity() float64 {
 	if x != nil {
-		return x.CallOrPut
+		return x.BidQuantity
 	}
-	return CallPut_CALL_PUT_UNSPECIFIED
+	return 0
 }
 
-func (x *Symbol) GetClearingPrice() float64 {
+func (x *Board) GetBidPrice() float64 {
 	if x != nil {
-		return x.ClearingPrice
+		return x.BidPrice
 	}
 	return 0
 }
 
-// 銘柄コード情報
-type SymbolCodeInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 銘柄コード
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	// 銘柄名
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Board) GetBidTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BidTime
+	}
+	return nil
 }
 
-func (x *SymbolCodeInfo) Reset() {
-	*x = SymbolCodeInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[41]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Board) GetBidSign() string {
+	if x != nil {
+		return x.BidSign
 	}
+	return ""
 }
 
-func (x *SymbolCodeInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Board) GetMarketOrderSellQuantity() float64 {
+	if x != nil {
+		return x.MarketOrderSellQuantity
+	}
+	return 0
 }
 
-func (*SymbolCodeInfo) ProtoMessage() {}
+func (x *Board) GetSell1() *FirstQuote {
+	if x != nil {
+		return x.Sell1
+	}
+	return nil
+}
 
-func (x *SymbolCodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[41]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Board) GetSell2() *Quote {
+	if x != nil {
+		return x.Sell2
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SymbolCodeInfo.ProtoReflect.Descriptor instead.
-func (*SymbolCodeInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{41}
+func (x *Board) GetSell3() *Quote {
+	if x != nil {
+		return x.Sell3
+	}
+	return nil
 }
 
-func (x *SymbolCodeInfo) GetCode() string {
+func (x *Board) GetSell4() *Quote {
 	if x != nil {
-		return x.Code
+		return x.Sell4
 	}
-	return ""
+	return nil
 }
 
-func (x *SymbolCodeInfo) GetName() string {
+func (x *Board) GetSell5() *Quote {
 	if x != nil {
-		return x.Name
+		return x.Sell5
 	}
-	return ""
+	return nil
 }
 
-// 気配値 + 気配値フラグ
-type FirstQuote struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Board) GetSell6() *Quote {
+	if x != nil {
+		return x.Sell6
+	}
+	return nil
+}
 
-	// 時刻
-	//  株式銘柄の場合のみ
-	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	// 気配フラグ
-	//   株式・先物・オプション銘柄の場合のみ
-	Sign string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"` // TODO enum化
-	// 値段
-	//   株式・先物・オプション銘柄の場合のみ
-	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	// 数量
-	//   株式・先物・オプション銘柄の場合のみ
-	Quantity float64 `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+func (x *Board) GetSell7() *Quote {
+	if x != nil {
+		return x.Sell7
+	}
+	return nil
 }
 
-func (x *FirstQuote) Reset() {
-	*x = FirstQuote{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Board) GetSell8() *Quote {
+	if x != nil {
+		return x.Sell8
 	}
+	return nil
 }
 
-func (x *FirstQuote) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Board) GetSell9() *Quote {
+	if x != nil {
+		return x.Sell9
+	}
+	return nil
 }
 
-func (*FirstQuote) ProtoMessage() {}
+func (x *Board) GetSell10() *Quote {
+	if x != nil {
+		return x.Sell10
+	}
+	return nil
+}
 
-func (x *FirstQuote) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Board) GetAskQuantity() float64 {
+	if x != nil {
+		return x.AskQuantity
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FirstQuote.ProtoReflect.Descriptor instead.
-func (*FirstQuote) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{42}
+func (x *Board) GetAskPrice() float64 {
+	if x != nil {
+		return x.AskPrice
+	}
+	return 0
 }
 
-func (x *FirstQuote) GetTime() *timestamppb.Timestamp {
+func (x *Board) GetAskTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Time
+		return x.AskTime
 	}
 	return nil
 }
 
-func (x *FirstQuote) GetSign() string {
+func (x *Board) GetAskSign() string {
 	if x != nil {
-		return x.Sign
+		return x.AskSign
 	}
 	return ""
 }
 
-func (x *FirstQuote) GetPrice() float64 {
+func (x *Board) GetMarketOrderBuyQuantity() float64 {
 	if x != nil {
-		return x.Price
+		return x.MarketOrderBuyQuantity
 	}
 	return 0
 }
 
-func (x *FirstQuote) GetQuantity() float64 {
+func (x *Board) GetBuy1() *FirstQuote {
 	if x != nil {
-		return x.Quantity
+		return x.Buy1
 	}
-	return 0
+	return nil
 }
 
-// 気配値
-type Quote struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 値段
-	//   株式・先物・オプション銘柄の場合のみ
-	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	// 数量
-	//   株式・先物・オプション銘柄の場合のみ
-	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+func (x *Board) GetBuy2() *Quote {
+	if x != nil {
+		return x.Buy2
+	}
+	return nil
 }
 
-func (x *Quote) Reset() {
-	*x = Quote{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[43]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Board) GetBuy3() *Quote {
+	if x != nil {
+		return x.Buy3
 	}
+	return nil
 }
 
-func (x *Quote) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Board) GetBuy4() *Quote {
+	if x != nil {
+		return x.Buy4
+	}
+	return nil
 }
 
-func (*Quote) ProtoMessage() {}
-
-func (x *Quote) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[43]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Board) GetBuy5() *Quote {
+	if x != nil {
+		return x.Buy5
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
-func (*Quote) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{43}
+func (x *Board) GetBuy6() *Quote {
+	if x != nil {
+		return x.Buy6
+	}
+	return nil
 }
 
-func (x *Quote) GetPrice() float64 {
+func (x *Board) GetBuy7() *Quote {
 	if x != nil {
-		return x.Price
+		return x.Buy7
 	}
-	return 0
+	return nil
 }
 
-func (x *Quote) GetQuantity() float64 {
+func (x *Board) GetBuy8() *Quote {
 	if x != nil {
-		return x.Quantity
+		return x.Buy8
 	}
-	return 0
+	return nil
 }
 
-// 注文リスト
-type Orders struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+func (x *Board) GetBuy9() *Quote {
+	if x != nil {
+		return x.Buy9
+	}
+	return nil
 }
 
-func (x *Orders) Reset() {
-	*x = Orders{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[44]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Board) GetBuy10() *Quote {
+	if x != nil {
+		return x.Buy10
 	}
+	return nil
 }
 
-func (x *Orders) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Orders) ProtoMessage() {}
-
-func (x *Orders) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[44]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Orders.ProtoReflect.Descriptor instead.
-func (*Orders) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *Orders) GetOrders() []*Order {
+func (x *Board) GetOverSellQuantity() float64 {
 	if x != nil {
-		return x.Orders
-	}
-	return nil
-}
-
-// 注文
-type Order struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 状態
-	State State `protobuf:"varint,2,opt,name=state,proto3,enum=kabuspb.State" json:"state,omitempty"`
-	// 注文状態
-	OrderState OrderState `protobuf:"varint,3,opt,name=order_state,json=orderState,proto3,enum=kabuspb.OrderState" json:"order_state,omitempty"`
-	// 執行条件
-	OrderType OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=kabuspb.OrderType" json:"order_type,omitempty"`
-	// 受注日時
-	ReceiveTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,6,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名
-	SymbolName string `protobuf:"bytes,7,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 市場コード
-	Exchange OrderExchange `protobuf:"varint,8,opt,name=exchange,proto3,enum=kabuspb.OrderExchange" json:"exchange,omitempty"`
-	// 市場名
-	ExchangeName string `protobuf:"bytes,9,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 有効期間条件
-	TimeInForce TimeInForce `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=kabuspb.TimeInForce" json:"time_in_force,omitempty"`
-	// 値段
-	Price float64 `protobuf:"fixed64,11,opt,name=price,proto3" json:"price,omitempty"`
-	// 発注数量
-	OrderQuantity float64 `protobuf:"fixed64,12,opt,name=order_quantity,json=orderQuantity,proto3" json:"order_quantity,omitempty"`
-	// 約定数量
-	CumulativeQuantity float64 `protobuf:"fixed64,13,opt,name=cumulative_quantity,json=cumulativeQuantity,proto3" json:"cumulative_quantity,omitempty"`
-	// 売買区分
-	Side Side `protobuf:"varint,14,opt,name=side,proto3,enum=kabuspb.Side" json:"side,omitempty"`
-	// 取引区分
-	TradeType TradeType `protobuf:"varint,15,opt,name=trade_type,json=tradeType,proto3,enum=kabuspb.TradeType" json:"trade_type,omitempty"`
-	// 口座種別
-	AccountType AccountType `protobuf:"varint,16,opt,name=account_type,json=accountType,proto3,enum=kabuspb.AccountType" json:"account_type,omitempty"`
-	// 受渡区分
-	DeliveryType DeliveryType `protobuf:"varint,17,opt,name=delivery_type,json=deliveryType,proto3,enum=kabuspb.DeliveryType" json:"delivery_type,omitempty"`
-	// 注文有効期限
-	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
-	// 信用取引区分
-	MarginTradeType MarginTradeType `protobuf:"varint,19,opt,name=margin_trade_type,json=marginTradeType,proto3,enum=kabuspb.MarginTradeType" json:"margin_trade_type,omitempty"`
-	// プレミアム料
-	MarginPremium float64 `protobuf:"fixed64,21,opt,name=margin_premium,json=marginPremium,proto3" json:"margin_premium,omitempty"`
-	// 注文詳細
-	Details []*OrderDetail `protobuf:"bytes,20,rep,name=details,proto3" json:"details,omitempty"`
-}
-
-func (x *Order) Reset() {
-	*x = Order{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[45]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Order) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Order) ProtoMessage() {}
-
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[45]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OverSellQuantity
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{45}
+	return 0
 }
 
-func (x *Order) GetId() string {
+func (x *Board) GetUnderBuyQuantity() float64 {
 	if x != nil {
-		return x.Id
+		return x.UnderBuyQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetState() State {
+func (x *Board) GetTotalMarketValue() float64 {
 	if x != nil {
-		return x.State
+		return x.TotalMarketValue
 	}
-	return State_STATE_UNSPECIFIED
+	return 0
 }
 
-func (x *Order) GetOrderState() OrderState {
+func (x *Board) GetClearingPrice() float64 {
 	if x != nil {
-		return x.OrderState
+		return x.ClearingPrice
 	}
-	return OrderState_ORDER_STATE_UNSPECIFIED
+	return 0
 }
 
-func (x *Order) GetOrderType() OrderType {
+func (x *Board) GetImpliedVolatility() float64 {
 	if x != nil {
-		return x.OrderType
+		return x.ImpliedVolatility
 	}
-	return OrderType_ORDER_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Order) GetReceiveTime() *timestamppb.Timestamp {
+func (x *Board) GetGamma() float64 {
 	if x != nil {
-		return x.ReceiveTime
+		return x.Gamma
 	}
-	return nil
+	return 0
 }
 
-func (x *Order) GetSymbolCode() string {
+func (x *Board) GetTheta() float64 {
 	if x != nil {
-		return x.SymbolCode
+		return x.Theta
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetSymbolName() string {
+func (x *Board) GetVega() float64 {
 	if x != nil {
-		return x.SymbolName
+		return x.Vega
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetExchange() OrderExchange {
+func (x *Board) GetDelta() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Delta
 	}
-	return OrderExchange_ORDER_EXCHANGE_UNSPECIFIED
+	return 0
 }
 
-func (x *Order) GetExchangeName() string {
+func (x *Board) GetSecurityType() SecurityType {
 	if x != nil {
-		return x.ExchangeName
+		return x.SecurityType
 	}
-	return ""
+	return SecurityType_SECURITY_TYPE_UNSPECIFIED
 }
 
-func (x *Order) GetTimeInForce() TimeInForce {
+func (x *Board) GetNotice() *BoardStreamNotice {
 	if x != nil {
-		return x.TimeInForce
+		return x.Notice
 	}
-	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+	return nil
 }
 
-func (x *Order) GetPrice() float64 {
+func (x *Board) GetSequence() uint64 {
 	if x != nil {
-		return x.Price
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *Order) GetOrderQuantity() float64 {
+func (x *Board) GetReceivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderQuantity
+		return x.ReceivedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetCumulativeQuantity() float64 {
+func (x *Board) GetSessionId() string {
 	if x != nil {
-		return x.CumulativeQuantity
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetSide() Side {
-	if x != nil {
-		return x.Side
-	}
-	return Side_SIDE_UNSPECIFIED
+// 板情報ストリーミングの状態の通知
+//
+//	切断したときにRECONNECTINGで、再接続して板情報を受け取り始めたときにCONNECTEDで送る
+type BoardStreamNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// websocketの状態
+	State BoardWebSocketState `protobuf:"varint,1,opt,name=state,proto3,enum=kabuspb.BoardWebSocketState" json:"state,omitempty"`
+	// 切断した日時
+	DisconnectedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at,omitempty"`
+	// 再接続して板情報を受け取り始めた日時
+	//   RECONNECTINGのときはゼロ値、disconnected_atからreconnected_atまでの板情報は届いていない
+	ReconnectedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reconnected_at,json=reconnectedAt,proto3" json:"reconnected_at,omitempty"`
+	// 切断の原因のエラー
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *Order) GetTradeType() TradeType {
-	if x != nil {
-		return x.TradeType
+func (x *BoardStreamNotice) Reset() {
+	*x = BoardStreamNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return TradeType_TRADE_TYPE_UNSPECIFIED
 }
 
-func (x *Order) GetAccountType() AccountType {
-	if x != nil {
-		return x.AccountType
-	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+func (x *BoardStreamNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Order) GetDeliveryType() DeliveryType {
-	if x != nil {
-		return x.DeliveryType
+func (*BoardStreamNotice) ProtoMessage() {}
+
+func (x *BoardStreamNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return DeliveryType_DELIVERY_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetExpireDay() *timestamppb.Timestamp {
+// Deprecated: Use BoardStreamNotice.ProtoReflect.Descriptor instead.
+func (*BoardStreamNotice) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BoardStreamNotice) GetState() BoardWebSocketState {
 	if x != nil {
-		return x.ExpireDay
+		return x.State
 	}
-	return nil
+	return BoardWebSocketState_BOARD_WEB_SOCKET_STATE_UNSPECIFIED
 }
 
-func (x *Order) GetMarginTradeType() MarginTradeType {
+func (x *BoardStreamNotice) GetDisconnectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MarginTradeType
+		return x.DisconnectedAt
 	}
-	return MarginTradeType_MARGIN_TRADE_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *Order) GetMarginPremium() float64 {
+func (x *BoardStreamNotice) GetReconnectedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MarginPremium
+		return x.ReconnectedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetDetails() []*OrderDetail {
+func (x *BoardStreamNotice) GetError() string {
 	if x != nil {
-		return x.Details
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-// 注文詳細
-type OrderDetail struct {
+// 銘柄
+type Symbol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// シーケンスNo
-	//   注文明細レコードの生成順序です
-	//   通番であるとは限りませんが、大小による順序は保たれています
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
-	// 注文詳細番号
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// 明細種別
-	RecordType RecordType `protobuf:"varint,3,opt,name=record_type,json=recordType,proto3,enum=kabuspb.RecordType" json:"record_type,omitempty"`
-	// 取引所番号
-	ExchangeId string `protobuf:"bytes,4,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
-	// 状態
-	State OrderDetailState `protobuf:"varint,5,opt,name=state,proto3,enum=kabuspb.OrderDetailState" json:"state,omitempty"`
-	// 処理時刻
-	TransactTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transact_time,json=transactTime,proto3" json:"transact_time,omitempty"`
-	// 執行条件
-	OrderType OrderType `protobuf:"varint,7,opt,name=order_type,json=orderType,proto3,enum=kabuspb.OrderType" json:"order_type,omitempty"`
-	// 値段
-	Price float64 `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
-	// 数量
-	Quantity float64 `protobuf:"fixed64,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// 約定番号
-	ExecutionId string `protobuf:"bytes,10,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	// 約定日時
-	ExecutionDay *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=execution_day,json=executionDay,proto3" json:"execution_day,omitempty"`
-	// 受渡日
-	DeliveryDay *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivery_day,json=deliveryDay,proto3" json:"delivery_day,omitempty"`
-	// 手数料
-	//  注文詳細の明細種別が約定の場合に設定
-	Commission float64 `protobuf:"fixed64,13,opt,name=commission,proto3" json:"commission,omitempty"`
-	// 手数料消費税
-	//   明細種別は約定の場合にのみ表示されます
-	CommissionTax float64 `protobuf:"fixed64,14,opt,name=commission_tax,json=commissionTax,proto3" json:"commission_tax,omitempty"`
+	// 銘柄コード
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 銘柄名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 銘柄略称
+	//   ※株式・先物・オプション銘柄の場合のみ
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// 市場コード
+	//   株式・先物・オプション銘柄の場合のみ
+	Exchange Exchange `protobuf:"varint,4,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
+	// 市場名称
+	//   株式・先物・オプション銘柄の場合のみ
+	ExchangeName string `protobuf:"bytes,5,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 業種コード名
+	//   株式銘柄の場合のみ
+	IndustryCategory string `protobuf:"bytes,6,opt,name=industry_category,json=industryCategory,proto3" json:"industry_category,omitempty"` // TODO enum
+	// 時価総額
+	//   株式銘柄の場合のみ
+	TotalMarketValue float64 `protobuf:"fixed64,7,opt,name=total_market_value,json=totalMarketValue,proto3" json:"total_market_value,omitempty"`
+	// 発行済み株式数（千株）
+	//   株式銘柄の場合のみ
+	TotalStocks float64 `protobuf:"fixed64,8,opt,name=total_stocks,json=totalStocks,proto3" json:"total_stocks,omitempty"`
+	// 売買単位
+	//   株式・先物・オプション銘柄の場合のみ
+	TradingUnit float64 `protobuf:"fixed64,9,opt,name=trading_unit,json=tradingUnit,proto3" json:"trading_unit,omitempty"`
+	// 決算期日
+	//   株式銘柄の場合のみ
+	FiscalYearEndBasic *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=fiscal_year_end_basic,json=fiscalYearEndBasic,proto3" json:"fiscal_year_end_basic,omitempty"`
+	// 呼値グループ
+	//   株式・先物・オプション銘柄の場合のみ
+	PriceRangeGroup string `protobuf:"bytes,11,opt,name=price_range_group,json=priceRangeGroup,proto3" json:"price_range_group,omitempty"` // todo enum
+	// 一般信用買建フラグ
+	//   trueのとき一般信用買建可能
+	//   株式銘柄の場合のみ
+	KabucomMarginBuy bool `protobuf:"varint,12,opt,name=kabucom_margin_buy,json=kabucomMarginBuy,proto3" json:"kabucom_margin_buy,omitempty"`
+	// 一般信用売建フラグ
+	//   trueのとき一般信用売建可能
+	//   株式銘柄の場合のみ
+	KabucomMarginSell bool `protobuf:"varint,13,opt,name=kabucom_margin_sell,json=kabucomMarginSell,proto3" json:"kabucom_margin_sell,omitempty"`
+	// 制度信用買建フラグ
+	//   trueのとき制度信用買建可能
+	//   株式銘柄の場合のみ
+	MarginBuy bool `protobuf:"varint,14,opt,name=margin_buy,json=marginBuy,proto3" json:"margin_buy,omitempty"`
+	// 制度信用売建フラグ
+	//   trueのとき制度信用売建可能
+	//   株式銘柄の場合のみ
+	MarginSell bool `protobuf:"varint,15,opt,name=margin_sell,json=marginSell,proto3" json:"margin_sell,omitempty"`
+	// 値幅上限
+	//   株式・先物・オプション銘柄の場合のみ
+	UpperLimit float64 `protobuf:"fixed64,16,opt,name=upper_limit,json=upperLimit,proto3" json:"upper_limit,omitempty"`
+	// 値幅下限
+	//   株式・先物・オプション銘柄の場合のみ
+	LowerLimit float64 `protobuf:"fixed64,17,opt,name=lower_limit,json=lowerLimit,proto3" json:"lower_limit,omitempty"`
+	// 原資産コード
+	//   先物・オプション銘柄の場合のみ
+	Underlyer string `protobuf:"bytes,18,opt,name=underlyer,proto3" json:"underlyer,omitempty"` // todo enum
+	// 限月-年月
+	//   先物・オプション銘柄の場合のみ
+	DerivativeMonth *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=derivative_month,json=derivativeMonth,proto3" json:"derivative_month,omitempty"`
+	// 取引開始日
+	//   先物・オプション銘柄の場合のみ
+	TradeStart *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=trade_start,json=tradeStart,proto3" json:"trade_start,omitempty"`
+	// 取引終了日
+	//   先物・オプション銘柄の場合のみ
+	TradeEnd *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=trade_end,json=tradeEnd,proto3" json:"trade_end,omitempty"`
+	// 権利行使価格
+	//   オプション銘柄の場合のみ
+	StrikePrice float64 `protobuf:"fixed64,22,opt,name=strike_price,json=strikePrice,proto3" json:"strike_price,omitempty"`
+	// プット/コール区分
+	//   オプション銘柄の場合のみ
+	CallOrPut CallPut `protobuf:"varint,23,opt,name=call_or_put,json=callOrPut,proto3,enum=kabuspb.CallPut" json:"call_or_put,omitempty"`
+	// 清算値
+	//   先物銘柄の場合のみ
+	ClearingPrice float64 `protobuf:"fixed64,24,opt,name=clearing_price,json=clearingPrice,proto3" json:"clearing_price,omitempty"`
 }
 
-func (x *OrderDetail) Reset() {
-	*x = OrderDetail{}
+func (x *Symbol) Reset() {
+	*x = Symbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[46]
+		mi := &file_kabuspb_kabus_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrderDetail) String() string {
+func (x *Symbol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderDetail) ProtoMessage() {}
+func (*Symbol) ProtoMessage() {}
 
-func (x *OrderDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[46]
+func (x *Symbol) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6613,231 +7356,208 @@ func (x *OrderDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderDetail.ProtoReflect.Descriptor instead.
-func (*OrderDetail) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
+func (*Symbol) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *OrderDetail) GetSequenceNumber() int32 {
+func (x *Symbol) GetCode() string {
 	if x != nil {
-		return x.SequenceNumber
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderDetail) GetId() string {
+func (x *Symbol) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OrderDetail) GetRecordType() RecordType {
+func (x *Symbol) GetDisplayName() string {
 	if x != nil {
-		return x.RecordType
+		return x.DisplayName
 	}
-	return RecordType_RECORD_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *OrderDetail) GetExchangeId() string {
+func (x *Symbol) GetExchange() Exchange {
 	if x != nil {
-		return x.ExchangeId
+		return x.Exchange
 	}
-	return ""
+	return Exchange_EXCHANGE_UNSPECIFIED
 }
 
-func (x *OrderDetail) GetState() OrderDetailState {
+func (x *Symbol) GetExchangeName() string {
 	if x != nil {
-		return x.State
+		return x.ExchangeName
 	}
-	return OrderDetailState_ORDER_DETAIL_STATE_UNSPECIFIED
+	return ""
 }
 
-func (x *OrderDetail) GetTransactTime() *timestamppb.Timestamp {
+func (x *Symbol) GetIndustryCategory() string {
 	if x != nil {
-		return x.TransactTime
+		return x.IndustryCategory
 	}
-	return nil
+	return ""
 }
 
-func (x *OrderDetail) GetOrderType() OrderType {
+func (x *Symbol) GetTotalMarketValue() float64 {
 	if x != nil {
-		return x.OrderType
+		return x.TotalMarketValue
 	}
-	return OrderType_ORDER_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *OrderDetail) GetPrice() float64 {
+func (x *Symbol) GetTotalStocks() float64 {
 	if x != nil {
-		return x.Price
+		return x.TotalStocks
 	}
 	return 0
 }
 
-func (x *OrderDetail) GetQuantity() float64 {
+func (x *Symbol) GetTradingUnit() float64 {
 	if x != nil {
-		return x.Quantity
+		return x.TradingUnit
 	}
 	return 0
 }
 
-func (x *OrderDetail) GetExecutionId() string {
+func (x *Symbol) GetFiscalYearEndBasic() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExecutionId
+		return x.FiscalYearEndBasic
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderDetail) GetExecutionDay() *timestamppb.Timestamp {
+func (x *Symbol) GetPriceRangeGroup() string {
 	if x != nil {
-		return x.ExecutionDay
+		return x.PriceRangeGroup
 	}
-	return nil
+	return ""
 }
 
-func (x *OrderDetail) GetDeliveryDay() *timestamppb.Timestamp {
+func (x *Symbol) GetKabucomMarginBuy() bool {
 	if x != nil {
-		return x.DeliveryDay
+		return x.KabucomMarginBuy
 	}
-	return nil
+	return false
 }
 
-func (x *OrderDetail) GetCommission() float64 {
+func (x *Symbol) GetKabucomMarginSell() bool {
 	if x != nil {
-		return x.Commission
+		return x.KabucomMarginSell
 	}
-	return 0
+	return false
 }
 
-func (x *OrderDetail) GetCommissionTax() float64 {
+func (x *Symbol) GetMarginBuy() bool {
 	if x != nil {
-		return x.CommissionTax
+		return x.MarginBuy
 	}
-	return 0
+	return false
 }
 
-// ポジションリスト
-type Positions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Positions []*Position `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+func (x *Symbol) GetMarginSell() bool {
+	if x != nil {
+		return x.MarginSell
+	}
+	return false
 }
 
-func (x *Positions) Reset() {
-	*x = Positions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Symbol) GetUpperLimit() float64 {
+	if x != nil {
+		return x.UpperLimit
 	}
+	return 0
 }
 
-func (x *Positions) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Symbol) GetLowerLimit() float64 {
+	if x != nil {
+		return x.LowerLimit
+	}
+	return 0
 }
 
-func (*Positions) ProtoMessage() {}
-
-func (x *Positions) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Symbol) GetUnderlyer() string {
+	if x != nil {
+		return x.Underlyer
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Positions.ProtoReflect.Descriptor instead.
-func (*Positions) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{47}
+func (x *Symbol) GetDerivativeMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DerivativeMonth
+	}
+	return nil
 }
 
-func (x *Positions) GetPositions() []*Position {
+func (x *Symbol) GetTradeStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Positions
+		return x.TradeStart
 	}
 	return nil
 }
 
-// ポジション
-type Position struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Symbol) GetTradeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TradeEnd
+	}
+	return nil
+}
+
+func (x *Symbol) GetStrikePrice() float64 {
+	if x != nil {
+		return x.StrikePrice
+	}
+	return 0
+}
+
+func (x *Symbol) GetCallOrPut() CallPut {
+	if x != nil {
+		return x.CallOrPut
+	}
+	return CallPut_CALL_PUT_UNSPECIFIED
+}
+
+func (x *Symbol) GetClearingPrice() float64 {
+	if x != nil {
+		return x.ClearingPrice
+	}
+	return 0
+}
+
+// 銘柄コード情報
+type SymbolCodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-	// 約定番号
-	ExecutionId string `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
-	// 口座種別
-	AccountType AccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=kabuspb.AccountType" json:"account_type,omitempty"`
 	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,3,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	// 銘柄名
-	SymbolName string `protobuf:"bytes,4,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 市場コード
-	Exchange Exchange `protobuf:"varint,5,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
-	// 市場名
-	ExchangeName string `protobuf:"bytes,6,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 銘柄種別
-	//   先物・オプション銘柄の場合のみ
-	SecurityType SecurityType `protobuf:"varint,7,opt,name=security_type,json=securityType,proto3,enum=kabuspb.SecurityType" json:"security_type,omitempty"`
-	// 約定日（建玉日）
-	//   信用・先物・オプションの場合のみ
-	ExecutionDay *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=execution_day,json=executionDay,proto3" json:"execution_day,omitempty"`
-	// 値段
-	Price float64 `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
-	// 残数量
-	LeavesQuantity float64 `protobuf:"fixed64,10,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
-	// 拘束数量（保有数量）
-	HoldQuantity float64 `protobuf:"fixed64,11,opt,name=hold_quantity,json=holdQuantity,proto3" json:"hold_quantity,omitempty"`
-	// 売買区分
-	Side Side `protobuf:"varint,12,opt,name=side,proto3,enum=kabuspb.Side" json:"side,omitempty"`
-	// 諸経費
-	//   信用・先物・オプションの場合のみ
-	Expenses float64 `protobuf:"fixed64,13,opt,name=expenses,proto3" json:"expenses,omitempty"`
-	// 手数料
-	//   信用・先物・オプションの場合のみ
-	Commission float64 `protobuf:"fixed64,14,opt,name=commission,proto3" json:"commission,omitempty"`
-	// 手数料消費税
-	//   信用・先物・オプションの場合のみ
-	CommissionTax float64 `protobuf:"fixed64,15,opt,name=commission_tax,json=commissionTax,proto3" json:"commission_tax,omitempty"`
-	// 返済期日
-	//   信用・先物・オプションの場合のみ
-	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
-	// 信用取引区分
-	//   信用の場合のみ
-	MarginTradeType MarginTradeType `protobuf:"varint,17,opt,name=margin_trade_type,json=marginTradeType,proto3,enum=kabuspb.MarginTradeType" json:"margin_trade_type,omitempty"`
-	// 現在値
-	CurrentPrice float64 `protobuf:"fixed64,18,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 評価金額
-	Valuation float64 `protobuf:"fixed64,19,opt,name=valuation,proto3" json:"valuation,omitempty"`
-	// 評価損益額
-	ProfitLoss float64 `protobuf:"fixed64,20,opt,name=profit_loss,json=profitLoss,proto3" json:"profit_loss,omitempty"`
-	// 評価損益率
-	ProfitLossRate float64 `protobuf:"fixed64,21,opt,name=profit_loss_rate,json=profitLossRate,proto3" json:"profit_loss_rate,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Position) Reset() {
-	*x = Position{}
+func (x *SymbolCodeInfo) Reset() {
+	*x = SymbolCodeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[48]
+		mi := &file_kabuspb_kabus_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Position) String() string {
+func (x *SymbolCodeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Position) ProtoMessage() {}
+func (*SymbolCodeInfo) ProtoMessage() {}
 
-func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[48]
+func (x *SymbolCodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6848,187 +7568,191 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Position.ProtoReflect.Descriptor instead.
-func (*Position) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SymbolCodeInfo.ProtoReflect.Descriptor instead.
+func (*SymbolCodeInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Position) GetExecutionId() string {
+func (x *SymbolCodeInfo) GetCode() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Position) GetAccountType() AccountType {
+func (x *SymbolCodeInfo) GetName() string {
 	if x != nil {
-		return x.AccountType
+		return x.Name
 	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *Position) GetSymbolCode() string {
-	if x != nil {
-		return x.SymbolCode
-	}
-	return ""
+// 気配値 + 気配値フラグ
+type FirstQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 時刻
+	//  株式銘柄の場合のみ
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// 気配フラグ
+	//   株式・先物・オプション銘柄の場合のみ
+	Sign string `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"` // TODO enum化
+	// 値段
+	//   株式・先物・オプション銘柄の場合のみ
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// 数量
+	//   株式・先物・オプション銘柄の場合のみ
+	Quantity float64 `protobuf:"fixed64,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (x *Position) GetSymbolName() string {
-	if x != nil {
-		return x.SymbolName
+func (x *FirstQuote) Reset() {
+	*x = FirstQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Position) GetExchange() Exchange {
-	if x != nil {
-		return x.Exchange
-	}
-	return Exchange_EXCHANGE_UNSPECIFIED
+func (x *FirstQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Position) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
+func (*FirstQuote) ProtoMessage() {}
+
+func (x *FirstQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Position) GetSecurityType() SecurityType {
-	if x != nil {
-		return x.SecurityType
-	}
-	return SecurityType_SECURITY_TYPE_UNSPECIFIED
+// Deprecated: Use FirstQuote.ProtoReflect.Descriptor instead.
+func (*FirstQuote) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Position) GetExecutionDay() *timestamppb.Timestamp {
+func (x *FirstQuote) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExecutionDay
+		return x.Time
 	}
 	return nil
 }
 
-func (x *Position) GetPrice() float64 {
+func (x *FirstQuote) GetSign() string {
 	if x != nil {
-		return x.Price
+		return x.Sign
 	}
-	return 0
+	return ""
 }
 
-func (x *Position) GetLeavesQuantity() float64 {
+func (x *FirstQuote) GetPrice() float64 {
 	if x != nil {
-		return x.LeavesQuantity
+		return x.Price
 	}
 	return 0
 }
 
-func (x *Position) GetHoldQuantity() float64 {
+func (x *FirstQuote) GetQuantity() float64 {
 	if x != nil {
-		return x.HoldQuantity
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Position) GetSide() Side {
-	if x != nil {
-		return x.Side
-	}
-	return Side_SIDE_UNSPECIFIED
-}
+// 気配値
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Position) GetExpenses() float64 {
-	if x != nil {
-		return x.Expenses
-	}
-	return 0
+	// 値段
+	//   株式・先物・オプション銘柄の場合のみ
+	Price float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	// 数量
+	//   株式・先物・オプション銘柄の場合のみ
+	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (x *Position) GetCommission() float64 {
-	if x != nil {
-		return x.Commission
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Position) GetCommissionTax() float64 {
-	if x != nil {
-		return x.CommissionTax
-	}
-	return 0
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Position) GetExpireDay() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpireDay
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Position) GetMarginTradeType() MarginTradeType {
-	if x != nil {
-		return x.MarginTradeType
-	}
-	return MarginTradeType_MARGIN_TRADE_TYPE_UNSPECIFIED
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Position) GetCurrentPrice() float64 {
+func (x *Quote) GetPrice() float64 {
 	if x != nil {
-		return x.CurrentPrice
+		return x.Price
 	}
 	return 0
 }
 
-func (x *Position) GetValuation() float64 {
+func (x *Quote) GetQuantity() float64 {
 	if x != nil {
-		return x.Valuation
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Position) GetProfitLoss() float64 {
-	if x != nil {
-		return x.ProfitLoss
-	}
-	return 0
-}
-
-func (x *Position) GetProfitLossRate() float64 {
-	if x != nil {
-		return x.ProfitLossRate
-	}
-	return 0
-}
-
-// 銘柄登録
-type RegisteredSymbols struct {
+// 注文リスト
+type Orders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 現在登録されている銘柄のリスト
-	Symbols []*RegisterSymbol `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	// 登録銘柄数
-	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
-func (x *RegisteredSymbols) Reset() {
-	*x = RegisteredSymbols{}
+func (x *Orders) Reset() {
+	*x = Orders{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[49]
+		mi := &file_kabuspb_kabus_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisteredSymbols) String() string {
+func (x *Orders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredSymbols) ProtoMessage() {}
+func (*Orders) ProtoMessage() {}
 
-func (x *RegisteredSymbols) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[49]
+func (x *Orders) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7039,54 +7763,44 @@ func (x *RegisteredSymbols) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredSymbols.ProtoReflect.Descriptor instead.
-func (*RegisteredSymbols) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use Orders.ProtoReflect.Descriptor instead.
+func (*Orders) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RegisteredSymbols) GetSymbols() []*RegisterSymbol {
+func (x *Orders) GetOrders() []*Order {
 	if x != nil {
-		return x.Symbols
+		return x.Orders
 	}
 	return nil
 }
 
-func (x *RegisteredSymbols) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-// 銘柄登録の詳細
-type RegisterSymbol struct {
+// 注文履歴
+type OrderHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 市場
-	Exchange Exchange `protobuf:"varint,2,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
+	Records []*OrderHistoryRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (x *RegisterSymbol) Reset() {
-	*x = RegisterSymbol{}
+func (x *OrderHistory) Reset() {
+	*x = OrderHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[50]
+		mi := &file_kabuspb_kabus_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterSymbol) String() string {
+func (x *OrderHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterSymbol) ProtoMessage() {}
+func (*OrderHistory) ProtoMessage() {}
 
-func (x *RegisterSymbol) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[50]
+func (x *OrderHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7097,56 +7811,53 @@ func (x *RegisterSymbol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterSymbol.ProtoReflect.Descriptor instead.
-func (*RegisterSymbol) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *RegisterSymbol) GetSymbolCode() string {
-	if x != nil {
-		return x.SymbolCode
-	}
-	return ""
+// Deprecated: Use OrderHistory.ProtoReflect.Descriptor instead.
+func (*OrderHistory) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RegisterSymbol) GetExchange() Exchange {
+func (x *OrderHistory) GetRecords() []*OrderHistoryRecord {
 	if x != nil {
-		return x.Exchange
+		return x.Records
 	}
-	return Exchange_EXCHANGE_UNSPECIFIED
+	return nil
 }
 
-// 株価ランキング
-type PriceRanking struct {
+// 注文履歴の注文
+//
+//	サーバーで定期的と注文の変化の検知時に取得した注文を、注文明細と一緒に保存したもの
+type OrderHistoryRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ランキング種別
-	Type PriceRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.PriceRankingType" json:"type,omitempty"`
-	// 市場・上場部
-	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
-	// ランキング
-	Ranking []*PriceRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	// 最後に取得した注文
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// 商品
+	Product Product `protobuf:"varint,2,opt,name=product,proto3,enum=kabuspb.Product" json:"product,omitempty"`
+	// 仮想証券会社の注文か
+	IsVirtual bool `protobuf:"varint,3,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
+	// 最後に保存した日時
+	SavedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
 }
 
-func (x *PriceRanking) Reset() {
-	*x = PriceRanking{}
+func (x *OrderHistoryRecord) Reset() {
+	*x = OrderHistoryRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[51]
+		mi := &file_kabuspb_kabus_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PriceRanking) String() string {
+func (x *OrderHistoryRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceRanking) ProtoMessage() {}
+func (*OrderHistoryRecord) ProtoMessage() {}
 
-func (x *PriceRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[51]
+func (x *OrderHistoryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7157,85 +7868,109 @@ func (x *PriceRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceRanking.ProtoReflect.Descriptor instead.
-func (*PriceRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use OrderHistoryRecord.ProtoReflect.Descriptor instead.
+func (*OrderHistoryRecord) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PriceRanking) GetType() PriceRankingType {
+func (x *OrderHistoryRecord) GetOrder() *Order {
 	if x != nil {
-		return x.Type
+		return x.Order
 	}
-	return PriceRankingType_PRICE_RANKING_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *PriceRanking) GetExchangeDivision() ExchangeDivision {
+func (x *OrderHistoryRecord) GetProduct() Product {
 	if x != nil {
-		return x.ExchangeDivision
+		return x.Product
 	}
-	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
+	return Product_PRODUCT_UNSPECIFIED
 }
 
-func (x *PriceRanking) GetRanking() []*PriceRankingInfo {
+func (x *OrderHistoryRecord) GetIsVirtual() bool {
 	if x != nil {
-		return x.Ranking
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *OrderHistoryRecord) GetSavedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SavedAt
 	}
 	return nil
 }
 
-// 株価ランキング詳細
-type PriceRankingInfo struct {
+// 注文
+type Order struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 順位
-	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
-	// トレンド
-	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
-	// 平均順位
-	//   100位以下は「999」となります
-	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
+	// ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 状態
+	State State `protobuf:"varint,2,opt,name=state,proto3,enum=kabuspb.State" json:"state,omitempty"`
+	// 注文状態
+	OrderState OrderState `protobuf:"varint,3,opt,name=order_state,json=orderState,proto3,enum=kabuspb.OrderState" json:"order_state,omitempty"`
+	// 執行条件
+	OrderType OrderType `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=kabuspb.OrderType" json:"order_type,omitempty"`
+	// 受注日時
+	ReceiveTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
 	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名称
-	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 現在値
-	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 前日比
-	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
-	// 騰落率（%）
-	ChangePercentage float64 `protobuf:"fixed64,8,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
-	// 時刻 HH:mm
-	//   日付は返しません
-	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
-	// 売買高
-	TradingVolume float64 `protobuf:"fixed64,10,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
-	// 売買代金
-	Turnover float64 `protobuf:"fixed64,11,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	SymbolCode string `protobuf:"bytes,6,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名
+	SymbolName string `protobuf:"bytes,7,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 市場コード
+	Exchange OrderExchange `protobuf:"varint,8,opt,name=exchange,proto3,enum=kabuspb.OrderExchange" json:"exchange,omitempty"`
 	// 市場名
-	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 業種名
-	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	ExchangeName string `protobuf:"bytes,9,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 有効期間条件
+	TimeInForce TimeInForce `protobuf:"varint,10,opt,name=time_in_force,json=timeInForce,proto3,enum=kabuspb.TimeInForce" json:"time_in_force,omitempty"`
+	// 値段
+	Price float64 `protobuf:"fixed64,11,opt,name=price,proto3" json:"price,omitempty"`
+	// 発注数量
+	OrderQuantity float64 `protobuf:"fixed64,12,opt,name=order_quantity,json=orderQuantity,proto3" json:"order_quantity,omitempty"`
+	// 約定数量
+	CumulativeQuantity float64 `protobuf:"fixed64,13,opt,name=cumulative_quantity,json=cumulativeQuantity,proto3" json:"cumulative_quantity,omitempty"`
+	// 売買区分
+	Side Side `protobuf:"varint,14,opt,name=side,proto3,enum=kabuspb.Side" json:"side,omitempty"`
+	// 取引区分
+	TradeType TradeType `protobuf:"varint,15,opt,name=trade_type,json=tradeType,proto3,enum=kabuspb.TradeType" json:"trade_type,omitempty"`
+	// 口座種別
+	AccountType AccountType `protobuf:"varint,16,opt,name=account_type,json=accountType,proto3,enum=kabuspb.AccountType" json:"account_type,omitempty"`
+	// 受渡区分
+	DeliveryType DeliveryType `protobuf:"varint,17,opt,name=delivery_type,json=deliveryType,proto3,enum=kabuspb.DeliveryType" json:"delivery_type,omitempty"`
+	// 注文有効期限
+	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
+	// 信用取引区分
+	MarginTradeType MarginTradeType `protobuf:"varint,19,opt,name=margin_trade_type,json=marginTradeType,proto3,enum=kabuspb.MarginTradeType" json:"margin_trade_type,omitempty"`
+	// プレミアム料
+	MarginPremium float64 `protobuf:"fixed64,21,opt,name=margin_premium,json=marginPremium,proto3" json:"margin_premium,omitempty"`
+	// 注文詳細
+	Details []*OrderDetail `protobuf:"bytes,20,rep,name=details,proto3" json:"details,omitempty"`
+	// クライアント注文ID
+	//   このサーバーで発注したときに指定したもの
+	ClientOrderId string `protobuf:"bytes,98,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
 }
 
-func (x *PriceRankingInfo) Reset() {
-	*x = PriceRankingInfo{}
+func (x *Order) Reset() {
+	*x = Order{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[52]
+		mi := &file_kabuspb_kabus_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PriceRankingInfo) String() string {
+func (x *Order) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceRankingInfo) ProtoMessage() {}
+func (*Order) ProtoMessage() {}
 
-func (x *PriceRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[52]
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7246,216 +7981,222 @@ func (x *PriceRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceRankingInfo.ProtoReflect.Descriptor instead.
-func (*PriceRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *PriceRankingInfo) GetNo() int32 {
+func (x *Order) GetId() string {
 	if x != nil {
-		return x.No
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceRankingInfo) GetTrend() RankingTrend {
+func (x *Order) GetState() State {
 	if x != nil {
-		return x.Trend
+		return x.State
 	}
-	return RankingTrend_RANKING_TREND_UNSPECIFIED
+	return State_STATE_UNSPECIFIED
 }
 
-func (x *PriceRankingInfo) GetAverageRanking() float64 {
+func (x *Order) GetOrderState() OrderState {
 	if x != nil {
-		return x.AverageRanking
+		return x.OrderState
 	}
-	return 0
+	return OrderState_ORDER_STATE_UNSPECIFIED
 }
 
-func (x *PriceRankingInfo) GetSymbolCode() string {
+func (x *Order) GetOrderType() OrderType {
 	if x != nil {
-		return x.SymbolCode
+		return x.OrderType
 	}
-	return ""
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
 
-func (x *PriceRankingInfo) GetSymbolName() string {
+func (x *Order) GetReceiveTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SymbolName
+		return x.ReceiveTime
 	}
-	return ""
+	return nil
 }
 
-func (x *PriceRankingInfo) GetCurrentPrice() float64 {
+func (x *Order) GetSymbolCode() string {
 	if x != nil {
-		return x.CurrentPrice
+		return x.SymbolCode
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceRankingInfo) GetChangeRatio() float64 {
+func (x *Order) GetSymbolName() string {
 	if x != nil {
-		return x.ChangeRatio
+		return x.SymbolName
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceRankingInfo) GetChangePercentage() float64 {
+func (x *Order) GetExchange() OrderExchange {
 	if x != nil {
-		return x.ChangePercentage
+		return x.Exchange
 	}
-	return 0
+	return OrderExchange_ORDER_EXCHANGE_UNSPECIFIED
 }
 
-func (x *PriceRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
+func (x *Order) GetExchangeName() string {
 	if x != nil {
-		return x.CurrentPriceTime
+		return x.ExchangeName
 	}
-	return nil
+	return ""
 }
 
-func (x *PriceRankingInfo) GetTradingVolume() float64 {
+func (x *Order) GetTimeInForce() TimeInForce {
 	if x != nil {
-		return x.TradingVolume
+		return x.TimeInForce
 	}
-	return 0
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
 }
 
-func (x *PriceRankingInfo) GetTurnover() float64 {
+func (x *Order) GetPrice() float64 {
 	if x != nil {
-		return x.Turnover
+		return x.Price
 	}
 	return 0
 }
 
-func (x *PriceRankingInfo) GetExchangeName() string {
+func (x *Order) GetOrderQuantity() float64 {
 	if x != nil {
-		return x.ExchangeName
+		return x.OrderQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *PriceRankingInfo) GetIndustryName() string {
+func (x *Order) GetCumulativeQuantity() float64 {
 	if x != nil {
-		return x.IndustryName
+		return x.CumulativeQuantity
 	}
-	return ""
+	return 0
 }
 
-// TICK回数ランキング
-type TickRanking struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 市場・上場部
-	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
-	// ランキング
-	Ranking []*TickRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+func (x *Order) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_SIDE_UNSPECIFIED
 }
 
-func (x *TickRanking) Reset() {
-	*x = TickRanking{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[53]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Order) GetTradeType() TradeType {
+	if x != nil {
+		return x.TradeType
 	}
+	return TradeType_TRADE_TYPE_UNSPECIFIED
 }
 
-func (x *TickRanking) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Order) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (*TickRanking) ProtoMessage() {}
+func (x *Order) GetDeliveryType() DeliveryType {
+	if x != nil {
+		return x.DeliveryType
+	}
+	return DeliveryType_DELIVERY_TYPE_UNSPECIFIED
+}
 
-func (x *TickRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[53]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Order) GetExpireDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireDay
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TickRanking.ProtoReflect.Descriptor instead.
-func (*TickRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{53}
+func (x *Order) GetMarginTradeType() MarginTradeType {
+	if x != nil {
+		return x.MarginTradeType
+	}
+	return MarginTradeType_MARGIN_TRADE_TYPE_UNSPECIFIED
 }
 
-func (x *TickRanking) GetExchangeDivision() ExchangeDivision {
+func (x *Order) GetMarginPremium() float64 {
 	if x != nil {
-		return x.ExchangeDivision
+		return x.MarginPremium
 	}
-	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
+	return 0
 }
 
-func (x *TickRanking) GetRanking() []*TickRankingInfo {
+func (x *Order) GetDetails() []*OrderDetail {
 	if x != nil {
-		return x.Ranking
+		return x.Details
 	}
 	return nil
 }
 
-// TICK回数ランキング詳細
-type TickRankingInfo struct {
+func (x *Order) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+// 注文詳細
+type OrderDetail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 順位
-	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
-	// トレンド
-	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
-	// 平均順位
-	//   100位以下は「999」となります
-	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名称
-	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 現在値
-	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 前日比
-	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
-	// TICK回数
-	TickCount int32 `protobuf:"varint,8,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty"`
-	// UP
-	UpCount int32 `protobuf:"varint,9,opt,name=up_count,json=upCount,proto3" json:"up_count,omitempty"`
-	// DOWN
-	DownCount int32 `protobuf:"varint,10,opt,name=down_count,json=downCount,proto3" json:"down_count,omitempty"`
-	// 騰落率（%）
-	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
-	// 売買高
-	TradingVolume float64 `protobuf:"fixed64,12,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
-	// 売買代金
-	Turnover float64 `protobuf:"fixed64,13,opt,name=turnover,proto3" json:"turnover,omitempty"`
-	// 市場名
-	ExchangeName string `protobuf:"bytes,14,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 業種名
-	IndustryName string `protobuf:"bytes,15,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	// シーケンスNo
+	//   注文明細レコードの生成順序です
+	//   通番であるとは限りませんが、大小による順序は保たれています
+	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// 注文詳細番号
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 明細種別
+	RecordType RecordType `protobuf:"varint,3,opt,name=record_type,json=recordType,proto3,enum=kabuspb.RecordType" json:"record_type,omitempty"`
+	// 取引所番号
+	ExchangeId string `protobuf:"bytes,4,opt,name=exchange_id,json=exchangeId,proto3" json:"exchange_id,omitempty"`
+	// 状態
+	State OrderDetailState `protobuf:"varint,5,opt,name=state,proto3,enum=kabuspb.OrderDetailState" json:"state,omitempty"`
+	// 処理時刻
+	TransactTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=transact_time,json=transactTime,proto3" json:"transact_time,omitempty"`
+	// 執行条件
+	OrderType OrderType `protobuf:"varint,7,opt,name=order_type,json=orderType,proto3,enum=kabuspb.OrderType" json:"order_type,omitempty"`
+	// 値段
+	Price float64 `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	// 数量
+	Quantity float64 `protobuf:"fixed64,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// 約定番号
+	ExecutionId string `protobuf:"bytes,10,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// 約定日時
+	ExecutionDay *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=execution_day,json=executionDay,proto3" json:"execution_day,omitempty"`
+	// 受渡日
+	DeliveryDay *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=delivery_day,json=deliveryDay,proto3" json:"delivery_day,omitempty"`
+	// 手数料
+	//  注文詳細の明細種別が約定の場合に設定
+	Commission float64 `protobuf:"fixed64,13,opt,name=commission,proto3" json:"commission,omitempty"`
+	// 手数料消費税
+	//   明細種別は約定の場合にのみ表示されます
+	CommissionTax float64 `protobuf:"fixed64,14,opt,name=commission_tax,json=commissionTax,proto3" json:"commission_tax,omitempty"`
 }
 
-func (x *TickRankingInfo) Reset() {
-	*x = TickRankingInfo{}
+func (x *OrderDetail) Reset() {
+	*x = OrderDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[54]
+		mi := &file_kabuspb_kabus_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TickRankingInfo) String() string {
+func (x *OrderDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TickRankingInfo) ProtoMessage() {}
+func (*OrderDetail) ProtoMessage() {}
 
-func (x *TickRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[54]
+func (x *OrderDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7466,145 +8207,135 @@ func (x *TickRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TickRankingInfo.ProtoReflect.Descriptor instead.
-func (*TickRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use OrderDetail.ProtoReflect.Descriptor instead.
+func (*OrderDetail) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *TickRankingInfo) GetNo() int32 {
+func (x *OrderDetail) GetSequenceNumber() int32 {
 	if x != nil {
-		return x.No
+		return x.SequenceNumber
 	}
 	return 0
 }
 
-func (x *TickRankingInfo) GetTrend() RankingTrend {
+func (x *OrderDetail) GetId() string {
 	if x != nil {
-		return x.Trend
+		return x.Id
 	}
-	return RankingTrend_RANKING_TREND_UNSPECIFIED
+	return ""
 }
 
-func (x *TickRankingInfo) GetAverageRanking() float64 {
+func (x *OrderDetail) GetRecordType() RecordType {
 	if x != nil {
-		return x.AverageRanking
+		return x.RecordType
 	}
-	return 0
+	return RecordType_RECORD_TYPE_UNSPECIFIED
 }
 
-func (x *TickRankingInfo) GetSymbolCode() string {
+func (x *OrderDetail) GetExchangeId() string {
 	if x != nil {
-		return x.SymbolCode
+		return x.ExchangeId
 	}
 	return ""
 }
 
-func (x *TickRankingInfo) GetSymbolName() string {
+func (x *OrderDetail) GetState() OrderDetailState {
 	if x != nil {
-		return x.SymbolName
+		return x.State
 	}
-	return ""
+	return OrderDetailState_ORDER_DETAIL_STATE_UNSPECIFIED
 }
 
-func (x *TickRankingInfo) GetCurrentPrice() float64 {
+func (x *OrderDetail) GetTransactTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CurrentPrice
+		return x.TransactTime
 	}
-	return 0
+	return nil
 }
 
-func (x *TickRankingInfo) GetChangeRatio() float64 {
+func (x *OrderDetail) GetOrderType() OrderType {
 	if x != nil {
-		return x.ChangeRatio
+		return x.OrderType
 	}
-	return 0
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
 
-func (x *TickRankingInfo) GetTickCount() int32 {
+func (x *OrderDetail) GetPrice() float64 {
 	if x != nil {
-		return x.TickCount
+		return x.Price
 	}
 	return 0
 }
 
-func (x *TickRankingInfo) GetUpCount() int32 {
+func (x *OrderDetail) GetQuantity() float64 {
 	if x != nil {
-		return x.UpCount
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *TickRankingInfo) GetDownCount() int32 {
+func (x *OrderDetail) GetExecutionId() string {
 	if x != nil {
-		return x.DownCount
+		return x.ExecutionId
 	}
-	return 0
+	return ""
 }
 
-func (x *TickRankingInfo) GetChangePercentage() float64 {
+func (x *OrderDetail) GetExecutionDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ChangePercentage
+		return x.ExecutionDay
 	}
-	return 0
+	return nil
 }
 
-func (x *TickRankingInfo) GetTradingVolume() float64 {
+func (x *OrderDetail) GetDeliveryDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TradingVolume
+		return x.DeliveryDay
 	}
-	return 0
+	return nil
 }
 
-func (x *TickRankingInfo) GetTurnover() float64 {
+func (x *OrderDetail) GetCommission() float64 {
 	if x != nil {
-		return x.Turnover
+		return x.Commission
 	}
 	return 0
 }
 
-func (x *TickRankingInfo) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
-}
-
-func (x *TickRankingInfo) GetIndustryName() string {
+func (x *OrderDetail) GetCommissionTax() float64 {
 	if x != nil {
-		return x.IndustryName
+		return x.CommissionTax
 	}
-	return ""
+	return 0
 }
 
-// 売買高ランキング
-type VolumeRanking struct {
+// ポジションリスト
+type Positions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 市場・上場部
-	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
-	// ランキング
-	Ranking []*VolumeRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	Positions []*Position `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
 }
 
-func (x *VolumeRanking) Reset() {
-	*x = VolumeRanking{}
+func (x *Positions) Reset() {
+	*x = Positions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[55]
+		mi := &file_kabuspb_kabus_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VolumeRanking) String() string {
+func (x *Positions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeRanking) ProtoMessage() {}
+func (*Positions) ProtoMessage() {}
 
-func (x *VolumeRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[55]
+func (x *Positions) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7615,78 +8346,92 @@ func (x *VolumeRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeRanking.ProtoReflect.Descriptor instead.
-func (*VolumeRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *VolumeRanking) GetExchangeDivision() ExchangeDivision {
-	if x != nil {
-		return x.ExchangeDivision
-	}
-	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
+// Deprecated: Use Positions.ProtoReflect.Descriptor instead.
+func (*Positions) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *VolumeRanking) GetRanking() []*VolumeRankingInfo {
+func (x *Positions) GetPositions() []*Position {
 	if x != nil {
-		return x.Ranking
+		return x.Positions
 	}
 	return nil
 }
 
-// 売買高ランキング詳細
-type VolumeRankingInfo struct {
+// ポジション
+type Position struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 順位
-	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
-	// トレンド
-	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
-	// 平均順位
-	//   100位以下は「999」となります
-	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
+	// 約定番号
+	ExecutionId string `protobuf:"bytes,1,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	// 口座種別
+	AccountType AccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=kabuspb.AccountType" json:"account_type,omitempty"`
 	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名称
-	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 現在値
-	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 前日比
-	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
-	// 売買高急増（％）
-	RapidTradePercentage float64 `protobuf:"fixed64,8,opt,name=rapid_trade_percentage,json=rapidTradePercentage,proto3" json:"rapid_trade_percentage,omitempty"`
-	// 売買高
-	TradingVolume float64 `protobuf:"fixed64,9,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
-	// 時刻 HH:mm
-	//   日付は返しません
-	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
-	// 騰落率（%）
-	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	SymbolCode string `protobuf:"bytes,3,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名
+	SymbolName string `protobuf:"bytes,4,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 市場コード
+	Exchange Exchange `protobuf:"varint,5,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
 	// 市場名
-	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 業種名
-	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	ExchangeName string `protobuf:"bytes,6,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 銘柄種別
+	//   先物・オプション銘柄の場合のみ
+	SecurityType SecurityType `protobuf:"varint,7,opt,name=security_type,json=securityType,proto3,enum=kabuspb.SecurityType" json:"security_type,omitempty"`
+	// 約定日（建玉日）
+	//   信用・先物・オプションの場合のみ
+	ExecutionDay *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=execution_day,json=executionDay,proto3" json:"execution_day,omitempty"`
+	// 値段
+	Price float64 `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	// 残数量
+	LeavesQuantity float64 `protobuf:"fixed64,10,opt,name=leaves_quantity,json=leavesQuantity,proto3" json:"leaves_quantity,omitempty"`
+	// 拘束数量（保有数量）
+	HoldQuantity float64 `protobuf:"fixed64,11,opt,name=hold_quantity,json=holdQuantity,proto3" json:"hold_quantity,omitempty"`
+	// 売買区分
+	Side Side `protobuf:"varint,12,opt,name=side,proto3,enum=kabuspb.Side" json:"side,omitempty"`
+	// 諸経費
+	//   信用・先物・オプションの場合のみ
+	Expenses float64 `protobuf:"fixed64,13,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	// 手数料
+	//   信用・先物・オプションの場合のみ
+	Commission float64 `protobuf:"fixed64,14,opt,name=commission,proto3" json:"commission,omitempty"`
+	// 手数料消費税
+	//   信用・先物・オプションの場合のみ
+	CommissionTax float64 `protobuf:"fixed64,15,opt,name=commission_tax,json=commissionTax,proto3" json:"commission_tax,omitempty"`
+	// 返済期日
+	//   信用・先物・オプションの場合のみ
+	ExpireDay *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expire_day,json=expireDay,proto3" json:"expire_day,omitempty"`
+	// 信用取引区分
+	//   信用の場合のみ
+	MarginTradeType MarginTradeType `protobuf:"varint,17,opt,name=margin_trade_type,json=marginTradeType,proto3,enum=kabuspb.MarginTradeType" json:"margin_trade_type,omitempty"`
+	// 現在値
+	CurrentPrice float64 `protobuf:"fixed64,18,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// 評価金額
+	Valuation float64 `protobuf:"fixed64,19,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	// 評価損益額
+	ProfitLoss float64 `protobuf:"fixed64,20,opt,name=profit_loss,json=profitLoss,proto3" json:"profit_loss,omitempty"`
+	// 評価損益率
+	ProfitLossRate float64 `protobuf:"fixed64,21,opt,name=profit_loss_rate,json=profitLossRate,proto3" json:"profit_loss_rate,omitempty"`
 }
 
-func (x *VolumeRankingInfo) Reset() {
-	*x = VolumeRankingInfo{}
+func (x *Position) Reset() {
+	*x = Position{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[56]
+		mi := &file_kabuspb_kabus_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VolumeRankingInfo) String() string {
+func (x *Position) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeRankingInfo) ProtoMessage() {}
+func (*Position) ProtoMessage() {}
 
-func (x *VolumeRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[56]
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7697,213 +8442,187 @@ func (x *VolumeRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeRankingInfo.ProtoReflect.Descriptor instead.
-func (*VolumeRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *VolumeRankingInfo) GetNo() int32 {
+func (x *Position) GetExecutionId() string {
 	if x != nil {
-		return x.No
+		return x.ExecutionId
 	}
-	return 0
+	return ""
 }
 
-func (x *VolumeRankingInfo) GetTrend() RankingTrend {
+func (x *Position) GetAccountType() AccountType {
 	if x != nil {
-		return x.Trend
+		return x.AccountType
 	}
-	return RankingTrend_RANKING_TREND_UNSPECIFIED
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
 }
 
-func (x *VolumeRankingInfo) GetAverageRanking() float64 {
+func (x *Position) GetSymbolCode() string {
 	if x != nil {
-		return x.AverageRanking
+		return x.SymbolCode
 	}
-	return 0
+	return ""
 }
 
-func (x *VolumeRankingInfo) GetSymbolCode() string {
+func (x *Position) GetSymbolName() string {
 	if x != nil {
-		return x.SymbolCode
+		return x.SymbolName
 	}
 	return ""
 }
 
-func (x *VolumeRankingInfo) GetSymbolName() string {
+func (x *Position) GetExchange() Exchange {
 	if x != nil {
-		return x.SymbolName
+		return x.Exchange
 	}
-	return ""
+	return Exchange_EXCHANGE_UNSPECIFIED
 }
 
-func (x *VolumeRankingInfo) GetCurrentPrice() float64 {
+func (x *Position) GetExchangeName() string {
 	if x != nil {
-		return x.CurrentPrice
+		return x.ExchangeName
 	}
-	return 0
+	return ""
 }
 
-func (x *VolumeRankingInfo) GetChangeRatio() float64 {
+func (x *Position) GetSecurityType() SecurityType {
 	if x != nil {
-		return x.ChangeRatio
+		return x.SecurityType
 	}
-	return 0
+	return SecurityType_SECURITY_TYPE_UNSPECIFIED
 }
 
-func (x *VolumeRankingInfo) GetRapidTradePercentage() float64 {
+func (x *Position) GetExecutionDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RapidTradePercentage
+		return x.ExecutionDay
 	}
-	return 0
+	return nil
 }
 
-func (x *VolumeRankingInfo) GetTradingVolume() float64 {
+func (x *Position) GetPrice() float64 {
 	if x != nil {
-		return x.TradingVolume
+		return x.Price
 	}
 	return 0
 }
 
-func (x *VolumeRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
+func (x *Position) GetLeavesQuantity() float64 {
 	if x != nil {
-		return x.CurrentPriceTime
+		return x.LeavesQuantity
 	}
-	return nil
+	return 0
 }
 
-func (x *VolumeRankingInfo) GetChangePercentage() float64 {
+func (x *Position) GetHoldQuantity() float64 {
 	if x != nil {
-		return x.ChangePercentage
+		return x.HoldQuantity
 	}
 	return 0
 }
 
-func (x *VolumeRankingInfo) GetExchangeName() string {
+func (x *Position) GetSide() Side {
 	if x != nil {
-		return x.ExchangeName
+		return x.Side
 	}
-	return ""
+	return Side_SIDE_UNSPECIFIED
 }
 
-func (x *VolumeRankingInfo) GetIndustryName() string {
+func (x *Position) GetExpenses() float64 {
 	if x != nil {
-		return x.IndustryName
+		return x.Expenses
 	}
-	return ""
+	return 0
 }
 
-// 売買代金ランキング
-type ValueRanking struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Position) GetCommission() float64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
 
-	// 市場・上場部
-	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
-	// ランキング
-	Ranking []*ValueRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+func (x *Position) GetCommissionTax() float64 {
+	if x != nil {
+		return x.CommissionTax
+	}
+	return 0
 }
 
-func (x *ValueRanking) Reset() {
-	*x = ValueRanking{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[57]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Position) GetExpireDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireDay
 	}
+	return nil
 }
 
-func (x *ValueRanking) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Position) GetMarginTradeType() MarginTradeType {
+	if x != nil {
+		return x.MarginTradeType
+	}
+	return MarginTradeType_MARGIN_TRADE_TYPE_UNSPECIFIED
 }
 
-func (*ValueRanking) ProtoMessage() {}
-
-func (x *ValueRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[57]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Position) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ValueRanking.ProtoReflect.Descriptor instead.
-func (*ValueRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{57}
+func (x *Position) GetValuation() float64 {
+	if x != nil {
+		return x.Valuation
+	}
+	return 0
 }
 
-func (x *ValueRanking) GetExchangeDivision() ExchangeDivision {
+func (x *Position) GetProfitLoss() float64 {
 	if x != nil {
-		return x.ExchangeDivision
+		return x.ProfitLoss
 	}
-	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
+	return 0
 }
 
-func (x *ValueRanking) GetRanking() []*ValueRankingInfo {
+func (x *Position) GetProfitLossRate() float64 {
 	if x != nil {
-		return x.Ranking
+		return x.ProfitLossRate
 	}
-	return nil
+	return 0
 }
 
-// 売買代金ランキング詳細
-type ValueRankingInfo struct {
+// 銘柄登録
+type RegisteredSymbols struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 順位
-	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
-	// トレンド
-	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
-	// 平均順位
-	//   100位以下は「999」となります
-	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 銘柄名称
-	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 現在値
-	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	// 前日比
-	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
-	// 代金急増（％）
-	RapidPaymentPercentage float64 `protobuf:"fixed64,8,opt,name=rapid_payment_percentage,json=rapidPaymentPercentage,proto3" json:"rapid_payment_percentage,omitempty"`
-	// 売買代金
-	Turnover float64 `protobuf:"fixed64,9,opt,name=turnover,proto3" json:"turnover,omitempty"`
-	// 時刻 HH:mm
-	//   日付は返しません
-	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
-	// 騰落率（%）
-	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
-	// 市場名
-	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	// 業種名
-	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	// 現在登録されている銘柄のリスト
+	Symbols []*RegisterSymbol `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// 登録銘柄数
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ValueRankingInfo) Reset() {
-	*x = ValueRankingInfo{}
+func (x *RegisteredSymbols) Reset() {
+	*x = RegisteredSymbols{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[58]
+		mi := &file_kabuspb_kabus_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValueRankingInfo) String() string {
+func (x *RegisteredSymbols) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValueRankingInfo) ProtoMessage() {}
+func (*RegisteredSymbols) ProtoMessage() {}
 
-func (x *ValueRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[58]
+func (x *RegisteredSymbols) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7914,133 +8633,114 @@ func (x *ValueRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValueRankingInfo.ProtoReflect.Descriptor instead.
-func (*ValueRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *ValueRankingInfo) GetNo() int32 {
-	if x != nil {
-		return x.No
-	}
-	return 0
+// Deprecated: Use RegisteredSymbols.ProtoReflect.Descriptor instead.
+func (*RegisteredSymbols) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ValueRankingInfo) GetTrend() RankingTrend {
+func (x *RegisteredSymbols) GetSymbols() []*RegisterSymbol {
 	if x != nil {
-		return x.Trend
+		return x.Symbols
 	}
-	return RankingTrend_RANKING_TREND_UNSPECIFIED
+	return nil
 }
 
-func (x *ValueRankingInfo) GetAverageRanking() float64 {
+func (x *RegisteredSymbols) GetCount() int32 {
 	if x != nil {
-		return x.AverageRanking
+		return x.Count
 	}
 	return 0
 }
 
-func (x *ValueRankingInfo) GetSymbolCode() string {
-	if x != nil {
-		return x.SymbolCode
-	}
-	return ""
-}
-
-func (x *ValueRankingInfo) GetSymbolName() string {
-	if x != nil {
-		return x.SymbolName
-	}
-	return ""
-}
+// 銘柄登録の詳細
+type RegisterSymbol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ValueRankingInfo) GetCurrentPrice() float64 {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return 0
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 市場
+	Exchange Exchange `protobuf:"varint,2,opt,name=exchange,proto3,enum=kabuspb.Exchange" json:"exchange,omitempty"`
 }
 
-func (x *ValueRankingInfo) GetChangeRatio() float64 {
-	if x != nil {
-		return x.ChangeRatio
+func (x *RegisterSymbol) Reset() {
+	*x = RegisterSymbol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ValueRankingInfo) GetRapidPaymentPercentage() float64 {
-	if x != nil {
-		return x.RapidPaymentPercentage
-	}
-	return 0
+func (x *RegisterSymbol) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ValueRankingInfo) GetTurnover() float64 {
-	if x != nil {
-		return x.Turnover
-	}
-	return 0
-}
+func (*RegisterSymbol) ProtoMessage() {}
 
-func (x *ValueRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CurrentPriceTime
+func (x *RegisterSymbol) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ValueRankingInfo) GetChangePercentage() float64 {
-	if x != nil {
-		return x.ChangePercentage
-	}
-	return 0
+// Deprecated: Use RegisterSymbol.ProtoReflect.Descriptor instead.
+func (*RegisterSymbol) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ValueRankingInfo) GetExchangeName() string {
+func (x *RegisterSymbol) GetSymbolCode() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.SymbolCode
 	}
 	return ""
 }
 
-func (x *ValueRankingInfo) GetIndustryName() string {
+func (x *RegisterSymbol) GetExchange() Exchange {
 	if x != nil {
-		return x.IndustryName
+		return x.Exchange
 	}
-	return ""
+	return Exchange_EXCHANGE_UNSPECIFIED
 }
 
-// 信用ランキング
-type MarginRanking struct {
+// 株価ランキング
+type PriceRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 信用ランキング種別
-	Type MarginRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.MarginRankingType" json:"type,omitempty"`
+	// ランキング種別
+	Type PriceRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.PriceRankingType" json:"type,omitempty"`
 	// 市場・上場部
 	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
 	// ランキング
-	Ranking []*MarginRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	Ranking []*PriceRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *MarginRanking) Reset() {
-	*x = MarginRanking{}
+func (x *PriceRanking) Reset() {
+	*x = PriceRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[59]
+		mi := &file_kabuspb_kabus_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarginRanking) String() string {
+func (x *PriceRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRanking) ProtoMessage() {}
+func (*PriceRanking) ProtoMessage() {}
 
-func (x *MarginRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[59]
+func (x *PriceRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8051,77 +8751,85 @@ func (x *MarginRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRanking.ProtoReflect.Descriptor instead.
-func (*MarginRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use PriceRanking.ProtoReflect.Descriptor instead.
+func (*PriceRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *MarginRanking) GetType() MarginRankingType {
+func (x *PriceRanking) GetType() PriceRankingType {
 	if x != nil {
 		return x.Type
 	}
-	return MarginRankingType_MARGIN_RANKING_TYPE_UNSPECIFIED
+	return PriceRankingType_PRICE_RANKING_TYPE_UNSPECIFIED
 }
 
-func (x *MarginRanking) GetExchangeDivision() ExchangeDivision {
+func (x *PriceRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
 		return x.ExchangeDivision
 	}
 	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-func (x *MarginRanking) GetRanking() []*MarginRankingInfo {
+func (x *PriceRanking) GetRanking() []*PriceRankingInfo {
 	if x != nil {
 		return x.Ranking
 	}
 	return nil
 }
 
-// 信用ランキング詳細
-type MarginRankingInfo struct {
+// 株価ランキング詳細
+type PriceRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 順位
 	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
+	// トレンド
+	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
+	// 平均順位
+	//   100位以下は「999」となります
+	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
 	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,2,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
 	// 銘柄名称
-	SymbolName string `protobuf:"bytes,3,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
-	// 売残（千株）
-	SellRapidPaymentPercentage float64 `protobuf:"fixed64,4,opt,name=sell_rapid_payment_percentage,json=sellRapidPaymentPercentage,proto3" json:"sell_rapid_payment_percentage,omitempty"`
-	// 売残前週比
-	SellLastWeekRatio float64 `protobuf:"fixed64,5,opt,name=sell_last_week_ratio,json=sellLastWeekRatio,proto3" json:"sell_last_week_ratio,omitempty"`
-	// 買残（千株）
-	BuyRapidPaymentPercentage float64 `protobuf:"fixed64,6,opt,name=buy_rapid_payment_percentage,json=buyRapidPaymentPercentage,proto3" json:"buy_rapid_payment_percentage,omitempty"`
-	// 買残前週比
-	BuyLastWeekRatio float64 `protobuf:"fixed64,7,opt,name=buy_last_week_ratio,json=buyLastWeekRatio,proto3" json:"buy_last_week_ratio,omitempty"`
-	// 倍率
-	Ratio float64 `protobuf:"fixed64,8,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 現在値
+	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// 前日比
+	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
+	// 騰落率（%）
+	ChangePercentage float64 `protobuf:"fixed64,8,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	// 時刻 HH:mm
+	//   日付は返しません
+	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// 売買高
+	TradingVolume float64 `protobuf:"fixed64,10,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
+	// 売買代金
+	Turnover float64 `protobuf:"fixed64,11,opt,name=turnover,proto3" json:"turnover,omitempty"`
 	// 市場名
-	ExchangeName string `protobuf:"bytes,9,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
 	// 業種名
-	IndustryName string `protobuf:"bytes,10,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
 }
 
-func (x *MarginRankingInfo) Reset() {
-	*x = MarginRankingInfo{}
+func (x *PriceRankingInfo) Reset() {
+	*x = PriceRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[60]
+		mi := &file_kabuspb_kabus_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarginRankingInfo) String() string {
+func (x *PriceRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRankingInfo) ProtoMessage() {}
+func (*PriceRankingInfo) ProtoMessage() {}
 
-func (x *MarginRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[60]
+func (x *PriceRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8132,112 +8840,131 @@ func (x *MarginRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRankingInfo.ProtoReflect.Descriptor instead.
-func (*MarginRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use PriceRankingInfo.ProtoReflect.Descriptor instead.
+func (*PriceRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *MarginRankingInfo) GetNo() int32 {
+func (x *PriceRankingInfo) GetNo() int32 {
 	if x != nil {
 		return x.No
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetSymbolCode() string {
+func (x *PriceRankingInfo) GetTrend() RankingTrend {
+	if x != nil {
+		return x.Trend
+	}
+	return RankingTrend_RANKING_TREND_UNSPECIFIED
+}
+
+func (x *PriceRankingInfo) GetAverageRanking() float64 {
+	if x != nil {
+		return x.AverageRanking
+	}
+	return 0
+}
+
+func (x *PriceRankingInfo) GetSymbolCode() string {
 	if x != nil {
 		return x.SymbolCode
 	}
 	return ""
 }
 
-func (x *MarginRankingInfo) GetSymbolName() string {
+func (x *PriceRankingInfo) GetSymbolName() string {
 	if x != nil {
 		return x.SymbolName
 	}
 	return ""
 }
 
-func (x *MarginRankingInfo) GetSellRapidPaymentPercentage() float64 {
+func (x *PriceRankingInfo) GetCurrentPrice() float64 {
 	if x != nil {
-		return x.SellRapidPaymentPercentage
+		return x.CurrentPrice
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetSellLastWeekRatio() float64 {
+func (x *PriceRankingInfo) GetChangeRatio() float64 {
 	if x != nil {
-		return x.SellLastWeekRatio
+		return x.ChangeRatio
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetBuyRapidPaymentPercentage() float64 {
+func (x *PriceRankingInfo) GetChangePercentage() float64 {
 	if x != nil {
-		return x.BuyRapidPaymentPercentage
+		return x.ChangePercentage
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetBuyLastWeekRatio() float64 {
+func (x *PriceRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BuyLastWeekRatio
+		return x.CurrentPriceTime
+	}
+	return nil
+}
+
+func (x *PriceRankingInfo) GetTradingVolume() float64 {
+	if x != nil {
+		return x.TradingVolume
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetRatio() float64 {
+func (x *PriceRankingInfo) GetTurnover() float64 {
 	if x != nil {
-		return x.Ratio
+		return x.Turnover
 	}
 	return 0
 }
 
-func (x *MarginRankingInfo) GetExchangeName() string {
+func (x *PriceRankingInfo) GetExchangeName() string {
 	if x != nil {
 		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *MarginRankingInfo) GetIndustryName() string {
+func (x *PriceRankingInfo) GetIndustryName() string {
 	if x != nil {
 		return x.IndustryName
 	}
 	return ""
 }
 
-// 業種別ランキング
-type IndustryRanking struct {
+// TICK回数ランキング
+type TickRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 信用ランキング種別
-	Type IndustryRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.IndustryRankingType" json:"type,omitempty"`
 	// 市場・上場部
 	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
 	// ランキング
-	Ranking []*IndustryRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	Ranking []*TickRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *IndustryRanking) Reset() {
-	*x = IndustryRanking{}
+func (x *TickRanking) Reset() {
+	*x = TickRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[61]
+		mi := &file_kabuspb_kabus_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IndustryRanking) String() string {
+func (x *TickRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndustryRanking) ProtoMessage() {}
+func (*TickRanking) ProtoMessage() {}
 
-func (x *IndustryRanking) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[61]
+func (x *TickRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8248,34 +8975,27 @@ func (x *IndustryRanking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndustryRanking.ProtoReflect.Descriptor instead.
-func (*IndustryRanking) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *IndustryRanking) GetType() IndustryRankingType {
-	if x != nil {
-		return x.Type
-	}
-	return IndustryRankingType_INDUSTRY_RANKING_TYPE_UNSPECIFIED
+// Deprecated: Use TickRanking.ProtoReflect.Descriptor instead.
+func (*TickRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *IndustryRanking) GetExchangeDivision() ExchangeDivision {
+func (x *TickRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
 		return x.ExchangeDivision
 	}
 	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-func (x *IndustryRanking) GetRanking() []*IndustryRankingInfo {
+func (x *TickRanking) GetRanking() []*TickRankingInfo {
 	if x != nil {
 		return x.Ranking
 	}
 	return nil
 }
 
-// 業種別ランキング詳細
-type IndustryRankingInfo struct {
+// TICK回数ランキング詳細
+type TickRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -8287,38 +9007,49 @@ type IndustryRankingInfo struct {
 	// 平均順位
 	//   100位以下は「999」となります
 	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
-	// 業種コード
-	IndustryCode string `protobuf:"bytes,4,opt,name=industry_code,json=industryCode,proto3" json:"industry_code,omitempty"`
-	// 業種名
-	IndustryName string `protobuf:"bytes,5,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名称
+	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
 	// 現在値
 	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
 	// 前日比
 	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
-	// 時刻 HH:mm
-	//   日付は返しません
-	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// TICK回数
+	TickCount int32 `protobuf:"varint,8,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty"`
+	// UP
+	UpCount int32 `protobuf:"varint,9,opt,name=up_count,json=upCount,proto3" json:"up_count,omitempty"`
+	// DOWN
+	DownCount int32 `protobuf:"varint,10,opt,name=down_count,json=downCount,proto3" json:"down_count,omitempty"`
 	// 騰落率（%）
-	ChangePercentage float64 `protobuf:"fixed64,9,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	// 売買高
+	TradingVolume float64 `protobuf:"fixed64,12,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
+	// 売買代金
+	Turnover float64 `protobuf:"fixed64,13,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	// 市場名
+	ExchangeName string `protobuf:"bytes,14,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 業種名
+	IndustryName string `protobuf:"bytes,15,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
 }
 
-func (x *IndustryRankingInfo) Reset() {
-	*x = IndustryRankingInfo{}
+func (x *TickRankingInfo) Reset() {
+	*x = TickRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[62]
+		mi := &file_kabuspb_kabus_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IndustryRankingInfo) String() string {
+func (x *TickRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndustryRankingInfo) ProtoMessage() {}
+func (*TickRankingInfo) ProtoMessage() {}
 
-func (x *IndustryRankingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[62]
+func (x *TickRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8329,161 +9060,145 @@ func (x *IndustryRankingInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndustryRankingInfo.ProtoReflect.Descriptor instead.
-func (*IndustryRankingInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use TickRankingInfo.ProtoReflect.Descriptor instead.
+func (*TickRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *IndustryRankingInfo) GetNo() int32 {
+func (x *TickRankingInfo) GetNo() int32 {
 	if x != nil {
 		return x.No
 	}
 	return 0
 }
 
-func (x *IndustryRankingInfo) GetTrend() RankingTrend {
+func (x *TickRankingInfo) GetTrend() RankingTrend {
 	if x != nil {
 		return x.Trend
 	}
 	return RankingTrend_RANKING_TREND_UNSPECIFIED
 }
 
-func (x *IndustryRankingInfo) GetAverageRanking() float64 {
+func (x *TickRankingInfo) GetAverageRanking() float64 {
 	if x != nil {
 		return x.AverageRanking
 	}
 	return 0
 }
 
-func (x *IndustryRankingInfo) GetIndustryCode() string {
+func (x *TickRankingInfo) GetSymbolCode() string {
 	if x != nil {
-		return x.IndustryCode
+		return x.SymbolCode
 	}
 	return ""
 }
 
-func (x *IndustryRankingInfo) GetIndustryName() string {
+func (x *TickRankingInfo) GetSymbolName() string {
 	if x != nil {
-		return x.IndustryName
+		return x.SymbolName
 	}
 	return ""
 }
 
-func (x *IndustryRankingInfo) GetCurrentPrice() float64 {
+func (x *TickRankingInfo) GetCurrentPrice() float64 {
 	if x != nil {
 		return x.CurrentPrice
 	}
 	return 0
 }
 
-func (x *IndustryRankingInfo) GetChangeRatio() float64 {
+func (x *TickRankingInfo) GetChangeRatio() float64 {
 	if x != nil {
 		return x.ChangeRatio
 	}
 	return 0
 }
 
-func (x *IndustryRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
+func (x *TickRankingInfo) GetTickCount() int32 {
 	if x != nil {
-		return x.CurrentPriceTime
+		return x.TickCount
 	}
-	return nil
+	return 0
 }
 
-func (x *IndustryRankingInfo) GetChangePercentage() float64 {
+func (x *TickRankingInfo) GetUpCount() int32 {
 	if x != nil {
-		return x.ChangePercentage
+		return x.UpCount
 	}
 	return 0
 }
 
-// 注文発注レスポンス
-type OrderResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TickRankingInfo) GetDownCount() int32 {
+	if x != nil {
+		return x.DownCount
+	}
+	return 0
+}
 
-	// 結果コード
-	//   0が成功
-	//   それ以外はエラーコード
-	ResultCode int32 `protobuf:"varint,1,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
-	// 受付注文番号
-	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+func (x *TickRankingInfo) GetChangePercentage() float64 {
+	if x != nil {
+		return x.ChangePercentage
+	}
+	return 0
 }
 
-func (x *OrderResponse) Reset() {
-	*x = OrderResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[63]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TickRankingInfo) GetTradingVolume() float64 {
+	if x != nil {
+		return x.TradingVolume
 	}
+	return 0
 }
 
-func (x *OrderResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TickRankingInfo) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
 }
 
-func (*OrderResponse) ProtoMessage() {}
+func (x *TickRankingInfo) GetExchangeName() string {
+	if x != nil {
+		return x.ExchangeName
+	}
+	return ""
+}
 
-func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[63]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
-func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *OrderResponse) GetResultCode() int32 {
-	if x != nil {
-		return x.ResultCode
-	}
-	return 0
-}
-
-func (x *OrderResponse) GetOrderId() string {
+func (x *TickRankingInfo) GetIndustryName() string {
 	if x != nil {
-		return x.OrderId
+		return x.IndustryName
 	}
 	return ""
 }
 
-// 取引余力（現物）
-type StockWallet struct {
+// 売買高ランキング
+type VolumeRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 現物買付可能額
-	StockAccountWallet float64 `protobuf:"fixed64,1,opt,name=stock_account_wallet,json=stockAccountWallet,proto3" json:"stock_account_wallet,omitempty"`
+	// 市場・上場部
+	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
+	// ランキング
+	Ranking []*VolumeRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *StockWallet) Reset() {
-	*x = StockWallet{}
+func (x *VolumeRanking) Reset() {
+	*x = VolumeRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[64]
+		mi := &file_kabuspb_kabus_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StockWallet) String() string {
+func (x *VolumeRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockWallet) ProtoMessage() {}
+func (*VolumeRanking) ProtoMessage() {}
 
-func (x *StockWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[64]
+func (x *VolumeRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8494,54 +9209,78 @@ func (x *StockWallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockWallet.ProtoReflect.Descriptor instead.
-func (*StockWallet) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use VolumeRanking.ProtoReflect.Descriptor instead.
+func (*VolumeRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *StockWallet) GetStockAccountWallet() float64 {
+func (x *VolumeRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
-		return x.StockAccountWallet
+		return x.ExchangeDivision
 	}
-	return 0
+	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-// 取引余力（信用）
-type MarginWallet struct {
+func (x *VolumeRanking) GetRanking() []*VolumeRankingInfo {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+// 売買高ランキング詳細
+type VolumeRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 信用新規可能額
-	MarginAccountWallet float64 `protobuf:"fixed64,1,opt,name=margin_account_wallet,json=marginAccountWallet,proto3" json:"margin_account_wallet,omitempty"`
-	// 保証金維持率
-	//   銘柄指定の場合のみ
-	DepositKeepRate float64 `protobuf:"fixed64,2,opt,name=deposit_keep_rate,json=depositKeepRate,proto3" json:"deposit_keep_rate,omitempty"`
-	// 委託保証金率
-	//   銘柄指定の場合のみ
-	ConsignmentDepositRate float64 `protobuf:"fixed64,3,opt,name=consignment_deposit_rate,json=consignmentDepositRate,proto3" json:"consignment_deposit_rate,omitempty"`
-	// 現金委託保証金率
-	//   銘柄指定の場合のみ
-	CashOfConsignmentDepositRate float64 `protobuf:"fixed64,4,opt,name=cash_of_consignment_deposit_rate,json=cashOfConsignmentDepositRate,proto3" json:"cash_of_consignment_deposit_rate,omitempty"`
+	// 順位
+	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
+	// トレンド
+	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
+	// 平均順位
+	//   100位以下は「999」となります
+	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名称
+	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 現在値
+	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// 前日比
+	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
+	// 売買高急増（％）
+	RapidTradePercentage float64 `protobuf:"fixed64,8,opt,name=rapid_trade_percentage,json=rapidTradePercentage,proto3" json:"rapid_trade_percentage,omitempty"`
+	// 売買高
+	TradingVolume float64 `protobuf:"fixed64,9,opt,name=trading_volume,json=tradingVolume,proto3" json:"trading_volume,omitempty"`
+	// 時刻 HH:mm
+	//   日付は返しません
+	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// 騰落率（%）
+	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	// 市場名
+	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 業種名
+	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
 }
 
-func (x *MarginWallet) Reset() {
-	*x = MarginWallet{}
+func (x *VolumeRankingInfo) Reset() {
+	*x = VolumeRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[65]
+		mi := &file_kabuspb_kabus_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarginWallet) String() string {
+func (x *VolumeRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginWallet) ProtoMessage() {}
+func (*VolumeRankingInfo) ProtoMessage() {}
 
-func (x *MarginWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[65]
+func (x *VolumeRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8552,130 +9291,131 @@ func (x *MarginWallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginWallet.ProtoReflect.Descriptor instead.
-func (*MarginWallet) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use VolumeRankingInfo.ProtoReflect.Descriptor instead.
+func (*VolumeRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *MarginWallet) GetMarginAccountWallet() float64 {
+func (x *VolumeRankingInfo) GetNo() int32 {
 	if x != nil {
-		return x.MarginAccountWallet
+		return x.No
 	}
 	return 0
 }
 
-func (x *MarginWallet) GetDepositKeepRate() float64 {
+func (x *VolumeRankingInfo) GetTrend() RankingTrend {
 	if x != nil {
-		return x.DepositKeepRate
+		return x.Trend
 	}
-	return 0
+	return RankingTrend_RANKING_TREND_UNSPECIFIED
 }
 
-func (x *MarginWallet) GetConsignmentDepositRate() float64 {
+func (x *VolumeRankingInfo) GetAverageRanking() float64 {
 	if x != nil {
-		return x.ConsignmentDepositRate
+		return x.AverageRanking
 	}
 	return 0
 }
 
-func (x *MarginWallet) GetCashOfConsignmentDepositRate() float64 {
+func (x *VolumeRankingInfo) GetSymbolCode() string {
 	if x != nil {
-		return x.CashOfConsignmentDepositRate
+		return x.SymbolCode
 	}
-	return 0
+	return ""
 }
 
-// 取引余力（先物）
-type FutureWallet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 新規建玉可能額
-	FutureTradeLimit float64 `protobuf:"fixed64,1,opt,name=future_trade_limit,json=futureTradeLimit,proto3" json:"future_trade_limit,omitempty"`
-	// 必要証拠金額
-	//   銘柄指定の場合のみ。
-	MarginRequirement float64 `protobuf:"fixed64,2,opt,name=margin_requirement,json=marginRequirement,proto3" json:"margin_requirement,omitempty"`
+func (x *VolumeRankingInfo) GetSymbolName() string {
+	if x != nil {
+		return x.SymbolName
+	}
+	return ""
 }
 
-func (x *FutureWallet) Reset() {
-	*x = FutureWallet{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *VolumeRankingInfo) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
 	}
+	return 0
 }
 
-func (x *FutureWallet) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VolumeRankingInfo) GetChangeRatio() float64 {
+	if x != nil {
+		return x.ChangeRatio
+	}
+	return 0
 }
 
-func (*FutureWallet) ProtoMessage() {}
+func (x *VolumeRankingInfo) GetRapidTradePercentage() float64 {
+	if x != nil {
+		return x.RapidTradePercentage
+	}
+	return 0
+}
 
-func (x *FutureWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *VolumeRankingInfo) GetTradingVolume() float64 {
+	if x != nil {
+		return x.TradingVolume
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use FutureWallet.ProtoReflect.Descriptor instead.
-func (*FutureWallet) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{66}
+func (x *VolumeRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPriceTime
+	}
+	return nil
 }
 
-func (x *FutureWallet) GetFutureTradeLimit() float64 {
+func (x *VolumeRankingInfo) GetChangePercentage() float64 {
 	if x != nil {
-		return x.FutureTradeLimit
+		return x.ChangePercentage
 	}
 	return 0
 }
 
-func (x *FutureWallet) GetMarginRequirement() float64 {
+func (x *VolumeRankingInfo) GetExchangeName() string {
 	if x != nil {
-		return x.MarginRequirement
+		return x.ExchangeName
 	}
-	return 0
+	return ""
 }
 
-// 取引余力（オプション）
-type OptionWallet struct {
+func (x *VolumeRankingInfo) GetIndustryName() string {
+	if x != nil {
+		return x.IndustryName
+	}
+	return ""
+}
+
+// 売買代金ランキング
+type ValueRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 買新規建玉可能額
-	OptionBuyTradeLimit float64 `protobuf:"fixed64,1,opt,name=option_buy_trade_limit,json=optionBuyTradeLimit,proto3" json:"option_buy_trade_limit,omitempty"`
-	// 売新規建玉可能額
-	OptionSellTradeLimit float64 `protobuf:"fixed64,2,opt,name=option_sell_trade_limit,json=optionSellTradeLimit,proto3" json:"option_sell_trade_limit,omitempty"`
-	// 必要証拠金額
-	//   銘柄指定の場合のみ。
-	MarginRequirement float64 `protobuf:"fixed64,3,opt,name=margin_requirement,json=marginRequirement,proto3" json:"margin_requirement,omitempty"`
+	// 市場・上場部
+	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
+	// ランキング
+	Ranking []*ValueRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *OptionWallet) Reset() {
-	*x = OptionWallet{}
+func (x *ValueRanking) Reset() {
+	*x = ValueRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[67]
+		mi := &file_kabuspb_kabus_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OptionWallet) String() string {
+func (x *ValueRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OptionWallet) ProtoMessage() {}
+func (*ValueRanking) ProtoMessage() {}
 
-func (x *OptionWallet) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[67]
+func (x *ValueRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8686,69 +9426,78 @@ func (x *OptionWallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OptionWallet.ProtoReflect.Descriptor instead.
-func (*OptionWallet) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *OptionWallet) GetOptionBuyTradeLimit() float64 {
-	if x != nil {
-		return x.OptionBuyTradeLimit
-	}
-	return 0
+// Deprecated: Use ValueRanking.ProtoReflect.Descriptor instead.
+func (*ValueRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *OptionWallet) GetOptionSellTradeLimit() float64 {
+func (x *ValueRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
-		return x.OptionSellTradeLimit
+		return x.ExchangeDivision
 	}
-	return 0
+	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-func (x *OptionWallet) GetMarginRequirement() float64 {
+func (x *ValueRanking) GetRanking() []*ValueRankingInfo {
 	if x != nil {
-		return x.MarginRequirement
+		return x.Ranking
 	}
-	return 0
+	return nil
 }
 
-// 為替情報
-type ExchangeInfo struct {
+// 売買代金ランキング詳細
+type ValueRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 通貨
-	Currency Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=kabuspb.Currency" json:"currency,omitempty"`
-	// BID
-	BidPrice float64 `protobuf:"fixed64,2,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
-	// SP
-	Spread float64 `protobuf:"fixed64,3,opt,name=spread,proto3" json:"spread,omitempty"`
-	// ASK
-	AskPrice float64 `protobuf:"fixed64,4,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	// 順位
+	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
+	// トレンド
+	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
+	// 平均順位
+	//   100位以下は「999」となります
+	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名称
+	SymbolName string `protobuf:"bytes,5,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 現在値
+	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
 	// 前日比
-	Change float64 `protobuf:"fixed64,5,opt,name=change,proto3" json:"change,omitempty"`
-	// 時刻
-	Time *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
+	// 代金急増（％）
+	RapidPaymentPercentage float64 `protobuf:"fixed64,8,opt,name=rapid_payment_percentage,json=rapidPaymentPercentage,proto3" json:"rapid_payment_percentage,omitempty"`
+	// 売買代金
+	Turnover float64 `protobuf:"fixed64,9,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	// 時刻 HH:mm
+	//   日付は返しません
+	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// 騰落率（%）
+	ChangePercentage float64 `protobuf:"fixed64,11,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	// 市場名
+	ExchangeName string `protobuf:"bytes,12,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 業種名
+	IndustryName string `protobuf:"bytes,13,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
 }
 
-func (x *ExchangeInfo) Reset() {
-	*x = ExchangeInfo{}
+func (x *ValueRankingInfo) Reset() {
+	*x = ValueRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[68]
+		mi := &file_kabuspb_kabus_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExchangeInfo) String() string {
+func (x *ValueRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExchangeInfo) ProtoMessage() {}
+func (*ValueRankingInfo) ProtoMessage() {}
 
-func (x *ExchangeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[68]
+func (x *ValueRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8759,149 +9508,133 @@ func (x *ExchangeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExchangeInfo.ProtoReflect.Descriptor instead.
-func (*ExchangeInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ValueRankingInfo.ProtoReflect.Descriptor instead.
+func (*ValueRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ExchangeInfo) GetCurrency() Currency {
+func (x *ValueRankingInfo) GetNo() int32 {
 	if x != nil {
-		return x.Currency
+		return x.No
 	}
-	return Currency_CURRENCY_UNSPECIFIED
+	return 0
 }
 
-func (x *ExchangeInfo) GetBidPrice() float64 {
+func (x *ValueRankingInfo) GetTrend() RankingTrend {
 	if x != nil {
-		return x.BidPrice
+		return x.Trend
 	}
-	return 0
+	return RankingTrend_RANKING_TREND_UNSPECIFIED
 }
 
-func (x *ExchangeInfo) GetSpread() float64 {
+func (x *ValueRankingInfo) GetAverageRanking() float64 {
 	if x != nil {
-		return x.Spread
+		return x.AverageRanking
 	}
 	return 0
 }
 
-func (x *ExchangeInfo) GetAskPrice() float64 {
+func (x *ValueRankingInfo) GetSymbolCode() string {
 	if x != nil {
-		return x.AskPrice
+		return x.SymbolCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ExchangeInfo) GetChange() float64 {
+func (x *ValueRankingInfo) GetSymbolName() string {
 	if x != nil {
-		return x.Change
+		return x.SymbolName
 	}
-	return 0
+	return ""
 }
 
-func (x *ExchangeInfo) GetTime() *timestamppb.Timestamp {
+func (x *ValueRankingInfo) GetCurrentPrice() float64 {
 	if x != nil {
-		return x.Time
+		return x.CurrentPrice
 	}
-	return nil
+	return 0
 }
 
-// 規制情報
-type Regulation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 銘柄コード
-	SymbolCode         string            `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	RegulationInfoList []*RegulationInfo `protobuf:"bytes,2,rep,name=regulation_info_list,json=regulationInfoList,proto3" json:"regulation_info_list,omitempty"`
+func (x *ValueRankingInfo) GetChangeRatio() float64 {
+	if x != nil {
+		return x.ChangeRatio
+	}
+	return 0
 }
 
-func (x *Regulation) Reset() {
-	*x = Regulation{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[69]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ValueRankingInfo) GetRapidPaymentPercentage() float64 {
+	if x != nil {
+		return x.RapidPaymentPercentage
 	}
+	return 0
 }
 
-func (x *Regulation) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ValueRankingInfo) GetTurnover() float64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
 }
 
-func (*Regulation) ProtoMessage() {}
-
-func (x *Regulation) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[69]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ValueRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPriceTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Regulation.ProtoReflect.Descriptor instead.
-func (*Regulation) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{69}
+func (x *ValueRankingInfo) GetChangePercentage() float64 {
+	if x != nil {
+		return x.ChangePercentage
+	}
+	return 0
 }
 
-func (x *Regulation) GetSymbolCode() string {
+func (x *ValueRankingInfo) GetExchangeName() string {
 	if x != nil {
-		return x.SymbolCode
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *Regulation) GetRegulationInfoList() []*RegulationInfo {
+func (x *ValueRankingInfo) GetIndustryName() string {
 	if x != nil {
-		return x.RegulationInfoList
+		return x.IndustryName
 	}
-	return nil
+	return ""
 }
 
-// 規制情報詳細
-type RegulationInfo struct {
+// 信用ランキング
+type MarginRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 規制市場
-	Exchange RegulationExchange `protobuf:"varint,1,opt,name=exchange,proto3,enum=kabuspb.RegulationExchange" json:"exchange,omitempty"`
-	// 規制取引区分
-	Product RegulationProduct `protobuf:"varint,2,opt,name=product,proto3,enum=kabuspb.RegulationProduct" json:"product,omitempty"`
-	// 規制売買
-	Side RegulationSide `protobuf:"varint,3,opt,name=side,proto3,enum=kabuspb.RegulationSide" json:"side,omitempty"`
-	// 理由
-	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	// 制限開始日
-	LimitStartDay *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=limit_start_day,json=limitStartDay,proto3" json:"limit_start_day,omitempty"`
-	// 制限終了日
-	LimitEndDay *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=limit_end_day,json=limitEndDay,proto3" json:"limit_end_day,omitempty"`
-	// コンプライアンスレベル
-	Level RegulationLevel `protobuf:"varint,7,opt,name=level,proto3,enum=kabuspb.RegulationLevel" json:"level,omitempty"`
+	// 信用ランキング種別
+	Type MarginRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.MarginRankingType" json:"type,omitempty"`
+	// 市場・上場部
+	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
+	// ランキング
+	Ranking []*MarginRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *RegulationInfo) Reset() {
-	*x = RegulationInfo{}
+func (x *MarginRanking) Reset() {
+	*x = MarginRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[70]
+		mi := &file_kabuspb_kabus_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegulationInfo) String() string {
+func (x *MarginRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegulationInfo) ProtoMessage() {}
+func (*MarginRanking) ProtoMessage() {}
 
-func (x *RegulationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[70]
+func (x *MarginRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8912,89 +9645,77 @@ func (x *RegulationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegulationInfo.ProtoReflect.Descriptor instead.
-func (*RegulationInfo) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use MarginRanking.ProtoReflect.Descriptor instead.
+func (*MarginRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *RegulationInfo) GetExchange() RegulationExchange {
+func (x *MarginRanking) GetType() MarginRankingType {
 	if x != nil {
-		return x.Exchange
+		return x.Type
 	}
-	return RegulationExchange_REGULATION_EXCHANGE_UNSPECIFIED
+	return MarginRankingType_MARGIN_RANKING_TYPE_UNSPECIFIED
 }
 
-func (x *RegulationInfo) GetProduct() RegulationProduct {
+func (x *MarginRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
-		return x.Product
+		return x.ExchangeDivision
 	}
-	return RegulationProduct_REGULATION_PRODUCT_UNSPECIFIED
+	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-func (x *RegulationInfo) GetSide() RegulationSide {
+func (x *MarginRanking) GetRanking() []*MarginRankingInfo {
 	if x != nil {
-		return x.Side
+		return x.Ranking
 	}
-	return RegulationSide_REGULATION_SIDE_UNSPECIFIED
+	return nil
 }
 
-func (x *RegulationInfo) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *RegulationInfo) GetLimitStartDay() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LimitStartDay
-	}
-	return nil
-}
-
-func (x *RegulationInfo) GetLimitEndDay() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LimitEndDay
-	}
-	return nil
-}
-
-func (x *RegulationInfo) GetLevel() RegulationLevel {
-	if x != nil {
-		return x.Level
-	}
-	return RegulationLevel_REGULATION_LEVEL_UNSPECIFIED
-}
-
-// 優先市場
-type PrimaryExchange struct {
+// 信用ランキング詳細
+type MarginRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// 順位
+	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
 	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 優先市場
-	PrimaryExchange StockExchange `protobuf:"varint,2,opt,name=primary_exchange,json=primaryExchange,proto3,enum=kabuspb.StockExchange" json:"primary_exchange,omitempty"`
+	SymbolCode string `protobuf:"bytes,2,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 銘柄名称
+	SymbolName string `protobuf:"bytes,3,opt,name=symbol_name,json=symbolName,proto3" json:"symbol_name,omitempty"`
+	// 売残（千株）
+	SellRapidPaymentPercentage float64 `protobuf:"fixed64,4,opt,name=sell_rapid_payment_percentage,json=sellRapidPaymentPercentage,proto3" json:"sell_rapid_payment_percentage,omitempty"`
+	// 売残前週比
+	SellLastWeekRatio float64 `protobuf:"fixed64,5,opt,name=sell_last_week_ratio,json=sellLastWeekRatio,proto3" json:"sell_last_week_ratio,omitempty"`
+	// 買残（千株）
+	BuyRapidPaymentPercentage float64 `protobuf:"fixed64,6,opt,name=buy_rapid_payment_percentage,json=buyRapidPaymentPercentage,proto3" json:"buy_rapid_payment_percentage,omitempty"`
+	// 買残前週比
+	BuyLastWeekRatio float64 `protobuf:"fixed64,7,opt,name=buy_last_week_ratio,json=buyLastWeekRatio,proto3" json:"buy_last_week_ratio,omitempty"`
+	// 倍率
+	Ratio float64 `protobuf:"fixed64,8,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	// 市場名
+	ExchangeName string `protobuf:"bytes,9,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	// 業種名
+	IndustryName string `protobuf:"bytes,10,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
 }
 
-func (x *PrimaryExchange) Reset() {
-	*x = PrimaryExchange{}
+func (x *MarginRankingInfo) Reset() {
+	*x = MarginRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[71]
+		mi := &file_kabuspb_kabus_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrimaryExchange) String() string {
+func (x *MarginRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrimaryExchange) ProtoMessage() {}
+func (*MarginRankingInfo) ProtoMessage() {}
 
-func (x *PrimaryExchange) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[71]
+func (x *MarginRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9005,155 +9726,112 @@ func (x *PrimaryExchange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrimaryExchange.ProtoReflect.Descriptor instead.
-func (*PrimaryExchange) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use MarginRankingInfo.ProtoReflect.Descriptor instead.
+func (*MarginRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *PrimaryExchange) GetSymbolCode() string {
+func (x *MarginRankingInfo) GetNo() int32 {
 	if x != nil {
-		return x.SymbolCode
+		return x.No
 	}
-	return ""
+	return 0
 }
 
-func (x *PrimaryExchange) GetPrimaryExchange() StockExchange {
+func (x *MarginRankingInfo) GetSymbolCode() string {
 	if x != nil {
-		return x.PrimaryExchange
+		return x.SymbolCode
 	}
-	return StockExchange_STOCK_EXCHANGE_UNSPECIFIED
-}
-
-// ソフトリミット
-type SoftLimit struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 現物のワンショット上限
-	//   単位は万円
-	Stock float64 `protobuf:"fixed64,1,opt,name=stock,proto3" json:"stock,omitempty"`
-	// 信用のワンショット上限
-	//   単位は万円
-	Margin float64 `protobuf:"fixed64,2,opt,name=margin,proto3" json:"margin,omitempty"`
-	// 先物のワンショット上限
-	//   単位は枚
-	Future float64 `protobuf:"fixed64,3,opt,name=future,proto3" json:"future,omitempty"`
-	// 先物ミニのワンショット上限
-	//   単位は枚
-	FutureMini float64 `protobuf:"fixed64,4,opt,name=future_mini,json=futureMini,proto3" json:"future_mini,omitempty"`
-	// オプションのワンショット上限
-	//   単位は枚
-	Option float64 `protobuf:"fixed64,5,opt,name=option,proto3" json:"option,omitempty"`
-	// kabuステーションのバージョン
-	KabusVersion string `protobuf:"bytes,6,opt,name=kabus_version,json=kabusVersion,proto3" json:"kabus_version,omitempty"`
+	return ""
 }
 
-func (x *SoftLimit) Reset() {
-	*x = SoftLimit{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[72]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MarginRankingInfo) GetSymbolName() string {
+	if x != nil {
+		return x.SymbolName
 	}
+	return ""
 }
 
-func (x *SoftLimit) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SoftLimit) ProtoMessage() {}
-
-func (x *SoftLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[72]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MarginRankingInfo) GetSellRapidPaymentPercentage() float64 {
+	if x != nil {
+		return x.SellRapidPaymentPercentage
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SoftLimit.ProtoReflect.Descriptor instead.
-func (*SoftLimit) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{72}
+	return 0
 }
 
-func (x *SoftLimit) GetStock() float64 {
+func (x *MarginRankingInfo) GetSellLastWeekRatio() float64 {
 	if x != nil {
-		return x.Stock
+		return x.SellLastWeekRatio
 	}
 	return 0
 }
 
-func (x *SoftLimit) GetMargin() float64 {
+func (x *MarginRankingInfo) GetBuyRapidPaymentPercentage() float64 {
 	if x != nil {
-		return x.Margin
+		return x.BuyRapidPaymentPercentage
 	}
 	return 0
 }
 
-func (x *SoftLimit) GetFuture() float64 {
+func (x *MarginRankingInfo) GetBuyLastWeekRatio() float64 {
 	if x != nil {
-		return x.Future
+		return x.BuyLastWeekRatio
 	}
 	return 0
 }
 
-func (x *SoftLimit) GetFutureMini() float64 {
+func (x *MarginRankingInfo) GetRatio() float64 {
 	if x != nil {
-		return x.FutureMini
+		return x.Ratio
 	}
 	return 0
 }
 
-func (x *SoftLimit) GetOption() float64 {
+func (x *MarginRankingInfo) GetExchangeName() string {
 	if x != nil {
-		return x.Option
+		return x.ExchangeName
 	}
-	return 0
+	return ""
 }
 
-func (x *SoftLimit) GetKabusVersion() string {
+func (x *MarginRankingInfo) GetIndustryName() string {
 	if x != nil {
-		return x.KabusVersion
+		return x.IndustryName
 	}
 	return ""
 }
 
-// プレミアム料取得
-type MarginPremium struct {
+// 業種別ランキング
+type IndustryRanking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 銘柄コード
-	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
-	// 一般信用（長期）
-	GeneralMargin *MarginPremiumDetail `protobuf:"bytes,2,opt,name=general_margin,json=generalMargin,proto3" json:"general_margin,omitempty"`
-	// 一般信用（デイトレ）
-	DayTrade *MarginPremiumDetail `protobuf:"bytes,3,opt,name=day_trade,json=dayTrade,proto3" json:"day_trade,omitempty"`
+	// 信用ランキング種別
+	Type IndustryRankingType `protobuf:"varint,1,opt,name=type,proto3,enum=kabuspb.IndustryRankingType" json:"type,omitempty"`
+	// 市場・上場部
+	ExchangeDivision ExchangeDivision `protobuf:"varint,2,opt,name=exchange_division,json=exchangeDivision,proto3,enum=kabuspb.ExchangeDivision" json:"exchange_division,omitempty"`
+	// ランキング
+	Ranking []*IndustryRankingInfo `protobuf:"bytes,3,rep,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *MarginPremium) Reset() {
-	*x = MarginPremium{}
+func (x *IndustryRanking) Reset() {
+	*x = IndustryRanking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[73]
+		mi := &file_kabuspb_kabus_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarginPremium) String() string {
+func (x *IndustryRanking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginPremium) ProtoMessage() {}
+func (*IndustryRanking) ProtoMessage() {}
 
-func (x *MarginPremium) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[73]
+func (x *IndustryRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9164,66 +9842,77 @@ func (x *MarginPremium) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginPremium.ProtoReflect.Descriptor instead.
-func (*MarginPremium) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use IndustryRanking.ProtoReflect.Descriptor instead.
+func (*IndustryRanking) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *MarginPremium) GetSymbolCode() string {
+func (x *IndustryRanking) GetType() IndustryRankingType {
 	if x != nil {
-		return x.SymbolCode
+		return x.Type
 	}
-	return ""
+	return IndustryRankingType_INDUSTRY_RANKING_TYPE_UNSPECIFIED
 }
 
-func (x *MarginPremium) GetGeneralMargin() *MarginPremiumDetail {
+func (x *IndustryRanking) GetExchangeDivision() ExchangeDivision {
 	if x != nil {
-		return x.GeneralMargin
+		return x.ExchangeDivision
 	}
-	return nil
+	return ExchangeDivision_EXCHANGE_DIVISION_UNSPECIFIED
 }
 
-func (x *MarginPremium) GetDayTrade() *MarginPremiumDetail {
+func (x *IndustryRanking) GetRanking() []*IndustryRankingInfo {
 	if x != nil {
-		return x.DayTrade
+		return x.Ranking
 	}
 	return nil
 }
 
-type MarginPremiumDetail struct {
+// 業種別ランキング詳細
+type IndustryRankingInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// プレミアム料入力区分
-	MarginPremiumType MarginPremiumType `protobuf:"varint,1,opt,name=margin_premium_type,json=marginPremiumType,proto3,enum=kabuspb.MarginPremiumType" json:"margin_premium_type,omitempty"`
-	// 確定プレミアム料
-	MarginPremium float64 `protobuf:"fixed64,2,opt,name=margin_premium,json=marginPremium,proto3" json:"margin_premium,omitempty"`
-	// 上限プレミアム料
-	UpperMarginPremium float64 `protobuf:"fixed64,3,opt,name=upper_margin_premium,json=upperMarginPremium,proto3" json:"upper_margin_premium,omitempty"`
-	// 下限プレミアム料
-	LowerMarginPremium float64 `protobuf:"fixed64,4,opt,name=lower_margin_premium,json=lowerMarginPremium,proto3" json:"lower_margin_premium,omitempty"`
-	// プレミアム料刻値
-	TickMarginPremium float64 `protobuf:"fixed64,5,opt,name=tick_margin_premium,json=tickMarginPremium,proto3" json:"tick_margin_premium,omitempty"`
+	// 順位
+	No int32 `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`
+	// トレンド
+	Trend RankingTrend `protobuf:"varint,2,opt,name=trend,proto3,enum=kabuspb.RankingTrend" json:"trend,omitempty"`
+	// 平均順位
+	//   100位以下は「999」となります
+	AverageRanking float64 `protobuf:"fixed64,3,opt,name=average_ranking,json=averageRanking,proto3" json:"average_ranking,omitempty"`
+	// 業種コード
+	IndustryCode string `protobuf:"bytes,4,opt,name=industry_code,json=industryCode,proto3" json:"industry_code,omitempty"`
+	// 業種名
+	IndustryName string `protobuf:"bytes,5,opt,name=industry_name,json=industryName,proto3" json:"industry_name,omitempty"`
+	// 現在値
+	CurrentPrice float64 `protobuf:"fixed64,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	// 前日比
+	ChangeRatio float64 `protobuf:"fixed64,7,opt,name=change_ratio,json=changeRatio,proto3" json:"change_ratio,omitempty"`
+	// 時刻 HH:mm
+	//   日付は返しません
+	CurrentPriceTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=current_price_time,json=currentPriceTime,proto3" json:"current_price_time,omitempty"`
+	// 騰落率（%）
+	ChangePercentage float64 `protobuf:"fixed64,9,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
 }
 
-func (x *MarginPremiumDetail) Reset() {
-	*x = MarginPremiumDetail{}
+func (x *IndustryRankingInfo) Reset() {
+	*x = IndustryRankingInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[74]
+		mi := &file_kabuspb_kabus_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarginPremiumDetail) String() string {
+func (x *IndustryRankingInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginPremiumDetail) ProtoMessage() {}
+func (*IndustryRankingInfo) ProtoMessage() {}
 
-func (x *MarginPremiumDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[74]
+func (x *IndustryRankingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9234,74 +9923,105 @@ func (x *MarginPremiumDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginPremiumDetail.ProtoReflect.Descriptor instead.
-func (*MarginPremiumDetail) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use IndustryRankingInfo.ProtoReflect.Descriptor instead.
+func (*IndustryRankingInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *MarginPremiumDetail) GetMarginPremiumType() MarginPremiumType {
+func (x *IndustryRankingInfo) GetNo() int32 {
 	if x != nil {
-		return x.MarginPremiumType
+		return x.No
 	}
-	return MarginPremiumType_MARGIN_PREMIUM_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *MarginPremiumDetail) GetMarginPremium() float64 {
+func (x *IndustryRankingInfo) GetTrend() RankingTrend {
 	if x != nil {
-		return x.MarginPremium
+		return x.Trend
+	}
+	return RankingTrend_RANKING_TREND_UNSPECIFIED
+}
+
+func (x *IndustryRankingInfo) GetAverageRanking() float64 {
+	if x != nil {
+		return x.AverageRanking
 	}
 	return 0
 }
 
-func (x *MarginPremiumDetail) GetUpperMarginPremium() float64 {
+func (x *IndustryRankingInfo) GetIndustryCode() string {
 	if x != nil {
-		return x.UpperMarginPremium
+		return x.IndustryCode
+	}
+	return ""
+}
+
+func (x *IndustryRankingInfo) GetIndustryName() string {
+	if x != nil {
+		return x.IndustryName
+	}
+	return ""
+}
+
+func (x *IndustryRankingInfo) GetCurrentPrice() float64 {
+	if x != nil {
+		return x.CurrentPrice
 	}
 	return 0
 }
 
-func (x *MarginPremiumDetail) GetLowerMarginPremium() float64 {
+func (x *IndustryRankingInfo) GetChangeRatio() float64 {
 	if x != nil {
-		return x.LowerMarginPremium
+		return x.ChangeRatio
 	}
 	return 0
 }
 
-func (x *MarginPremiumDetail) GetTickMarginPremium() float64 {
+func (x *IndustryRankingInfo) GetCurrentPriceTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TickMarginPremium
+		return x.CurrentPriceTime
+	}
+	return nil
+}
+
+func (x *IndustryRankingInfo) GetChangePercentage() float64 {
+	if x != nil {
+		return x.ChangePercentage
 	}
 	return 0
 }
 
-type RequestError struct {
+// 注文発注レスポンス
+type OrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32  `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Body       string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Code       int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
-	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 結果コード
+	//   0が成功
+	//   それ以外はエラーコード
+	ResultCode int32 `protobuf:"varint,1,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	// 受付注文番号
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (x *RequestError) Reset() {
-	*x = RequestError{}
+func (x *OrderResponse) Reset() {
+	*x = OrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kabuspb_kabus_proto_msgTypes[75]
+		mi := &file_kabuspb_kabus_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestError) String() string {
+func (x *OrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestError) ProtoMessage() {}
+func (*OrderResponse) ProtoMessage() {}
 
-func (x *RequestError) ProtoReflect() protoreflect.Message {
-	mi := &file_kabuspb_kabus_proto_msgTypes[75]
+func (x *OrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9312,162 +10032,2302 @@ func (x *RequestError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestError.ProtoReflect.Descriptor instead.
-func (*RequestError) Descriptor() ([]byte, []int) {
-	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
+func (*OrderResponse) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RequestError) GetStatusCode() int32 {
+func (x *OrderResponse) GetResultCode() int32 {
 	if x != nil {
-		return x.StatusCode
+		return x.ResultCode
 	}
 	return 0
 }
 
-func (x *RequestError) GetBody() string {
+func (x *OrderResponse) GetOrderId() string {
 	if x != nil {
-		return x.Body
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *RequestError) GetCode() int32 {
+// 取引余力（現物）
+type StockWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 現物買付可能額
+	StockAccountWallet float64 `protobuf:"fixed64,1,opt,name=stock_account_wallet,json=stockAccountWallet,proto3" json:"stock_account_wallet,omitempty"`
+}
+
+func (x *StockWallet) Reset() {
+	*x = StockWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StockWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockWallet) ProtoMessage() {}
+
+func (x *StockWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockWallet.ProtoReflect.Descriptor instead.
+func (*StockWallet) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StockWallet) GetStockAccountWallet() float64 {
 	if x != nil {
-		return x.Code
+		return x.StockAccountWallet
 	}
 	return 0
 }
 
-func (x *RequestError) GetMessage() string {
+// 取引余力（信用）
+type MarginWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 信用新規可能額
+	MarginAccountWallet float64 `protobuf:"fixed64,1,opt,name=margin_account_wallet,json=marginAccountWallet,proto3" json:"margin_account_wallet,omitempty"`
+	// 保証金維持率
+	//   銘柄指定の場合のみ
+	DepositKeepRate float64 `protobuf:"fixed64,2,opt,name=deposit_keep_rate,json=depositKeepRate,proto3" json:"deposit_keep_rate,omitempty"`
+	// 委託保証金率
+	//   銘柄指定の場合のみ
+	ConsignmentDepositRate float64 `protobuf:"fixed64,3,opt,name=consignment_deposit_rate,json=consignmentDepositRate,proto3" json:"consignment_deposit_rate,omitempty"`
+	// 現金委託保証金率
+	//   銘柄指定の場合のみ
+	CashOfConsignmentDepositRate float64 `protobuf:"fixed64,4,opt,name=cash_of_consignment_deposit_rate,json=cashOfConsignmentDepositRate,proto3" json:"cash_of_consignment_deposit_rate,omitempty"`
+}
+
+func (x *MarginWallet) Reset() {
+	*x = MarginWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarginWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginWallet) ProtoMessage() {}
+
+func (x *MarginWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginWallet.ProtoReflect.Descriptor instead.
+func (*MarginWallet) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MarginWallet) GetMarginAccountWallet() float64 {
 	if x != nil {
-		return x.Message
+		return x.MarginAccountWallet
 	}
-	return ""
+	return 0
 }
 
-var File_kabuspb_kabus_proto protoreflect.FileDescriptor
+func (x *MarginWallet) GetDepositKeepRate() float64 {
+	if x != nil {
+		return x.DepositKeepRate
+	}
+	return 0
+}
 
-var file_kabuspb_kabus_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2f, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcc, 0x04, 0x0a, 0x15, 0x53, 0x65,
-	0x6e, 0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
-	0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64,
-	0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x5f, 0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61,
-	0x79, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09,
-	0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
-	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
-	0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x9b, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
-	0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e, 0x64,
-	0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65,
-	0x72, 0x52, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x14,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48,
-	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x88, 0x06, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32,
-	0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52,
-	0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e,
-	0x0a, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72,
-	0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3a,
-	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x3f, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x36, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53,
-	0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x6f,
-	0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x53, 0x74,
-	0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x22, 0x9c, 0x02, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x52, 0x09, 0x75, 0x6e, 0x64,
+func (x *MarginWallet) GetConsignmentDepositRate() float64 {
+	if x != nil {
+		return x.ConsignmentDepositRate
+	}
+	return 0
+}
+
+func (x *MarginWallet) GetCashOfConsignmentDepositRate() float64 {
+	if x != nil {
+		return x.CashOfConsignmentDepositRate
+	}
+	return 0
+}
+
+// 取引余力（先物）
+type FutureWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 新規建玉可能額
+	FutureTradeLimit float64 `protobuf:"fixed64,1,opt,name=future_trade_limit,json=futureTradeLimit,proto3" json:"future_trade_limit,omitempty"`
+	// 必要証拠金額
+	//   銘柄指定の場合のみ。
+	MarginRequirement float64 `protobuf:"fixed64,2,opt,name=margin_requirement,json=marginRequirement,proto3" json:"margin_requirement,omitempty"`
+}
+
+func (x *FutureWallet) Reset() {
+	*x = FutureWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FutureWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FutureWallet) ProtoMessage() {}
+
+func (x *FutureWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FutureWallet.ProtoReflect.Descriptor instead.
+func (*FutureWallet) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FutureWallet) GetFutureTradeLimit() float64 {
+	if x != nil {
+		return x.FutureTradeLimit
+	}
+	return 0
+}
+
+func (x *FutureWallet) GetMarginRequirement() float64 {
+	if x != nil {
+		return x.MarginRequirement
+	}
+	return 0
+}
+
+// 取引余力（オプション）
+type OptionWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 買新規建玉可能額
+	OptionBuyTradeLimit float64 `protobuf:"fixed64,1,opt,name=option_buy_trade_limit,json=optionBuyTradeLimit,proto3" json:"option_buy_trade_limit,omitempty"`
+	// 売新規建玉可能額
+	OptionSellTradeLimit float64 `protobuf:"fixed64,2,opt,name=option_sell_trade_limit,json=optionSellTradeLimit,proto3" json:"option_sell_trade_limit,omitempty"`
+	// 必要証拠金額
+	//   銘柄指定の場合のみ。
+	MarginRequirement float64 `protobuf:"fixed64,3,opt,name=margin_requirement,json=marginRequirement,proto3" json:"margin_requirement,omitempty"`
+}
+
+func (x *OptionWallet) Reset() {
+	*x = OptionWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionWallet) ProtoMessage() {}
+
+func (x *OptionWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionWallet.ProtoReflect.Descriptor instead.
+func (*OptionWallet) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *OptionWallet) GetOptionBuyTradeLimit() float64 {
+	if x != nil {
+		return x.OptionBuyTradeLimit
+	}
+	return 0
+}
+
+func (x *OptionWallet) GetOptionSellTradeLimit() float64 {
+	if x != nil {
+		return x.OptionSellTradeLimit
+	}
+	return 0
+}
+
+func (x *OptionWallet) GetMarginRequirement() float64 {
+	if x != nil {
+		return x.MarginRequirement
+	}
+	return 0
+}
+
+// 為替情報
+type ExchangeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 通貨
+	Currency Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=kabuspb.Currency" json:"currency,omitempty"`
+	// BID
+	BidPrice float64 `protobuf:"fixed64,2,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	// SP
+	Spread float64 `protobuf:"fixed64,3,opt,name=spread,proto3" json:"spread,omitempty"`
+	// ASK
+	AskPrice float64 `protobuf:"fixed64,4,opt,name=ask_price,json=askPrice,proto3" json:"ask_price,omitempty"`
+	// 前日比
+	Change float64 `protobuf:"fixed64,5,opt,name=change,proto3" json:"change,omitempty"`
+	// 時刻
+	Time *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ExchangeInfo) Reset() {
+	*x = ExchangeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeInfo) ProtoMessage() {}
+
+func (x *ExchangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeInfo.ProtoReflect.Descriptor instead.
+func (*ExchangeInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExchangeInfo) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_CURRENCY_UNSPECIFIED
+}
+
+func (x *ExchangeInfo) GetBidPrice() float64 {
+	if x != nil {
+		return x.BidPrice
+	}
+	return 0
+}
+
+func (x *ExchangeInfo) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *ExchangeInfo) GetAskPrice() float64 {
+	if x != nil {
+		return x.AskPrice
+	}
+	return 0
+}
+
+func (x *ExchangeInfo) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *ExchangeInfo) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// 規制情報
+type Regulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 銘柄コード
+	SymbolCode         string            `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	RegulationInfoList []*RegulationInfo `protobuf:"bytes,2,rep,name=regulation_info_list,json=regulationInfoList,proto3" json:"regulation_info_list,omitempty"`
+}
+
+func (x *Regulation) Reset() {
+	*x = Regulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Regulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Regulation) ProtoMessage() {}
+
+func (x *Regulation) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Regulation.ProtoReflect.Descriptor instead.
+func (*Regulation) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Regulation) GetSymbolCode() string {
+	if x != nil {
+		return x.SymbolCode
+	}
+	return ""
+}
+
+func (x *Regulation) GetRegulationInfoList() []*RegulationInfo {
+	if x != nil {
+		return x.RegulationInfoList
+	}
+	return nil
+}
+
+// 規制情報詳細
+type RegulationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 規制市場
+	Exchange RegulationExchange `protobuf:"varint,1,opt,name=exchange,proto3,enum=kabuspb.RegulationExchange" json:"exchange,omitempty"`
+	// 規制取引区分
+	Product RegulationProduct `protobuf:"varint,2,opt,name=product,proto3,enum=kabuspb.RegulationProduct" json:"product,omitempty"`
+	// 規制売買
+	Side RegulationSide `protobuf:"varint,3,opt,name=side,proto3,enum=kabuspb.RegulationSide" json:"side,omitempty"`
+	// 理由
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 制限開始日
+	LimitStartDay *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=limit_start_day,json=limitStartDay,proto3" json:"limit_start_day,omitempty"`
+	// 制限終了日
+	LimitEndDay *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=limit_end_day,json=limitEndDay,proto3" json:"limit_end_day,omitempty"`
+	// コンプライアンスレベル
+	Level RegulationLevel `protobuf:"varint,7,opt,name=level,proto3,enum=kabuspb.RegulationLevel" json:"level,omitempty"`
+}
+
+func (x *RegulationInfo) Reset() {
+	*x = RegulationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegulationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegulationInfo) ProtoMessage() {}
+
+func (x *RegulationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegulationInfo.ProtoReflect.Descriptor instead.
+func (*RegulationInfo) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RegulationInfo) GetExchange() RegulationExchange {
+	if x != nil {
+		return x.Exchange
+	}
+	return RegulationExchange_REGULATION_EXCHANGE_UNSPECIFIED
+}
+
+func (x *RegulationInfo) GetProduct() RegulationProduct {
+	if x != nil {
+		return x.Product
+	}
+	return RegulationProduct_REGULATION_PRODUCT_UNSPECIFIED
+}
+
+func (x *RegulationInfo) GetSide() RegulationSide {
+	if x != nil {
+		return x.Side
+	}
+	return RegulationSide_REGULATION_SIDE_UNSPECIFIED
+}
+
+func (x *RegulationInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RegulationInfo) GetLimitStartDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LimitStartDay
+	}
+	return nil
+}
+
+func (x *RegulationInfo) GetLimitEndDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LimitEndDay
+	}
+	return nil
+}
+
+func (x *RegulationInfo) GetLevel() RegulationLevel {
+	if x != nil {
+		return x.Level
+	}
+	return RegulationLevel_REGULATION_LEVEL_UNSPECIFIED
+}
+
+// 優先市場
+type PrimaryExchange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 優先市場
+	PrimaryExchange StockExchange `protobuf:"varint,2,opt,name=primary_exchange,json=primaryExchange,proto3,enum=kabuspb.StockExchange" json:"primary_exchange,omitempty"`
+}
+
+func (x *PrimaryExchange) Reset() {
+	*x = PrimaryExchange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrimaryExchange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimaryExchange) ProtoMessage() {}
+
+func (x *PrimaryExchange) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimaryExchange.ProtoReflect.Descriptor instead.
+func (*PrimaryExchange) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PrimaryExchange) GetSymbolCode() string {
+	if x != nil {
+		return x.SymbolCode
+	}
+	return ""
+}
+
+func (x *PrimaryExchange) GetPrimaryExchange() StockExchange {
+	if x != nil {
+		return x.PrimaryExchange
+	}
+	return StockExchange_STOCK_EXCHANGE_UNSPECIFIED
+}
+
+// ソフトリミット
+type SoftLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 現物のワンショット上限
+	//   単位は万円
+	Stock float64 `protobuf:"fixed64,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	// 信用のワンショット上限
+	//   単位は万円
+	Margin float64 `protobuf:"fixed64,2,opt,name=margin,proto3" json:"margin,omitempty"`
+	// 先物のワンショット上限
+	//   単位は枚
+	Future float64 `protobuf:"fixed64,3,opt,name=future,proto3" json:"future,omitempty"`
+	// 先物ミニのワンショット上限
+	//   単位は枚
+	FutureMini float64 `protobuf:"fixed64,4,opt,name=future_mini,json=futureMini,proto3" json:"future_mini,omitempty"`
+	// オプションのワンショット上限
+	//   単位は枚
+	Option float64 `protobuf:"fixed64,5,opt,name=option,proto3" json:"option,omitempty"`
+	// kabuステーションのバージョン
+	KabusVersion string `protobuf:"bytes,6,opt,name=kabus_version,json=kabusVersion,proto3" json:"kabus_version,omitempty"`
+}
+
+func (x *SoftLimit) Reset() {
+	*x = SoftLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoftLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftLimit) ProtoMessage() {}
+
+func (x *SoftLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftLimit.ProtoReflect.Descriptor instead.
+func (*SoftLimit) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SoftLimit) GetStock() float64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SoftLimit) GetMargin() float64 {
+	if x != nil {
+		return x.Margin
+	}
+	return 0
+}
+
+func (x *SoftLimit) GetFuture() float64 {
+	if x != nil {
+		return x.Future
+	}
+	return 0
+}
+
+func (x *SoftLimit) GetFutureMini() float64 {
+	if x != nil {
+		return x.FutureMini
+	}
+	return 0
+}
+
+func (x *SoftLimit) GetOption() float64 {
+	if x != nil {
+		return x.Option
+	}
+	return 0
+}
+
+func (x *SoftLimit) GetKabusVersion() string {
+	if x != nil {
+		return x.KabusVersion
+	}
+	return ""
+}
+
+// プレミアム料取得
+type MarginPremium struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 銘柄コード
+	SymbolCode string `protobuf:"bytes,1,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 一般信用（長期）
+	GeneralMargin *MarginPremiumDetail `protobuf:"bytes,2,opt,name=general_margin,json=generalMargin,proto3" json:"general_margin,omitempty"`
+	// 一般信用（デイトレ）
+	DayTrade *MarginPremiumDetail `protobuf:"bytes,3,opt,name=day_trade,json=dayTrade,proto3" json:"day_trade,omitempty"`
+}
+
+func (x *MarginPremium) Reset() {
+	*x = MarginPremium{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarginPremium) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginPremium) ProtoMessage() {}
+
+func (x *MarginPremium) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginPremium.ProtoReflect.Descriptor instead.
+func (*MarginPremium) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *MarginPremium) GetSymbolCode() string {
+	if x != nil {
+		return x.SymbolCode
+	}
+	return ""
+}
+
+func (x *MarginPremium) GetGeneralMargin() *MarginPremiumDetail {
+	if x != nil {
+		return x.GeneralMargin
+	}
+	return nil
+}
+
+func (x *MarginPremium) GetDayTrade() *MarginPremiumDetail {
+	if x != nil {
+		return x.DayTrade
+	}
+	return nil
+}
+
+type MarginPremiumDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// プレミアム料入力区分
+	MarginPremiumType MarginPremiumType `protobuf:"varint,1,opt,name=margin_premium_type,json=marginPremiumType,proto3,enum=kabuspb.MarginPremiumType" json:"margin_premium_type,omitempty"`
+	// 確定プレミアム料
+	MarginPremium float64 `protobuf:"fixed64,2,opt,name=margin_premium,json=marginPremium,proto3" json:"margin_premium,omitempty"`
+	// 上限プレミアム料
+	UpperMarginPremium float64 `protobuf:"fixed64,3,opt,name=upper_margin_premium,json=upperMarginPremium,proto3" json:"upper_margin_premium,omitempty"`
+	// 下限プレミアム料
+	LowerMarginPremium float64 `protobuf:"fixed64,4,opt,name=lower_margin_premium,json=lowerMarginPremium,proto3" json:"lower_margin_premium,omitempty"`
+	// プレミアム料刻値
+	TickMarginPremium float64 `protobuf:"fixed64,5,opt,name=tick_margin_premium,json=tickMarginPremium,proto3" json:"tick_margin_premium,omitempty"`
+}
+
+func (x *MarginPremiumDetail) Reset() {
+	*x = MarginPremiumDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarginPremiumDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginPremiumDetail) ProtoMessage() {}
+
+func (x *MarginPremiumDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarginPremiumDetail.ProtoReflect.Descriptor instead.
+func (*MarginPremiumDetail) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *MarginPremiumDetail) GetMarginPremiumType() MarginPremiumType {
+	if x != nil {
+		return x.MarginPremiumType
+	}
+	return MarginPremiumType_MARGIN_PREMIUM_TYPE_UNSPECIFIED
+}
+
+func (x *MarginPremiumDetail) GetMarginPremium() float64 {
+	if x != nil {
+		return x.MarginPremium
+	}
+	return 0
+}
+
+func (x *MarginPremiumDetail) GetUpperMarginPremium() float64 {
+	if x != nil {
+		return x.UpperMarginPremium
+	}
+	return 0
+}
+
+func (x *MarginPremiumDetail) GetLowerMarginPremium() float64 {
+	if x != nil {
+		return x.LowerMarginPremium
+	}
+	return 0
+}
+
+func (x *MarginPremiumDetail) GetTickMarginPremium() float64 {
+	if x != nil {
+		return x.TickMarginPremium
+	}
+	return 0
+}
+
+type RequestError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32              `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Body       string             `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Code       int32              `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message    string             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Reason     RequestErrorReason `protobuf:"varint,5,opt,name=reason,proto3,enum=kabuspb.RequestErrorReason" json:"reason,omitempty"` // エラーの理由
+}
+
+func (x *RequestError) Reset() {
+	*x = RequestError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestError) ProtoMessage() {}
+
+func (x *RequestError) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestError.ProtoReflect.Descriptor instead.
+func (*RequestError) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RequestError) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *RequestError) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *RequestError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RequestError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestError) GetReason() RequestErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RequestErrorReason_REQUEST_ERROR_REASON_UNSPECIFIED
+}
+
+// リスクチェックによる注文の拒否
+//
+//	発注前のリスクチェックで拒否したときにFailedPreconditionの詳細に入る
+type RiskRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason  RiskRejectionReason `protobuf:"varint,1,opt,name=reason,proto3,enum=kabuspb.RiskRejectionReason" json:"reason,omitempty"` // 拒否の理由
+	Message string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                 // メッセージ
+	Limit   float64             `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`                                   // 上限・下限
+	Actual  float64             `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"`                                 // 注文の値
+}
+
+func (x *RiskRejection) Reset() {
+	*x = RiskRejection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RiskRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskRejection) ProtoMessage() {}
+
+func (x *RiskRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskRejection.ProtoReflect.Descriptor instead.
+func (*RiskRejection) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RiskRejection) GetReason() RiskRejectionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RiskRejectionReason_RISK_REJECTION_REASON_UNSPECIFIED
+}
+
+func (x *RiskRejection) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RiskRejection) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RiskRejection) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+// 流量制御状態
+type ThrottleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 区分ごとの状態
+	Lanes []*ThrottleLaneStatus `protobuf:"bytes,1,rep,name=lanes,proto3" json:"lanes,omitempty"`
+}
+
+func (x *ThrottleStatus) Reset() {
+	*x = ThrottleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThrottleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThrottleStatus) ProtoMessage() {}
+
+func (x *ThrottleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThrottleStatus.ProtoReflect.Descriptor instead.
+func (*ThrottleStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ThrottleStatus) GetLanes() []*ThrottleLaneStatus {
+	if x != nil {
+		return x.Lanes
+	}
+	return nil
+}
+
+// 区分ごとの流量制御状態
+type ThrottleLaneStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 区分
+	Category ThrottleCategory `protobuf:"varint,1,opt,name=category,proto3,enum=kabuspb.ThrottleCategory" json:"category,omitempty"`
+	// 待ち行列に積まれているリクエスト数
+	QueueDepth int32 `protobuf:"varint,2,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// 1リクエストあたりの間隔(ミリ秒)
+	IntervalMs int64 `protobuf:"varint,3,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+}
+
+func (x *ThrottleLaneStatus) Reset() {
+	*x = ThrottleLaneStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThrottleLaneStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThrottleLaneStatus) ProtoMessage() {}
+
+func (x *ThrottleLaneStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThrottleLaneStatus.ProtoReflect.Descriptor instead.
+func (*ThrottleLaneStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ThrottleLaneStatus) GetCategory() ThrottleCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ThrottleCategory_THROTTLE_CATEGORY_UNSPECIFIED
+}
+
+func (x *ThrottleLaneStatus) GetQueueDepth() int32 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *ThrottleLaneStatus) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+// 板情報ストリーミングの配信状況
+type BoardStreamStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 接続しているストリームごとの状況
+	Subscribers []*BoardSubscriberStatus `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	// サーバーの起動から捨てた板情報の数
+	//   切断したストリームの分も含む
+	DroppedTotal int64 `protobuf:"varint,2,opt,name=dropped_total,json=droppedTotal,proto3" json:"dropped_total,omitempty"`
+	// サーバーの起動から送り切れずに切断したストリームの数
+	DisconnectedTotal int64 `protobuf:"varint,3,opt,name=disconnected_total,json=disconnectedTotal,proto3" json:"disconnected_total,omitempty"`
+}
+
+func (x *BoardStreamStatus) Reset() {
+	*x = BoardStreamStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardStreamStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardStreamStatus) ProtoMessage() {}
+
+func (x *BoardStreamStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardStreamStatus.ProtoReflect.Descriptor instead.
+func (*BoardStreamStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BoardStreamStatus) GetSubscribers() []*BoardSubscriberStatus {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+func (x *BoardStreamStatus) GetDroppedTotal() int64 {
+	if x != nil {
+		return x.DroppedTotal
+	}
+	return 0
+}
+
+func (x *BoardStreamStatus) GetDisconnectedTotal() int64 {
+	if x != nil {
+		return x.DisconnectedTotal
+	}
+	return 0
+}
+
+// 板情報websocketの接続状態
+type BoardWebSocketStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// websocketの状態
+	State BoardWebSocketState `protobuf:"varint,1,opt,name=state,proto3,enum=kabuspb.BoardWebSocketState" json:"state,omitempty"`
+	// 最後に接続して板情報を受け取り始めた日時
+	ConnectedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	// 最後に切断した日時
+	DisconnectedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=disconnected_at,json=disconnectedAt,proto3" json:"disconnected_at,omitempty"`
+	// 次に再接続する日時
+	//   RECONNECTINGで待っているときだけ入っている
+	NextRetryAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`
+	// 最後に切断した原因のエラー
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// サーバーの起動から再接続した回数
+	ReconnectCount int64 `protobuf:"varint,6,opt,name=reconnect_count,json=reconnectCount,proto3" json:"reconnect_count,omitempty"`
+	// 今のwebsocketの接続のID
+	//   板情報のsession_idと同じ
+	SessionId string `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *BoardWebSocketStatus) Reset() {
+	*x = BoardWebSocketStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardWebSocketStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardWebSocketStatus) ProtoMessage() {}
+
+func (x *BoardWebSocketStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardWebSocketStatus.ProtoReflect.Descriptor instead.
+func (*BoardWebSocketStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *BoardWebSocketStatus) GetState() BoardWebSocketState {
+	if x != nil {
+		return x.State
+	}
+	return BoardWebSocketState_BOARD_WEB_SOCKET_STATE_UNSPECIFIED
+}
+
+func (x *BoardWebSocketStatus) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *BoardWebSocketStatus) GetDisconnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisconnectedAt
+	}
+	return nil
+}
+
+func (x *BoardWebSocketStatus) GetNextRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return nil
+}
+
+func (x *BoardWebSocketStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BoardWebSocketStatus) GetReconnectCount() int64 {
+	if x != nil {
+		return x.ReconnectCount
+	}
+	return 0
+}
+
+func (x *BoardWebSocketStatus) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// ストリームごとの板情報の配信状況
+type BoardSubscriberStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ストリームの番号
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ツール名
+	RequesterName string `protobuf:"bytes,2,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"`
+	// 送るのを待っている板情報の数
+	Queued int32 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// 送るのを待てる板情報の数の上限
+	Capacity int32 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// 送った板情報の数
+	Sent int64 `protobuf:"varint,5,opt,name=sent,proto3" json:"sent,omitempty"`
+	// 送り切れずに捨てた板情報の数
+	Dropped int64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *BoardSubscriberStatus) Reset() {
+	*x = BoardSubscriberStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSubscriberStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSubscriberStatus) ProtoMessage() {}
+
+func (x *BoardSubscriberStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSubscriberStatus.ProtoReflect.Descriptor instead.
+func (*BoardSubscriberStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *BoardSubscriberStatus) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BoardSubscriberStatus) GetRequesterName() string {
+	if x != nil {
+		return x.RequesterName
+	}
+	return ""
+}
+
+func (x *BoardSubscriberStatus) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *BoardSubscriberStatus) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BoardSubscriberStatus) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *BoardSubscriberStatus) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// kabusapi利用状況リスト
+type Usages struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usages []*Usage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+}
+
+func (x *Usages) Reset() {
+	*x = Usages{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Usages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usages) ProtoMessage() {}
+
+func (x *Usages) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usages.ProtoReflect.Descriptor instead.
+func (*Usages) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Usages) GetUsages() []*Usage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+// kabusapi利用状況
+//
+//	ツールはgRPCのメタデータ kabus-requester で指定されたツール名で区別する
+type Usage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ツール名
+	RequesterName string `protobuf:"bytes,1,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"`
+	// 区分
+	Category ThrottleCategory `protobuf:"varint,2,opt,name=category,proto3,enum=kabuspb.ThrottleCategory" json:"category,omitempty"`
+	// 受け付けた呼出し数
+	Calls int64 `protobuf:"varint,3,opt,name=calls,proto3" json:"calls,omitempty"`
+	// 利用枠超過で拒否した呼出し数
+	Rejections int64 `protobuf:"varint,4,opt,name=rejections,proto3" json:"rejections,omitempty"`
+	// 秒間の利用枠
+	//   0は無制限
+	PerSecondLimit int32 `protobuf:"varint,5,opt,name=per_second_limit,json=perSecondLimit,proto3" json:"per_second_limit,omitempty"`
+	// 分間の利用枠
+	//   0は無制限
+	PerMinuteLimit int32 `protobuf:"varint,6,opt,name=per_minute_limit,json=perMinuteLimit,proto3" json:"per_minute_limit,omitempty"`
+	// 現在の1秒間で受け付けた呼出し数
+	CurrentSecondCalls int32 `protobuf:"varint,7,opt,name=current_second_calls,json=currentSecondCalls,proto3" json:"current_second_calls,omitempty"`
+	// 現在の1分間で受け付けた呼出し数
+	CurrentMinuteCalls int32 `protobuf:"varint,8,opt,name=current_minute_calls,json=currentMinuteCalls,proto3" json:"current_minute_calls,omitempty"`
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Usage) GetRequesterName() string {
+	if x != nil {
+		return x.RequesterName
+	}
+	return ""
+}
+
+func (x *Usage) GetCategory() ThrottleCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ThrottleCategory_THROTTLE_CATEGORY_UNSPECIFIED
+}
+
+func (x *Usage) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *Usage) GetRejections() int64 {
+	if x != nil {
+		return x.Rejections
+	}
+	return 0
+}
+
+func (x *Usage) GetPerSecondLimit() int32 {
+	if x != nil {
+		return x.PerSecondLimit
+	}
+	return 0
+}
+
+func (x *Usage) GetPerMinuteLimit() int32 {
+	if x != nil {
+		return x.PerMinuteLimit
+	}
+	return 0
+}
+
+func (x *Usage) GetCurrentSecondCalls() int32 {
+	if x != nil {
+		return x.CurrentSecondCalls
+	}
+	return 0
+}
+
+func (x *Usage) GetCurrentMinuteCalls() int32 {
+	if x != nil {
+		return x.CurrentMinuteCalls
+	}
+	return 0
+}
+
+// kabuステーションの死活状態
+//
+//	バックグラウンドで定期的にトークンの取得とソフトリミットの取得を行い、その結果を保持している
+type StationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 利用可能か
+	//   利用できない間はkabusapiを呼び出すリクエストにUnavailableのエラーを返す
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	// 最後に確認した日時
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// 最後に確認したときのエラー
+	//   利用可能なら空
+	LastError string `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// トークンの有効期限
+	TokenExpiredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=token_expired_at,json=tokenExpiredAt,proto3" json:"token_expired_at,omitempty"`
+}
+
+func (x *StationStatus) Reset() {
+	*x = StationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationStatus) ProtoMessage() {}
+
+func (x *StationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationStatus.ProtoReflect.Descriptor instead.
+func (*StationStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *StationStatus) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *StationStatus) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *StationStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *StationStatus) GetTokenExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TokenExpiredAt
+	}
+	return nil
+}
+
+// 緊急停止の状態
+//
+//	サーバーを再起動しても引き継ぐ
+type KillSwitchStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 停止中か
+	//   停止中は実注文の新規発注にFailedPreconditionのエラーを返す
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// 仮想証券会社だけにしているか
+	VirtualOnly bool `protobuf:"varint,2,opt,name=virtual_only,json=virtualOnly,proto3" json:"virtual_only,omitempty"`
+	// 停止の理由
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 停止した日時
+	ActivatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	// 停止したときに取り消した注文
+	Cancels []*KillSwitchCancel `protobuf:"bytes,5,rep,name=cancels,proto3" json:"cancels,omitempty"`
+	// 状態をファイルに保存できなかったときのエラー
+	//   状態は変わっているが、再起動すると保存済みの状態に戻る
+	SaveError string `protobuf:"bytes,6,opt,name=save_error,json=saveError,proto3" json:"save_error,omitempty"`
+}
+
+func (x *KillSwitchStatus) Reset() {
+	*x = KillSwitchStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchStatus) ProtoMessage() {}
+
+func (x *KillSwitchStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchStatus.ProtoReflect.Descriptor instead.
+func (*KillSwitchStatus) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *KillSwitchStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *KillSwitchStatus) GetVirtualOnly() bool {
+	if x != nil {
+		return x.VirtualOnly
+	}
+	return false
+}
+
+func (x *KillSwitchStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KillSwitchStatus) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *KillSwitchStatus) GetCancels() []*KillSwitchCancel {
+	if x != nil {
+		return x.Cancels
+	}
+	return nil
+}
+
+func (x *KillSwitchStatus) GetSaveError() string {
+	if x != nil {
+		return x.SaveError
+	}
+	return ""
+}
+
+// 注文イベント
+//
+//	サーバーで実注文と仮想証券会社の注文の一覧をポーリングし、前回との差分から作る
+//	接続してから最初のポーリングの結果を基準にするので、それより前の変化は配信しない
+type OrderEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// イベントの種類
+	EventType OrderEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=kabuspb.OrderEventType" json:"event_type,omitempty"`
+	// 変化した後の注文
+	Order *Order `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// 前回のポーリングから増えた注文明細
+	//   同じポーリングで1つの注文に複数のイベントがあれば、最後のイベントに入れる
+	NewDetails []*OrderDetail `protobuf:"bytes,3,rep,name=new_details,json=newDetails,proto3" json:"new_details,omitempty"`
+	// 前回のポーリングから増えた約定数量
+	ExecutedQuantity float64 `protobuf:"fixed64,4,opt,name=executed_quantity,json=executedQuantity,proto3" json:"executed_quantity,omitempty"`
+	// 仮想証券会社の注文か
+	IsVirtual bool `protobuf:"varint,5,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
+	// 変化を検知した日時
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *OrderEvent) GetEventType() OrderEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *OrderEvent) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetNewDetails() []*OrderDetail {
+	if x != nil {
+		return x.NewDetails
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetExecutedQuantity() float64 {
+	if x != nil {
+		return x.ExecutedQuantity
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetIsVirtual() bool {
+	if x != nil {
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *OrderEvent) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+// 建玉イベント
+//
+//	サーバーで定期的と約定の検知時に実建玉と仮想証券会社の建玉を取得し、前回との差分から作る
+//	接続してから最初の取得の結果を基準にするので、それより前の変化は配信しない
+type PositionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// イベントの種類
+	EventType PositionEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=kabuspb.PositionEventType" json:"event_type,omitempty"`
+	// 変化した後の建玉、決済で一覧からなくなったときは最後の建玉
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	// 変化する前の建玉、新規のときは空
+	Previous *Position `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// 仮想証券会社の建玉か
+	IsVirtual bool `protobuf:"varint,4,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
+	// 変化を検知した日時
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+}
+
+func (x *PositionEvent) Reset() {
+	*x = PositionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionEvent) ProtoMessage() {}
+
+func (x *PositionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionEvent.ProtoReflect.Descriptor instead.
+func (*PositionEvent) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PositionEvent) GetEventType() PositionEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return PositionEventType_POSITION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PositionEvent) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PositionEvent) GetPrevious() *Position {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *PositionEvent) GetIsVirtual() bool {
+	if x != nil {
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *PositionEvent) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+// 監査ログ一覧
+type AuditLogs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*AuditLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *AuditLogs) Reset() {
+	*x = AuditLogs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogs) ProtoMessage() {}
+
+func (x *AuditLogs) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogs.ProtoReflect.Descriptor instead.
+func (*AuditLogs) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AuditLogs) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// 監査ログ
+//
+//	発注・取消の呼出しごとに、ハンドラを呼ぶ前にリクエストを、結果を返す前に結果をファイルに追記したもの
+type AuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 呼び出したメソッド
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// 認証したツール名
+	//   認証が不要な設定なら空
+	ClientName string `protobuf:"bytes,2,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	// メタデータで指定したツール名
+	Requester string `protobuf:"bytes,3,opt,name=requester,proto3" json:"requester,omitempty"`
+	// 銘柄コード
+	//   取消のときは空
+	SymbolCode string `protobuf:"bytes,4,opt,name=symbol_code,json=symbolCode,proto3" json:"symbol_code,omitempty"`
+	// 注文番号
+	//   発注のときは受け付けられた注文の注文番号
+	OrderId string `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// 仮想売買
+	IsVirtual bool `protobuf:"varint,6,opt,name=is_virtual,json=isVirtual,proto3" json:"is_virtual,omitempty"`
+	// リクエスト
+	//   JSON形式、パスワードは消してある
+	Request string `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	// kabusapiの結果
+	//   JSON形式、エラーのときは空
+	Response string `protobuf:"bytes,8,opt,name=response,proto3" json:"response,omitempty"`
+	// エラー
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// 呼び出した日時
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// 結果を返した日時
+	//   ハンドラを呼ぶ前の記録では空
+	RespondedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	// 記録の種類
+	LogType AuditLogType `protobuf:"varint,12,opt,name=log_type,json=logType,proto3,enum=kabuspb.AuditLogType" json:"log_type,omitempty"`
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *AuditLog) GetSymbolCode() string {
+	if x != nil {
+		return x.SymbolCode
+	}
+	return ""
+}
+
+func (x *AuditLog) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIsVirtual() bool {
+	if x != nil {
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *AuditLog) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *AuditLog) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *AuditLog) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *AuditLog) GetLogType() AuditLogType {
+	if x != nil {
+		return x.LogType
+	}
+	return AuditLogType_AUDIT_LOG_TYPE_UNSPECIFIED
+}
+
+// 緊急停止で取り消した注文
+type KillSwitchCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId  string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 注文番号
+	Canceled bool   `protobuf:"varint,2,opt,name=canceled,proto3" json:"canceled,omitempty"`             // 取消できたか
+	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`                    // 取消できなかったときのエラー
+}
+
+func (x *KillSwitchCancel) Reset() {
+	*x = KillSwitchCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kabuspb_kabus_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchCancel) ProtoMessage() {}
+
+func (x *KillSwitchCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_kabuspb_kabus_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchCancel.ProtoReflect.Descriptor instead.
+func (*KillSwitchCancel) Descriptor() ([]byte, []int) {
+	return file_kabuspb_kabus_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *KillSwitchCancel) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *KillSwitchCancel) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+func (x *KillSwitchCancel) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_kabuspb_kabus_proto protoreflect.FileDescriptor
+
+var file_kabuspb_kabus_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2f, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf4, 0x04, 0x0a, 0x15, 0x53, 0x65,
+	0x6e, 0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64,
+	0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61,
+	0x79, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x62, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18,
+	0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x22, 0x9b, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x52, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72,
+	0x4f, 0x76, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69,
+	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x68, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xb0,
+	0x06, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x31, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61,
+	0x79, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x62, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x22, 0x9c, 0x02, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x70,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x52, 0x09, 0x75, 0x6e, 0x64,
 	0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x14, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
 	0x68, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53,
@@ -9476,7 +12336,7 @@ var file_kabuspb_kabus_proto_rawDesc = []byte{
 	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x66, 0x74, 0x65,
 	0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x01, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x22, 0xba, 0x04, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f,
+	0x22, 0xe2, 0x04, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
 	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
 	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f,
@@ -9511,58 +12371,63 @@ var file_kabuspb_kabus_proto_rawDesc = []byte{
 	0x65, 0x44, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64,
 	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
 	0x70, 0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xe4, 0x01,
-	0x0a, 0x0f, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f,
-	0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x52, 0x09,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x14, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x11, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0xba, 0x04, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x08,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63,
-	0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x21,
-	0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a,
-	0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37,
-	0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a,
-	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70,
-	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f,
-	0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70,
+	0x65, 0x72, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x62, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31,
+	0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64,
+	0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x52, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65,
+	0x72, 0x12, 0x51, 0x0a, 0x14, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x11, 0x61, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x69,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe2, 0x04, 0x0a,
+	0x16, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49,
+	0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53,
+	0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79,
+	0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x62, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70,
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
 	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72,
 	0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x75, 0x6e,
@@ -9616,736 +12481,809 @@ var file_kabuspb_kabus_proto_rawDesc = []byte{
 	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
 	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
 	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x22, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x65, 0x22, 0x7f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f,
 	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
 	0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x22, 0x7d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0xec, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64,
-	0x65, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
-	0x6c, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x65,
-	0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x65,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72,
-	0x74, 0x75, 0x61, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a,
-	0x10, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74,
-	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65,
+	0x4d, 0x73, 0x22, 0x7d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x94, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d,
-	0x6f, 0x6e, 0x74, 0x68, 0x22, 0xbc, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x65, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x62, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x22, 0xec, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64,
-	0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x30,
-	0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x61,
-	0x6c, 0x6c, 0x50, 0x75, 0x74, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x50, 0x75, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
-	0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
-	0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x62, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x63, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x66, 0x75,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x45, 0x0a, 0x10, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0xbc, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x30, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x70, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x75, 0x74, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c, 0x4f, 0x72,
+	0x50, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76,
 	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61,
 	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69,
 	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
 	0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61,
 	0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x01,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0c, 0x72,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75,
-	0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x16, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x74,
-	0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x73, 0x22, 0x44, 0x0a, 0x1b, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2d, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
-	0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72,
-	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xa4, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49,
+	0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x72, 0x0a,
+	0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x22, 0x74, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x44, 0x0a, 0x1b, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0x3c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x1b, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x05, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xe9, 0x14, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x10, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76,
-	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
-	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
-	0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73,
-	0x65, 0x5f, 0x70, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x50, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f,
+	0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x15, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0xc3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x97, 0x01, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x76, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x95, 0x16, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x10, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x42, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x74,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x77, 0x61, 0x70, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x77, 0x61, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x74,
-	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x35, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x62, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69,
-	0x67, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x31, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x31, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65,
-	0x6c, 0x6c, 0x32, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x32,
-	0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x33, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x65, 0x6c, 0x6c, 0x33, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x34, 0x18,
-	0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x34, 0x12, 0x24, 0x0a, 0x05,
-	0x73, 0x65, 0x6c, 0x6c, 0x35, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c,
-	0x6c, 0x35, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x36, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x36, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c,
-	0x37, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x37, 0x12, 0x24,
-	0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x38, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x65, 0x6c, 0x6c, 0x38, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x39, 0x18, 0x25, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x39, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x65,
-	0x6c, 0x6c, 0x31, 0x30, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c,
-	0x31, 0x30, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x29,
+	0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x07, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x6b,
-	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x73, 0x6b,
-	0x53, 0x69, 0x67, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x42, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x27, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x10, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x11, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x77, 0x61, 0x70, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x77, 0x61, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x69, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x62,
+	0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x29,
+	0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x31, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
 	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x31, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x32,
-	0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x32, 0x12, 0x22, 0x0a, 0x04,
-	0x62, 0x75, 0x79, 0x33, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x33,
-	0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x34, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04,
-	0x62, 0x75, 0x79, 0x34, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x35, 0x18, 0x30, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x35, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x36,
-	0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x36, 0x12, 0x22, 0x0a, 0x04,
-	0x62, 0x75, 0x79, 0x37, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x37,
-	0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x38, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04,
-	0x62, 0x75, 0x79, 0x38, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x39, 0x18, 0x34, 0x20, 0x01,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x31, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c,
+	0x6c, 0x32, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x32, 0x12,
+	0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x33, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x65, 0x6c, 0x6c, 0x33, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x34, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x34, 0x12, 0x24, 0x0a, 0x05, 0x73,
+	0x65, 0x6c, 0x6c, 0x35, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c,
+	0x35, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x36, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x36, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x37,
+	0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x37, 0x12, 0x24, 0x0a,
+	0x05, 0x73, 0x65, 0x6c, 0x6c, 0x38, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x73, 0x65,
+	0x6c, 0x6c, 0x38, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x39, 0x18, 0x25, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x39, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x31,
-	0x30, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x62, 0x75, 0x79, 0x31, 0x30, 0x12, 0x2c,
-	0x0a, 0x12, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72,
-	0x53, 0x65, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x42,
-	0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x38, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x2d, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x69, 0x6d, 0x70,
-	0x6c, 0x69, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67,
-	0x61, 0x6d, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x3c, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65,
-	0x67, 0x61, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x67, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64,
-	0x65, 0x6c, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xfa, 0x07, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
-	0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
-	0x74, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
-	0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x4d, 0x0a, 0x15, 0x66,
-	0x69, 0x73, 0x63, 0x61, 0x6c, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x66, 0x69, 0x73, 0x63, 0x61, 0x6c, 0x59, 0x65,
-	0x61, 0x72, 0x45, 0x6e, 0x64, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f,
-	0x6d, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x79, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x10, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f, 0x6d, 0x4d, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x42, 0x75, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f, 0x6d, 0x5f,
-	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f, 0x6d, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x62,
-	0x75, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x42, 0x75, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65,
-	0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x70, 0x70, 0x65, 0x72,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65,
-	0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
-	0x79, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72,
-	0x6c, 0x79, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64, 0x65, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x0b, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x45, 0x6e,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f,
-	0x70, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x75, 0x74, 0x52, 0x09, 0x63, 0x61, 0x6c,
-	0x6c, 0x4f, 0x72, 0x50, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
-	0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x38, 0x0a,
-	0x0e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x73,
-	0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x39, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x31, 0x30, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x31,
+	0x30, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x27, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x6b, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x73, 0x6b, 0x53,
+	0x69, 0x67, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27,
+	0x0a, 0x04, 0x62, 0x75, 0x79, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x31, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x32, 0x18,
+	0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x32, 0x12, 0x22, 0x0a, 0x04, 0x62,
+	0x75, 0x79, 0x33, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x33, 0x12,
+	0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x34, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62,
+	0x75, 0x79, 0x34, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x35, 0x18, 0x30, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x35, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x36, 0x18,
+	0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x36, 0x12, 0x22, 0x0a, 0x04, 0x62,
+	0x75, 0x79, 0x37, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x37, 0x12,
+	0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x38, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x62,
+	0x75, 0x79, 0x38, 0x12, 0x22, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x39, 0x18, 0x34, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x04, 0x62, 0x75, 0x79, 0x39, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x31, 0x30,
+	0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x62, 0x75, 0x79, 0x31, 0x30, 0x12, 0x2c, 0x0a,
+	0x12, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x36, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x37, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x75,
+	0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x38, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x39, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x61,
+	0x6d, 0x6d, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18, 0x3c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x67,
+	0x61, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x67, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x32, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x06, 0x6e, 0x6f, 0x74,
+	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x41, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x42,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x39, 0x0a, 0x05,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x30, 0x0a, 0x06, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x26, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xcb, 0x07, 0x0a, 0x05, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0d,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49,
-	0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x12, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64,
-	0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x12, 0x20,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x11,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xfa, 0x07, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74,
+	0x72, 0x79, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x4d,
+	0x0a, 0x15, 0x66, 0x69, 0x73, 0x63, 0x61, 0x6c, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x66, 0x69, 0x73, 0x63, 0x61,
+	0x6c, 0x59, 0x65, 0x61, 0x72, 0x45, 0x6e, 0x64, 0x42, 0x61, 0x73, 0x69, 0x63, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x6b, 0x61, 0x62,
+	0x75, 0x63, 0x6f, 0x6d, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f, 0x6d, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x42, 0x75, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6b, 0x61, 0x62, 0x75, 0x63,
+	0x6f, 0x6d, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6b, 0x61, 0x62, 0x75, 0x63, 0x6f, 0x6d, 0x4d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x5f, 0x62, 0x75, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x42, 0x75, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x75, 0x70,
+	0x70, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x6c, 0x79, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x79, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x10, 0x64, 0x65, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x64,
+	0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x3b,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x14, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69,
-	0x75, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xde, 0x04, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21,
-	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x64,
-	0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x61, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x78, 0x22, 0x3c, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf2, 0x06, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x45, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x6f, 0x72, 0x5f, 0x70, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x75, 0x74, 0x52, 0x09,
+	0x63, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x50, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x38, 0x0a, 0x0e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0x39, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x30, 0x0a, 0x06, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x0c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x08, 0x73,
+	0x61, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x61, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xf3, 0x07, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78,
 	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
 	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
-	0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x73, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x51, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53,
-	0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
-	0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x78, 0x12, 0x39, 0x0a, 0x0a,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a,
+	0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65,
+	0x12, 0x31, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x44, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x62, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0xde, 0x04, 0x0a, 0x0b, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72,
-	0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73,
-	0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
-	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x11, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
-	0x12, 0x31, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x08,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x0c,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x86, 0x04, 0x0a, 0x10, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
-	0x02, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b, 0x0a,
-	0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72,
-	0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76,
-	0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2b, 0x0a,
-	0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74,
-	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
-	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x94, 0x04,
-	0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e,
-	0x6f, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69,
-	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
-	0x6f, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34,
-	0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x04, 0x0a, 0x11, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x72,
-	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x6e, 0x64,
-	0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44,
+	0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x61, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x78, 0x22, 0x3c, 0x0a, 0x09, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf2, 0x06, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x61,
-	0x70, 0x69, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x72, 0x61, 0x70, 0x69,
-	0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75,
-	0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x99, 0x04, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x78,
+	0x70, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x78, 0x12, 0x39, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x44, 0x61, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x4c, 0x6f,
+	0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x73,
+	0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x11,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x0e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xba, 0x01, 0x0a,
+	0x0c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x86, 0x04, 0x0a, 0x10, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b,
+	0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x2b,
+	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64,
+	0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x94,
+	0x04, 0x0a, 0x0f, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x6e, 0x6f, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74,
+	0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0xa1, 0x04, 0x0a, 0x11, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
 	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e,
 	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b, 0x0a, 0x05, 0x74,
 	0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61, 0x62,
@@ -10361,876 +13299,1339 @@ var file_kabuspb_kabus_proto_rawDesc = []byte{
 	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
 	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e,
 	0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x38, 0x0a, 0x18, 0x72,
-	0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x72,
-	0x61, 0x70, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65,
-	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65,
-	0x72, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72,
-	0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07,
-	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x22, 0xa9, 0x03, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x73, 0x65,
-	0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x1a, 0x73, 0x65, 0x6c, 0x6c, 0x52, 0x61, 0x70, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a,
-	0x14, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x5f,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x65, 0x6c,
-	0x6c, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x3f,
-	0x0a, 0x1c, 0x62, 0x75, 0x79, 0x5f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x62, 0x75, 0x79, 0x52, 0x61, 0x70, 0x69, 0x64, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
-	0x2d, 0x0a, 0x13, 0x62, 0x75, 0x79, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x65, 0x6b,
-	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x62, 0x75,
-	0x79, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64,
-	0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc3,
-	0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73,
-	0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07,
-	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79,
-	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x22, 0x84, 0x03, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
-	0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x72,
+	0x61, 0x70, 0x69, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x72, 0x61, 0x70,
+	0x69, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64,
+	0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x99, 0x04, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
 	0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b, 0x0a, 0x05,
 	0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61,
 	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65,
 	0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x65,
 	0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73,
-	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73,
-	0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6f, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x63,
-	0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x63, 0x6b,
-	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x4d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2a,
-	0x0a, 0x11, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x4b, 0x65, 0x65, 0x70, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f,
-	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x63, 0x6f,
-	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x66, 0x5f,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1c,
-	0x63, 0x61, 0x73, 0x68, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a, 0x0c,
-	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x75, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x35, 0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73,
-	0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61,
-	0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x38, 0x0a, 0x18,
+	0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16,
+	0x72, 0x61, 0x70, 0x69, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a,
+	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x22, 0xa9, 0x03, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x73,
+	0x65, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x1a, 0x73, 0x65, 0x6c, 0x6c, 0x52, 0x61, 0x70, 0x69, 0x64, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2f,
+	0x0a, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x65, 0x6b,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x65,
+	0x6c, 0x6c, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x3f, 0x0a, 0x1c, 0x62, 0x75, 0x79, 0x5f, 0x72, 0x61, 0x70, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x62, 0x75, 0x79, 0x52, 0x61, 0x70, 0x69, 0x64, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x2d, 0x0a, 0x13, 0x62, 0x75, 0x79, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x65, 0x65,
+	0x6b, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x62,
+	0x75, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xc3, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75,
+	0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a,
+	0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72,
+	0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x84, 0x03, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74,
+	0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x6e, 0x6f, 0x12, 0x2b, 0x0a,
+	0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x52, 0x05, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x64, 0x75,
+	0x73, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x64, 0x75,
+	0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x0d,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0b, 0x53, 0x74, 0x6f,
+	0x63, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x63,
+	0x6b, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x4d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x4b, 0x65, 0x65, 0x70, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x63,
+	0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x63, 0x61, 0x73, 0x68, 0x5f, 0x6f, 0x66,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x1c, 0x63, 0x61, 0x73, 0x68, 0x4f, 0x66, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a,
+	0x0c, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x0c, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x35, 0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x78, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x49, 0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x0e, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a,
+	0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x04,
+	0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x75, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x6f, 0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a,
+	0x09, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e,
+	0x69, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb0,
+	0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x43, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x22, 0x9c, 0x02, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d,
+	0x69, 0x75, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x4a, 0x0a, 0x13, 0x6d, 0x61, 0x72,
+	0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x14,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x75, 0x70, 0x70, 0x65,
+	0x72, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c, 0x6f,
+	0x77, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74,
+	0x69, 0x63, 0x6b, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x52, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x22, 0x43, 0x0a, 0x0e, 0x54, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x6c,
+	0x61, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x61, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x6c, 0x61, 0x6e, 0x65, 0x73, 0x22, 0x8d,
+	0x01, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x61, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0xa9,
+	0x01, 0x0a, 0x11, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xf5, 0x02, 0x0a, 0x14, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x78, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x49,
-	0x0a, 0x14, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x72, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xf8, 0x02, 0x0a, 0x0e, 0x52, 0x65,
-	0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x08,
-	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x73,
-	0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
-	0x64, 0x65, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x44, 0x61, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x15, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x06, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xd3, 0x02, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xcd, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf8, 0x01,
+	0x0a, 0x10, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x6e,
-	0x64, 0x44, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x22, 0x75, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f,
-	0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x09,
-	0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x69, 0x6e, 0x69,
-	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x01,
-	0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x43, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08, 0x64, 0x61, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x22, 0x9c, 0x02, 0x0a, 0x13, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69,
-	0x75, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x4a, 0x0a, 0x13, 0x6d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70,
-	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x75,
-	0x70, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x6d,
-	0x69, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x75, 0x70, 0x70, 0x65, 0x72,
-	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x30, 0x0a,
-	0x14, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70, 0x72,
-	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6c, 0x6f, 0x77,
-	0x65, 0x72, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12,
-	0x2e, 0x0a, 0x13, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x70,
-	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x69,
-	0x63, 0x6b, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x22,
-	0x71, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2a, 0xcf, 0x01, 0x0a, 0x08, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x18, 0x0a, 0x14, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x01, 0x12,
-	0x14, 0x0a, 0x10, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53,
-	0x48, 0x4f, 0x55, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48,
-	0x4f, 0x55, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x18,
-	0x0a, 0x14, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x18, 0x2a, 0xa2, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55,
-	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x03, 0x12, 0x1b,
-	0x0a, 0x17, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x53,
-	0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x41,
-	0x54, 0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x06, 0x2a, 0x96, 0x01, 0x0a, 0x0e, 0x46, 0x75,
-	0x74, 0x75, 0x72, 0x65, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x1b,
-	0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a,
-	0x1b, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f,
-	0x0a, 0x1b, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12,
-	0x21, 0x0a, 0x1d, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x07, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x61, 0x76,
+	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x61, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x0a, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x12,
+	0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a, 0x09,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x22, 0xb8, 0x03, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x6f, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x22, 0x5f, 0x0a, 0x10, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0xcf, 0x01, 0x0a,
+	0x08, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x03, 0x12,
+	0x15, 0x0a, 0x11, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x55, 0x4b, 0x55,
+	0x53, 0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x06, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x17, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e,
+	0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x18, 0x2a, 0xa2,
+	0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d,
+	0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x4f, 0x43,
+	0x4b, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53,
+	0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x45,
+	0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48, 0x4f,
+	0x55, 0x10, 0x06, 0x2a, 0x96, 0x01, 0x0a, 0x0e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x55, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53,
+	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x55, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f,
+	0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x55, 0x54,
+	0x55, 0x52, 0x45, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47,
+	0x48, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x18, 0x2a, 0x96, 0x01, 0x0a,
+	0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x10, 0x17, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x18, 0x2a, 0x97, 0x02, 0x0a, 0x0a, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x55, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x5f, 0x4d, 0x49,
+	0x4e, 0x49, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x46,
+	0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58,
+	0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x55, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x05,
+	0x12, 0x16, 0x0a, 0x12, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4a, 0x50, 0x58, 0x34, 0x30, 0x30, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x55, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x12, 0x0a,
+	0x0e, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x49, 0x10,
+	0x08, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x43, 0x4f, 0x52, 0x45, 0x33, 0x30, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x55, 0x54,
+	0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x49, 0x54, 0x10, 0x0a, 0x2a,
+	0x48, 0x0a, 0x07, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41,
+	0x4c, 0x4c, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x50, 0x55, 0x54,
+	0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x4c, 0x4c, 0x5f,
+	0x50, 0x55, 0x54, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x02, 0x2a, 0x82, 0x01, 0x0a, 0x07, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12,
+	0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b,
+	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41,
+	0x52, 0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52,
+	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x2a, 0x7e,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x04, 0x12, 0x0e,
+	0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x2a, 0xa7,
+	0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43,
+	0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59,
+	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x2a, 0x39, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x42,
+	0x55, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c,
+	0x4c, 0x10, 0x02, 0x2a, 0x52, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x02, 0x2a, 0xa9, 0x01, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x5a, 0x41, 0x52, 0x41, 0x42, 0x41, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41, 0x52, 0x49, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x54, 0x4c, 0x4f, 0x10, 0x05, 0x12,
+	0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4f,
+	0x43, 0x10, 0x06, 0x2a, 0x9c, 0x02, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45,
+	0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45,
+	0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x03, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x41, 0x54,
+	0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x10, 0x09,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17,
+	0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
 	0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0x18, 0x2a, 0x96, 0x01, 0x0a, 0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45,
-	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48,
-	0x54, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x18, 0x2a, 0x97, 0x02, 0x0a, 0x0a,
-	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x55,
-	0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x55, 0x54, 0x55, 0x52,
-	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x10, 0x01, 0x12, 0x1a,
-	0x0a, 0x16, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4b,
-	0x32, 0x32, 0x35, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x55,
-	0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x10,
-	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10, 0x04, 0x12, 0x17, 0x0a,
-	0x13, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x4f, 0x54,
-	0x48, 0x45, 0x52, 0x53, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4a, 0x50, 0x58, 0x34, 0x30, 0x30, 0x10, 0x06, 0x12, 0x13,
-	0x0a, 0x0f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x4f,
-	0x57, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f,
-	0x44, 0x45, 0x5f, 0x56, 0x49, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x55, 0x54, 0x55, 0x52,
-	0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x52, 0x45, 0x33, 0x30, 0x10, 0x09, 0x12,
-	0x14, 0x0a, 0x10, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52,
-	0x45, 0x49, 0x54, 0x10, 0x0a, 0x2a, 0x48, 0x0a, 0x07, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x75, 0x74,
-	0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x41,
-	0x4c, 0x4c, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x02, 0x2a,
-	0x82, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x50,
-	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
-	0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54,
-	0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x44,
-	0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04,
-	0x12, 0x12, 0x0a, 0x0e, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x05, 0x2a, 0x7e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a,
-	0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41,
-	0x49, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52,
-	0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
-	0x46, 0x59, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f,
-	0x4e, 0x45, 0x10, 0x05, 0x2a, 0xa7, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x57, 0x41, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
-	0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a,
-	0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f,
-	0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x2a, 0x39,
-	0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x53, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49,
-	0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x52, 0x0a, 0x09, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x44,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x02, 0x2a, 0xa9, 0x01,
-	0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x41, 0x52, 0x41, 0x42, 0x41, 0x10, 0x01, 0x12, 0x13,
-	0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45,
-	0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41, 0x52, 0x49, 0x10, 0x04,
-	0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
-	0x54, 0x4c, 0x4f, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4f, 0x43, 0x10, 0x06, 0x2a, 0x9c, 0x02, 0x0a, 0x0d, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f,
-	0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f,
-	0x55, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x05,
-	0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x06, 0x12, 0x16,
-	0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x53, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x5f, 0x53, 0x45, 0x53,
-	0x53, 0x49, 0x4f, 0x4e, 0x10, 0x17, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x53,
-	0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x18, 0x2a, 0x7e, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12,
-	0x19, 0x0a, 0x15, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x43, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x52, 0x50, 0x4f,
-	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x2a, 0x5d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x4c, 0x49,
-	0x56, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x4c, 0x49, 0x56,
-	0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01, 0x12,
-	0x16, 0x0a, 0x12, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x43, 0x41, 0x53, 0x48, 0x10, 0x02, 0x2a, 0x9f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4d,
-	0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c,
-	0x0a, 0x18, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
-	0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02,
-	0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x5f, 0x44, 0x41,
-	0x59, 0x10, 0x04, 0x22, 0x04, 0x08, 0x03, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x0b, 0x54, 0x69, 0x6d,
-	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45,
-	0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f,
-	0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x10, 0x01, 0x12, 0x15,
-	0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f,
-	0x46, 0x41, 0x4b, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e,
-	0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x4f, 0x4b, 0x10, 0x03, 0x2a, 0xf9, 0x01, 0x0a,
-	0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52,
-	0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x4f,
-	0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10,
-	0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x43, 0x41, 0x52, 0x52, 0x49, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45,
-	0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
-	0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x06,
-	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x52, 0x45, 0x56, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16,
-	0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
-	0x52, 0x41, 0x43, 0x54, 0x45, 0x44, 0x10, 0x08, 0x2a, 0xd6, 0x01, 0x0a, 0x10, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
-	0x1e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x01, 0x12, 0x21,
-	0x0a, 0x1d, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54,
-	0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
-	0x05, 0x2a, 0xeb, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54,
+	0x10, 0x18, 0x2a, 0x7e, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x43, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x52, 0x50, 0x4f, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x0c, 0x2a, 0x5d, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x54,
 	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45,
-	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32,
-	0x35, 0x10, 0x65, 0x12, 0x1d, 0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10,
-	0x85, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4a, 0x50, 0x58, 0x34, 0x30, 0x30, 0x10, 0x79, 0x12, 0x17, 0x0a, 0x13,
-	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f,
-	0x50, 0x49, 0x58, 0x10, 0x6b, 0x12, 0x1d, 0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x5f, 0x4d, 0x49, 0x4e,
-	0x49, 0x10, 0x8b, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x9a, 0x01,
-	0x12, 0x17, 0x0a, 0x12, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x52, 0x45, 0x49, 0x54, 0x10, 0x9b, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x53, 0x45, 0x43,
-	0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x10, 0x90,
-	0x01, 0x12, 0x15, 0x0a, 0x10, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x56, 0x49, 0x10, 0x91, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x53, 0x45, 0x43, 0x55,
-	0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x33, 0x30,
-	0x10, 0xab, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x5f, 0x4f, 0x50, 0x10, 0x67, 0x2a,
-	0xc5, 0x03, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
-	0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x43, 0x48, 0x41,
-	0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c,
-	0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44,
-	0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f,
-	0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48,
-	0x4f, 0x55, 0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x10, 0x0a, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x58,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44,
-	0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x48, 0x10,
+	0x02, 0x2a, 0x9f, 0x01, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f,
+	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x52, 0x47,
+	0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x59,
+	0x53, 0x54, 0x45, 0x4d, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e,
+	0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x4c, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x41,
+	0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x04, 0x22, 0x04, 0x08,
+	0x03, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f,
+	0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x5f, 0x46, 0x41, 0x53, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x4b, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45,
+	0x5f, 0x46, 0x4f, 0x4b, 0x10, 0x03, 0x2a, 0xf9, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x52, 0x49,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a,
+	0x13, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x08, 0x2a, 0xd6, 0x01, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xeb, 0x02, 0x0a, 0x0c,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f,
+	0x43, 0x4b, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x10, 0x65, 0x12, 0x1d, 0x0a,
+	0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e,
+	0x4b, 0x32, 0x32, 0x35, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10, 0x85, 0x07, 0x12, 0x18, 0x0a, 0x14,
+	0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x50,
+	0x58, 0x34, 0x30, 0x30, 0x10, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x10, 0x6b, 0x12,
+	0x1d, 0x0a, 0x18, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x10, 0x8b, 0x07, 0x12, 0x1a,
+	0x0a, 0x15, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x9a, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x53, 0x45,
+	0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x49, 0x54,
+	0x10, 0x9b, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x10, 0x90, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x53,
+	0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x10,
+	0x91, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x33, 0x30, 0x10, 0xab, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e,
+	0x4b, 0x32, 0x32, 0x35, 0x5f, 0x4f, 0x50, 0x10, 0x67, 0x2a, 0xc5, 0x03, 0x0a, 0x10, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49,
+	0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d,
+	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12,
+	0x23, 0x0a, 0x1f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x50, 0x52, 0x49,
+	0x4d, 0x45, 0x10, 0x0a, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f,
+	0x55, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20,
+	0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x47, 0x52, 0x4f, 0x57, 0x54, 0x48,
+	0x10, 0x0c, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44,
 	0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f,
-	0x47, 0x52, 0x4f, 0x57, 0x54, 0x48, 0x10, 0x0c, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f,
-	0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x31, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x5f, 0x32, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4d, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x53, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x43,
-	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4a,
-	0x41, 0x53, 0x44, 0x41, 0x51, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x43, 0x48, 0x41,
-	0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x49,
-	0x53, 0x48, 0x4f, 0x55, 0x10, 0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4b, 0x55,
-	0x53, 0x48, 0x4f, 0x55, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x41, 0x54, 0x53,
-	0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x09, 0x2a, 0xbf, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e,
-	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x31, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55,
+	0x5f, 0x32, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x54, 0x48, 0x45, 0x52,
+	0x53, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x44, 0x49, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4a, 0x41, 0x53, 0x44, 0x41, 0x51, 0x10,
+	0x06, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49,
+	0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x07,
+	0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x08,
+	0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x56,
+	0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10,
+	0x09, 0x2a, 0xbf, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52,
+	0x49, 0x43, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01,
 	0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f,
-	0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
-	0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x43,
-	0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
-	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50,
-	0x52, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x04, 0x2a, 0xc9, 0x02, 0x0a, 0x11, 0x4d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x23, 0x0a, 0x1f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52,
-	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c,
-	0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53,
-	0x45, 0x10, 0x01, 0x12, 0x2d, 0x0a, 0x29, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41,
-	0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x5f,
-	0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45,
-	0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e,
-	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x5f, 0x42, 0x41,
-	0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x03,
-	0x12, 0x2c, 0x0a, 0x28, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49,
-	0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x5f, 0x42, 0x41, 0x4c, 0x41,
-	0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x04, 0x12, 0x2a,
-	0x0a, 0x26, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x4d, 0x41, 0x47, 0x4e, 0x49,
-	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x41,
-	0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x4d, 0x41, 0x47, 0x4e, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x06, 0x2a, 0x8e, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74,
-	0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
-	0x21, 0x49, 0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x49, 0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x59,
-	0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
-	0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x27, 0x0a,
-	0x23, 0x49, 0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
 	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f,
-	0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a, 0xdb, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x4e, 0x4b, 0x49,
-	0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10,
-	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45,
-	0x4e, 0x44, 0x5f, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x32, 0x30, 0x10,
-	0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45,
-	0x4e, 0x44, 0x5f, 0x52, 0x49, 0x53, 0x45, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x4e,
-	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x48,
-	0x41, 0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
-	0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x54, 0x10,
-	0x05, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45,
-	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f,
-	0x32, 0x30, 0x10, 0x06, 0x2a, 0x7d, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x19, 0x0a, 0x15, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46,
-	0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x52,
-	0x47, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e,
-	0x47, 0x10, 0x03, 0x2a, 0xcf, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f,
-	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4f, 0x43,
-	0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10,
-	0x0a, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4d, 0x4f, 0x10, 0x0d, 0x12, 0x19, 0x0a, 0x15,
+	0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f,
+	0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f, 0x4c,
+	0x55, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x52,
+	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x10, 0x04, 0x2a, 0xc9, 0x02, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x41, 0x52,
+	0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d,
+	0x0a, 0x29, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e,
+	0x43, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x2d, 0x0a,
+	0x29, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43,
+	0x45, 0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28,
+	0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f,
+	0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x03, 0x12, 0x2c, 0x0a, 0x28, 0x4d, 0x41,
+	0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x42, 0x55, 0x59, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x44, 0x45,
+	0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x04, 0x12, 0x2a, 0x0a, 0x26, 0x4d, 0x41, 0x52, 0x47,
+	0x49, 0x4e, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x49, 0x47, 0x48, 0x5f, 0x4d, 0x41, 0x47, 0x4e, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x52,
+	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x57, 0x5f,
+	0x4d, 0x41, 0x47, 0x4e, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x2a,
+	0x8e, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x4e, 0x44, 0x55, 0x53,
+	0x54, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27,
+	0x0a, 0x23, 0x49, 0x4e, 0x44, 0x55, 0x53, 0x54, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49,
+	0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45,
+	0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x49, 0x4e, 0x44, 0x55, 0x53,
+	0x54, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02,
+	0x2a, 0xdb, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e,
+	0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x49, 0x53,
+	0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x32, 0x30, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52,
+	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x49, 0x53,
+	0x45, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x52, 0x45, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x04,
+	0x12, 0x19, 0x0a, 0x15, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e,
+	0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x52,
+	0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x32, 0x30, 0x10, 0x06, 0x2a, 0x7d,
+	0x0a, 0x08, 0x46, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x53,
+	0x54, 0x49, 0x54, 0x55, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x10, 0x02, 0x12,
+	0x1c, 0x0a, 0x18, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52,
+	0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0xcf, 0x03,
+	0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4d, 0x4f, 0x4d, 0x4f, 0x10, 0x0d, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x41, 0x4f, 0x10,
+	0x0e, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4d, 0x43, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15,
 	0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4d, 0x4f, 0x41, 0x4f, 0x10, 0x0e, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4d, 0x43,
-	0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x41, 0x43, 0x10, 0x10, 0x12, 0x1b, 0x0a,
-	0x17, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x49, 0x4f, 0x43, 0x5f, 0x4d, 0x4f, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
-	0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c,
-	0x4f, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x4d, 0x4f, 0x10, 0x15, 0x12, 0x19,
+	0x5f, 0x4d, 0x4f, 0x41, 0x43, 0x10, 0x10, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x4f, 0x43, 0x4b,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4f, 0x43, 0x5f,
+	0x4d, 0x4f, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x14, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4c, 0x4f, 0x4d, 0x4f, 0x10, 0x15, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43,
+	0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41,
+	0x4f, 0x10, 0x16, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x4d, 0x43, 0x10, 0x17, 0x12, 0x19,
 	0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x4f, 0x10, 0x16, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f,
-	0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f,
-	0x4d, 0x43, 0x10, 0x17, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x43, 0x10, 0x18, 0x12,
-	0x1d, 0x0a, 0x19, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41, 0x52, 0x49, 0x5f, 0x4d, 0x10, 0x19, 0x12, 0x1d,
-	0x0a, 0x19, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41, 0x52, 0x49, 0x5f, 0x41, 0x10, 0x1a, 0x12, 0x1b, 0x0a,
-	0x17, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x49, 0x4f, 0x43, 0x5f, 0x4c, 0x4f, 0x10, 0x1b, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54,
-	0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x54, 0x4f, 0x50, 0x10, 0x1e, 0x2a, 0xba, 0x01, 0x0a, 0x0f, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x55, 0x54,
-	0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
-	0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x78, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x43, 0x10,
-	0x12, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x46,
+	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x43, 0x10, 0x18, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x4f,
+	0x43, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55,
+	0x4e, 0x41, 0x52, 0x49, 0x5f, 0x4d, 0x10, 0x19, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x4f, 0x43,
+	0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e,
+	0x41, 0x52, 0x49, 0x5f, 0x41, 0x10, 0x1a, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x4f, 0x43, 0x4b,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4f, 0x43, 0x5f,
+	0x4c, 0x4f, 0x10, 0x1b, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x1e, 0x2a,
+	0xba, 0x01, 0x0a, 0x0f, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x78,
+	0x12, 0x19, 0x0a, 0x15, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x43, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x46,
 	0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4c, 0x4f, 0x43, 0x10, 0x1c, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50,
-	0x10, 0x1e, 0x2a, 0xba, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
-	0x4f, 0x10, 0x78, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x43, 0x10, 0x12, 0x12, 0x18,
-	0x0a, 0x14, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x54, 0x49,
+	0x5f, 0x4c, 0x4f, 0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x10, 0x1c,
+	0x12, 0x1a, 0x0a, 0x16, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x1e, 0x2a, 0xba, 0x01, 0x0a,
+	0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x78, 0x12, 0x19, 0x0a,
+	0x15, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x43, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x54, 0x49,
 	0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f,
-	0x43, 0x10, 0x1c, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x1e, 0x2a,
-	0x96, 0x02, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x14,
-	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
-	0x43, 0x59, 0x5f, 0x55, 0x53, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
-	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x55, 0x52, 0x5f, 0x4a, 0x50, 0x59,
-	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x47,
-	0x42, 0x50, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x55, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x04, 0x12, 0x14,
-	0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x48, 0x46, 0x5f, 0x4a,
-	0x50, 0x59, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59,
-	0x5f, 0x43, 0x41, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55,
-	0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x5a, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x07,
-	0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x5a, 0x41, 0x52,
-	0x5f, 0x4a, 0x50, 0x59, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
-	0x43, 0x59, 0x5f, 0x45, 0x55, 0x52, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10,
-	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x47, 0x42, 0x50, 0x5f, 0x55, 0x53, 0x44,
-	0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41,
-	0x55, 0x44, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x0b, 0x2a, 0x97, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x67,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x23, 0x0a, 0x1f, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58,
-	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53,
-	0x48, 0x4f, 0x55, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49,
-	0x53, 0x48, 0x4f, 0x55, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x55,
-	0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x47, 0x55,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
-	0x53, 0x41, 0x54, 0x53, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x52,
-	0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
-	0x43, 0x58, 0x4a, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4a, 0x4e, 0x58,
-	0x10, 0x15, 0x2a, 0x9d, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x47, 0x55,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x10, 0x14, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x10, 0x1c, 0x12, 0x1a, 0x0a,
+	0x16, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x1e, 0x2a, 0x96, 0x02, 0x0a, 0x08, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x53, 0x44,
+	0x5f, 0x4a, 0x50, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x45, 0x55, 0x52, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x47, 0x42, 0x50, 0x5f, 0x4a, 0x50, 0x59,
+	0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41,
+	0x55, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x48, 0x46, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x05, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x44, 0x5f, 0x4a,
+	0x50, 0x59, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x4e, 0x5a, 0x44, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55,
+	0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x5a, 0x41, 0x52, 0x5f, 0x4a, 0x50, 0x59, 0x10, 0x08,
+	0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x55, 0x52,
+	0x5f, 0x55, 0x53, 0x44, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x47, 0x42, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10,
+	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x55, 0x44, 0x5f, 0x55, 0x53, 0x44,
+	0x10, 0x0b, 0x2a, 0x97, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x47,
+	0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f,
+	0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x55, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x01, 0x12,
+	0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x49, 0x53, 0x48, 0x4f, 0x55, 0x10, 0x03,
+	0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45,
+	0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x46, 0x55, 0x4b, 0x55, 0x53, 0x48, 0x4f, 0x55,
+	0x10, 0x05, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x41, 0x54, 0x53, 0x55, 0x53,
+	0x48, 0x4f, 0x55, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x4f, 0x52,
+	0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x58, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x43, 0x58, 0x4a, 0x10, 0x0a, 0x12,
+	0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4a, 0x4e, 0x58, 0x10, 0x15, 0x2a, 0x9d, 0x03, 0x0a,
+	0x11, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02,
+	0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x4e,
+	0x54, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27,
 	0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x55,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x53,
-	0x54, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52,
-	0x47, 0x49, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
-	0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f,
+	0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47,
+	0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f,
+	0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x47, 0x55, 0x4c,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41,
+	0x52, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d,
+	0x10, 0x06, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
 	0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f,
-	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x04, 0x12,
-	0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52,
-	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x05, 0x12, 0x29, 0x0a,
-	0x25, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44,
-	0x55, 0x43, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f,
-	0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x06, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x47, 0x55,
-	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4d,
-	0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52,
-	0x41, 0x4c, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10,
-	0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10,
-	0x09, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59,
-	0x10, 0x0a, 0x2a, 0x7d, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x17,
-	0x0a, 0x13, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44,
-	0x45, 0x5f, 0x42, 0x55, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x47, 0x55, 0x4c,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10,
-	0x03, 0x2a, 0x6d, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49,
-	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
-	0x2a, 0x7a, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1c, 0x0a, 0x18, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
-	0x19, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
-	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32,
-	0x32, 0x35, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x58, 0x10, 0x03, 0x2a, 0x52, 0x0a, 0x09,
-	0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x44,
-	0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f,
-	0x56, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x55,
-	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x02,
-	0x2a, 0xb1, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48,
-	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x53,
-	0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x4f, 0x43, 0x4b,
-	0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54,
-	0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x02, 0x12, 0x25, 0x0a,
-	0x21, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41,
-	0x52, 0x49, 0x10, 0x03, 0x2a, 0x8e, 0x01, 0x0a, 0x17, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41,
-	0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x2b, 0x0a, 0x27, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52,
+	0x45, 0x58, 0x49, 0x54, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x07, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
+	0x44, 0x55, 0x43, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x52,
+	0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x10, 0x09, 0x12, 0x1f, 0x0a, 0x1b, 0x52,
+	0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43,
+	0x54, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x10, 0x0a, 0x2a, 0x7d, 0x0a, 0x0e,
+	0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x64, 0x65, 0x12, 0x1f,
+	0x0a, 0x1b, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49,
+	0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x47, 0x55,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x55, 0x59, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x03, 0x2a, 0x6d, 0x0a, 0x0f, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20,
+	0x0a, 0x1c, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x52, 0x45, 0x47, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x7a, 0x0a, 0x0b, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x49,
+	0x47, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x59,
+	0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4b, 0x32, 0x32, 0x35, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x4f, 0x50, 0x49, 0x58, 0x10, 0x03, 0x2a, 0x52, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x65, 0x72, 0x4f,
+	0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x44, 0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4f,
+	0x56, 0x45, 0x52, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x02, 0x2a, 0xb1, 0x01, 0x0a, 0x16, 0x53,
+	0x74, 0x6f, 0x63, 0x6b, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x41,
+	0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52,
 	0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a,
-	0x1e, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49,
-	0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10,
-	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45,
-	0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4c, 0x4f, 0x10, 0x02, 0x2a, 0x8e, 0x01, 0x0a, 0x17, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x2b, 0x0a, 0x27, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54, 0x45,
-	0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
-	0x0a, 0x1e, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48,
-	0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f,
-	0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54,
-	0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x02, 0x2a, 0x99, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x67, 0x69,
-	0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f,
+	0x4d, 0x4f, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x41, 0x46,
+	0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x54, 0x4f, 0x43, 0x4b,
+	0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x41, 0x52, 0x49, 0x10, 0x03, 0x2a, 0x8e,
+	0x01, 0x0a, 0x17, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48, 0x69,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x46, 0x55,
+	0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x55, 0x54, 0x55, 0x52,
+	0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x46,
+	0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x02, 0x2a,
+	0x8e, 0x01, 0x0a, 0x17, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x48,
+	0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x5f, 0x48, 0x49, 0x54,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x10, 0x02,
+	0x2a, 0x99, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69,
+	0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e,
+	0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4d,
+	0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
 	0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4d,
-	0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45,
-	0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4d,
-	0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x03, 0x32, 0xab, 0x12, 0x0a, 0x0c, 0x4b, 0x61, 0x62, 0x75, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x0f, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x53, 0x65, 0x6e,
-	0x64, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x42, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63,
-	0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x49, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67,
-	0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46,
-	0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x4d,
+	0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x45, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x8c, 0x01, 0x0a,
+	0x10, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x2a, 0x85, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x1c, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f,
+	0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x48, 0x49, 0x47,
+	0x48, 0x10, 0x03, 0x2a, 0x80, 0x04, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x45, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f,
+	0x4b, 0x45, 0x59, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x22,
+	0x0a, 0x1e, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x2a, 0x0a, 0x26, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x53,
+	0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x2b, 0x0a, 0x27, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55,
+	0x4e, 0x44, 0x53, 0x10, 0x09, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41,
+	0x52, 0x4b, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x27, 0x0a,
+	0x23, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x59,
+	0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x0b, 0x2a, 0xa1, 0x02, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x52,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x47, 0x49, 0x4e, 0x5f, 0x54, 0x52, 0x41,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x09, 0x2a, 0xb8, 0x03, 0x0a, 0x13, 0x52,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2d, 0x0a, 0x29, 0x52, 0x49, 0x53,
+	0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x52, 0x49, 0x53, 0x4b,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x41, 0x58, 0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x03, 0x12, 0x26,
+	0x0a, 0x22, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x4e, 0x4f, 0x54, 0x49,
+	0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x2c, 0x0a, 0x28, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x41, 0x58, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x49, 0x4f, 0x4e,
+	0x41, 0x4c, 0x10, 0x05, 0x12, 0x29, 0x0a, 0x25, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41,
+	0x58, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x53, 0x10, 0x06, 0x12,
+	0x25, 0x0a, 0x21, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x27, 0x0a, 0x23,
+	0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x09, 0x2a, 0xf1, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a,
+	0x19, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x23, 0x0a, 0x1f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xad, 0x01, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x0a,
+	0x21, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x56,
+	0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x42,
+	0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12,
+	0x24, 0x0a, 0x20, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f,
+	0x57, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x10, 0x03, 0x2a, 0xd6, 0x01, 0x0a, 0x13, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x57,
+	0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x22, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57,
+	0x45, 0x42, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x45, 0x42, 0x5f,
+	0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x42,
+	0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x45, 0x42, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x87,
+	0x01, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0x83, 0x1b, 0x0a, 0x0c, 0x4b, 0x61, 0x62,
+	0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x6e,
+	0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x53,
+	0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1e, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x63, 0x6b,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e,
 	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x34,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f,
-	0x6c, 0x12, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x37, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46,
-	0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x27, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
-	0x1e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
-	0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75,
-	0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x49,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x46, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x12, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x5b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x27, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
 	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
-	0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4c,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69,
-	0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
-	0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
-	0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x22,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x58,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x6b, 0x61,
-	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x11, 0x55, 0x6e, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x21, 0x2e,
-	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x58, 0x0a, 0x14,
+	0x62, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x1e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x64, 0x75, 0x73, 0x74, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1b, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x67, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x22, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x6b,
+	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x52, 0x0a, 0x11, 0x55, 0x6e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x21,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x58, 0x0a,
+	0x14, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e,
 	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x55,
-	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
-	0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72,
-	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b,
-	0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65,
-	0x6d, 0x69, 0x75, 0x6d, 0x12, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6b, 0x61, 0x62,
-	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
-	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x30, 0x01,
-	0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x3b, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x20, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50,
+	0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x50, 0x72,
+	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x4f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x58, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x61, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x65, 0x62, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6b, 0x61,
+	0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x65,
+	0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x57, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4c,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x12,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x12, 0x22, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x57, 0x0a, 0x14, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x24, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x55, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x40, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x1c, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6b, 0x61, 0x62, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x30, 0x01, 0x12,
+	0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6b, 0x61, 0x62,
+	0x75, 0x73, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x0c,
+	0x5a, 0x0a, 0x2e, 0x2f, 0x3b, 0x6b, 0x61, 0x62, 0x75, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11245,126 +14646,166 @@ func file_kabuspb_kabus_proto_rawDescGZIP() []byte {
 	return file_kabuspb_kabus_proto_rawDescData
 }
 
-var file_kabuspb_kabus_proto_enumTypes = make([]protoimpl.EnumInfo, 40)
-var file_kabuspb_kabus_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_kabuspb_kabus_proto_enumTypes = make([]protoimpl.EnumInfo, 50)
+var file_kabuspb_kabus_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_kabuspb_kabus_proto_goTypes = []interface{}{
-	(Exchange)(0),                          // 0: kabuspb.Exchange
-	(StockExchange)(0),                     // 1: kabuspb.StockExchange
-	(FutureExchange)(0),                    // 2: kabuspb.FutureExchange
-	(OptionExchange)(0),                    // 3: kabuspb.OptionExchange
-	(FutureCode)(0),                        // 4: kabuspb.FutureCode
-	(CallPut)(0),                           // 5: kabuspb.CallPut
-	(Product)(0),                           // 6: kabuspb.Product
-	(State)(0),                             // 7: kabuspb.State
-	(OrderState)(0),                        // 8: kabuspb.OrderState
-	(Side)(0),                              // 9: kabuspb.Side
-	(TradeType)(0),                         // 10: kabuspb.TradeType
-	(OrderType)(0),                         // 11: kabuspb.OrderType
-	(OrderExchange)(0),                     // 12: kabuspb.OrderExchange
-	(AccountType)(0),                       // 13: kabuspb.AccountType
-	(DeliveryType)(0),                      // 14: kabuspb.DeliveryType
-	(MarginTradeType)(0),                   // 15: kabuspb.MarginTradeType
-	(TimeInForce)(0),                       // 16: kabuspb.TimeInForce
-	(RecordType)(0),                        // 17: kabuspb.RecordType
-	(OrderDetailState)(0),                  // 18: kabuspb.OrderDetailState
-	(SecurityType)(0),                      // 19: kabuspb.SecurityType
-	(ExchangeDivision)(0),                  // 20: kabuspb.ExchangeDivision
-	(PriceRankingType)(0),                  // 21: kabuspb.PriceRankingType
-	(MarginRankingType)(0),                 // 22: kabuspb.MarginRankingType
-	(IndustryRankingType)(0),               // 23: kabuspb.IndustryRankingType
-	(RankingTrend)(0),                      // 24: kabuspb.RankingTrend
-	(FundType)(0),                          // 25: kabuspb.FundType
-	(StockOrderType)(0),                    // 26: kabuspb.StockOrderType
-	(FutureOrderType)(0),                   // 27: kabuspb.FutureOrderType
-	(OptionOrderType)(0),                   // 28: kabuspb.OptionOrderType
-	(Currency)(0),                          // 29: kabuspb.Currency
-	(RegulationExchange)(0),                // 30: kabuspb.RegulationExchange
-	(RegulationProduct)(0),                 // 31: kabuspb.RegulationProduct
-	(RegulationSide)(0),                    // 32: kabuspb.RegulationSide
-	(RegulationLevel)(0),                   // 33: kabuspb.RegulationLevel
-	(TriggerType)(0),                       // 34: kabuspb.TriggerType
-	(UnderOver)(0),                         // 35: kabuspb.UnderOver
-	(StockAfterHitOrderType)(0),            // 36: kabuspb.StockAfterHitOrderType
-	(FutureAfterHitOrderType)(0),           // 37: kabuspb.FutureAfterHitOrderType
-	(OptionAfterHitOrderType)(0),           // 38: kabuspb.OptionAfterHitOrderType
-	(MarginPremiumType)(0),                 // 39: kabuspb.MarginPremiumType
-	(*GetTokenRequest)(nil),                // 40: kabuspb.GetTokenRequest
-	(*RefreshTokenRequest)(nil),            // 41: kabuspb.RefreshTokenRequest
-	(*SendStockOrderRequest)(nil),          // 42: kabuspb.SendStockOrderRequest
-	(*StockStopOrder)(nil),                 // 43: kabuspb.StockStopOrder
-	(*SendMarginOrderRequest)(nil),         // 44: kabuspb.SendMarginOrderRequest
-	(*MarginStopOrder)(nil),                // 45: kabuspb.MarginStopOrder
-	(*SendFutureOrderRequest)(nil),         // 46: kabuspb.SendFutureOrderRequest
-	(*FutureStopOrder)(nil),                // 47: kabuspb.FutureStopOrder
-	(*SendOptionOrderRequest)(nil),         // 48: kabuspb.SendOptionOrderRequest
-	(*OptionStopOrder)(nil),                // 49: kabuspb.OptionStopOrder
-	(*ClosePosition)(nil),                  // 50: kabuspb.ClosePosition
-	(*CancelOrderRequest)(nil),             // 51: kabuspb.CancelOrderRequest
-	(*GetStockWalletRequest)(nil),          // 52: kabuspb.GetStockWalletRequest
-	(*GetMarginWalletRequest)(nil),         // 53: kabuspb.GetMarginWalletRequest
-	(*GetFutureWalletRequest)(nil),         // 54: kabuspb.GetFutureWalletRequest
-	(*GetOptionWalletRequest)(nil),         // 55: kabuspb.GetOptionWalletRequest
-	(*GetBoardRequest)(nil),                // 56: kabuspb.GetBoardRequest
-	(*GetSymbolRequest)(nil),               // 57: kabuspb.GetSymbolRequest
-	(*GetOrdersRequest)(nil),               // 58: kabuspb.GetOrdersRequest
-	(*GetPositionsRequest)(nil),            // 59: kabuspb.GetPositionsRequest
-	(*GetFutureSymbolCodeInfoRequest)(nil), // 60: kabuspb.GetFutureSymbolCodeInfoRequest
-	(*GetOptionSymbolCodeInfoRequest)(nil), // 61: kabuspb.GetOptionSymbolCodeInfoRequest
-	(*GetPriceRankingRequest)(nil),         // 62: kabuspb.GetPriceRankingRequest
-	(*GetTickRankingRequest)(nil),          // 63: kabuspb.GetTickRankingRequest
-	(*GetVolumeRankingRequest)(nil),        // 64: kabuspb.GetVolumeRankingRequest
-	(*GetValueRankingRequest)(nil),         // 65: kabuspb.GetValueRankingRequest
-	(*GetMarginRankingRequest)(nil),        // 66: kabuspb.GetMarginRankingRequest
-	(*GetIndustryRankingRequest)(nil),      // 67: kabuspb.GetIndustryRankingRequest
-	(*GetRegisteredSymbolsRequest)(nil),    // 68: kabuspb.GetRegisteredSymbolsRequest
-	(*RegisterSymbolsRequest)(nil),         // 69: kabuspb.RegisterSymbolsRequest
-	(*UnregisterSymbolsRequest)(nil),       // 70: kabuspb.UnregisterSymbolsRequest
-	(*UnregisterAllSymbolsRequest)(nil),    // 71: kabuspb.UnregisterAllSymbolsRequest
-	(*GetExchangeRequest)(nil),             // 72: kabuspb.GetExchangeRequest
-	(*GetRegulationRequest)(nil),           // 73: kabuspb.GetRegulationRequest
-	(*GetPrimaryExchangeRequest)(nil),      // 74: kabuspb.GetPrimaryExchangeRequest
-	(*GetSoftLimitRequest)(nil),            // 75: kabuspb.GetSoftLimitRequest
-	(*GetMarginPremiumRequest)(nil),        // 76: kabuspb.GetMarginPremiumRequest
-	(*GetBoardsStreamingRequest)(nil),      // 77: kabuspb.GetBoardsStreamingRequest
-	(*Token)(nil),                          // 78: kabuspb.Token
-	(*Board)(nil),                          // 79: kabuspb.Board
-	(*Symbol)(nil),                         // 80: kabuspb.Symbol
-	(*SymbolCodeInfo)(nil),                 // 81: kabuspb.SymbolCodeInfo
-	(*FirstQuote)(nil),                     // 82: kabuspb.FirstQuote
-	(*Quote)(nil),                          // 83: kabuspb.Quote
-	(*Orders)(nil),                         // 84: kabuspb.Orders
-	(*Order)(nil),                          // 85: kabuspb.Order
-	(*OrderDetail)(nil),                    // 86: kabuspb.OrderDetail
-	(*Positions)(nil),                      // 87: kabuspb.Positions
-	(*Position)(nil),                       // 88: kabuspb.Position
-	(*RegisteredSymbols)(nil),              // 89: kabuspb.RegisteredSymbols
-	(*RegisterSymbol)(nil),                 // 90: kabuspb.RegisterSymbol
-	(*PriceRanking)(nil),                   // 91: kabuspb.PriceRanking
-	(*PriceRankingInfo)(nil),               // 92: kabuspb.PriceRankingInfo
-	(*TickRanking)(nil),                    // 93: kabuspb.TickRanking
-	(*TickRankingInfo)(nil),                // 94: kabuspb.TickRankingInfo
-	(*VolumeRanking)(nil),                  // 95: kabuspb.VolumeRanking
-	(*VolumeRankingInfo)(nil),              // 96: kabuspb.VolumeRankingInfo
-	(*ValueRanking)(nil),                   // 97: kabuspb.ValueRanking
-	(*ValueRankingInfo)(nil),               // 98: kabuspb.ValueRankingInfo
-	(*MarginRanking)(nil),                  // 99: kabuspb.MarginRanking
-	(*MarginRankingInfo)(nil),              // 100: kabuspb.MarginRankingInfo
-	(*IndustryRanking)(nil),                // 101: kabuspb.IndustryRanking
-	(*IndustryRankingInfo)(nil),            // 102: kabuspb.IndustryRankingInfo
-	(*OrderResponse)(nil),                  // 103: kabuspb.OrderResponse
-	(*StockWallet)(nil),                    // 104: kabuspb.StockWallet
-	(*MarginWallet)(nil),                   // 105: kabuspb.MarginWallet
-	(*FutureWallet)(nil),                   // 106: kabuspb.FutureWallet
-	(*OptionWallet)(nil),                   // 107: kabuspb.OptionWallet
-	(*ExchangeInfo)(nil),                   // 108: kabuspb.ExchangeInfo
-	(*Regulation)(nil),                     // 109: kabuspb.Regulation
-	(*RegulationInfo)(nil),                 // 110: kabuspb.RegulationInfo
-	(*PrimaryExchange)(nil),                // 111: kabuspb.PrimaryExchange
-	(*SoftLimit)(nil),                      // 112: kabuspb.SoftLimit
-	(*MarginPremium)(nil),                  // 113: kabuspb.MarginPremium
-	(*MarginPremiumDetail)(nil),            // 114: kabuspb.MarginPremiumDetail
-	(*RequestError)(nil),                   // 115: kabuspb.RequestError
-	(*timestamppb.Timestamp)(nil),          // 116: google.protobuf.Timestamp
+	(Exchange)(0),                             // 0: kabuspb.Exchange
+	(StockExchange)(0),                        // 1: kabuspb.StockExchange
+	(FutureExchange)(0),                       // 2: kabuspb.FutureExchange
+	(OptionExchange)(0),                       // 3: kabuspb.OptionExchange
+	(FutureCode)(0),                           // 4: kabuspb.FutureCode
+	(CallPut)(0),                              // 5: kabuspb.CallPut
+	(Product)(0),                              // 6: kabuspb.Product
+	(State)(0),                                // 7: kabuspb.State
+	(OrderState)(0),                           // 8: kabuspb.OrderState
+	(Side)(0),                                 // 9: kabuspb.Side
+	(TradeType)(0),                            // 10: kabuspb.TradeType
+	(OrderType)(0),                            // 11: kabuspb.OrderType
+	(OrderExchange)(0),                        // 12: kabuspb.OrderExchange
+	(AccountType)(0),                          // 13: kabuspb.AccountType
+	(DeliveryType)(0),                         // 14: kabuspb.DeliveryType
+	(MarginTradeType)(0),                      // 15: kabuspb.MarginTradeType
+	(TimeInForce)(0),                          // 16: kabuspb.TimeInForce
+	(RecordType)(0),                           // 17: kabuspb.RecordType
+	(OrderDetailState)(0),                     // 18: kabuspb.OrderDetailState
+	(SecurityType)(0),                         // 19: kabuspb.SecurityType
+	(ExchangeDivision)(0),                     // 20: kabuspb.ExchangeDivision
+	(PriceRankingType)(0),                     // 21: kabuspb.PriceRankingType
+	(MarginRankingType)(0),                    // 22: kabuspb.MarginRankingType
+	(IndustryRankingType)(0),                  // 23: kabuspb.IndustryRankingType
+	(RankingTrend)(0),                         // 24: kabuspb.RankingTrend
+	(FundType)(0),                             // 25: kabuspb.FundType
+	(StockOrderType)(0),                       // 26: kabuspb.StockOrderType
+	(FutureOrderType)(0),                      // 27: kabuspb.FutureOrderType
+	(OptionOrderType)(0),                      // 28: kabuspb.OptionOrderType
+	(Currency)(0),                             // 29: kabuspb.Currency
+	(RegulationExchange)(0),                   // 30: kabuspb.RegulationExchange
+	(RegulationProduct)(0),                    // 31: kabuspb.RegulationProduct
+	(RegulationSide)(0),                       // 32: kabuspb.RegulationSide
+	(RegulationLevel)(0),                      // 33: kabuspb.RegulationLevel
+	(TriggerType)(0),                          // 34: kabuspb.TriggerType
+	(UnderOver)(0),                            // 35: kabuspb.UnderOver
+	(StockAfterHitOrderType)(0),               // 36: kabuspb.StockAfterHitOrderType
+	(FutureAfterHitOrderType)(0),              // 37: kabuspb.FutureAfterHitOrderType
+	(OptionAfterHitOrderType)(0),              // 38: kabuspb.OptionAfterHitOrderType
+	(MarginPremiumType)(0),                    // 39: kabuspb.MarginPremiumType
+	(ThrottleCategory)(0),                     // 40: kabuspb.ThrottleCategory
+	(RequestPriority)(0),                      // 41: kabuspb.RequestPriority
+	(RequestErrorReason)(0),                   // 42: kabuspb.RequestErrorReason
+	(Permission)(0),                           // 43: kabuspb.Permission
+	(RiskRejectionReason)(0),                  // 44: kabuspb.RiskRejectionReason
+	(OrderEventType)(0),                       // 45: kabuspb.OrderEventType
+	(PositionEventType)(0),                    // 46: kabuspb.PositionEventType
+	(BoardOverflowPolicy)(0),                  // 47: kabuspb.BoardOverflowPolicy
+	(BoardWebSocketState)(0),                  // 48: kabuspb.BoardWebSocketState
+	(AuditLogType)(0),                         // 49: kabuspb.AuditLogType
+	(*GetTokenRequest)(nil),                   // 50: kabuspb.GetTokenRequest
+	(*RefreshTokenRequest)(nil),               // 51: kabuspb.RefreshTokenRequest
+	(*SendStockOrderRequest)(nil),             // 52: kabuspb.SendStockOrderRequest
+	(*StockStopOrder)(nil),                    // 53: kabuspb.StockStopOrder
+	(*SendMarginOrderRequest)(nil),            // 54: kabuspb.SendMarginOrderRequest
+	(*MarginStopOrder)(nil),                   // 55: kabuspb.MarginStopOrder
+	(*SendFutureOrderRequest)(nil),            // 56: kabuspb.SendFutureOrderRequest
+	(*FutureStopOrder)(nil),                   // 57: kabuspb.FutureStopOrder
+	(*SendOptionOrderRequest)(nil),            // 58: kabuspb.SendOptionOrderRequest
+	(*OptionStopOrder)(nil),                   // 59: kabuspb.OptionStopOrder
+	(*ClosePosition)(nil),                     // 60: kabuspb.ClosePosition
+	(*CancelOrderRequest)(nil),                // 61: kabuspb.CancelOrderRequest
+	(*GetStockWalletRequest)(nil),             // 62: kabuspb.GetStockWalletRequest
+	(*GetMarginWalletRequest)(nil),            // 63: kabuspb.GetMarginWalletRequest
+	(*GetFutureWalletRequest)(nil),            // 64: kabuspb.GetFutureWalletRequest
+	(*GetOptionWalletRequest)(nil),            // 65: kabuspb.GetOptionWalletRequest
+	(*GetBoardRequest)(nil),                   // 66: kabuspb.GetBoardRequest
+	(*GetSymbolRequest)(nil),                  // 67: kabuspb.GetSymbolRequest
+	(*GetOrdersRequest)(nil),                  // 68: kabuspb.GetOrdersRequest
+	(*GetOrderHistoryRequest)(nil),            // 69: kabuspb.GetOrderHistoryRequest
+	(*GetPositionsRequest)(nil),               // 70: kabuspb.GetPositionsRequest
+	(*GetFutureSymbolCodeInfoRequest)(nil),    // 71: kabuspb.GetFutureSymbolCodeInfoRequest
+	(*GetOptionSymbolCodeInfoRequest)(nil),    // 72: kabuspb.GetOptionSymbolCodeInfoRequest
+	(*GetPriceRankingRequest)(nil),            // 73: kabuspb.GetPriceRankingRequest
+	(*GetTickRankingRequest)(nil),             // 74: kabuspb.GetTickRankingRequest
+	(*GetVolumeRankingRequest)(nil),           // 75: kabuspb.GetVolumeRankingRequest
+	(*GetValueRankingRequest)(nil),            // 76: kabuspb.GetValueRankingRequest
+	(*GetMarginRankingRequest)(nil),           // 77: kabuspb.GetMarginRankingRequest
+	(*GetIndustryRankingRequest)(nil),         // 78: kabuspb.GetIndustryRankingRequest
+	(*GetRegisteredSymbolsRequest)(nil),       // 79: kabuspb.GetRegisteredSymbolsRequest
+	(*RegisterSymbolsRequest)(nil),            // 80: kabuspb.RegisterSymbolsRequest
+	(*UnregisterSymbolsRequest)(nil),          // 81: kabuspb.UnregisterSymbolsRequest
+	(*UnregisterAllSymbolsRequest)(nil),       // 82: kabuspb.UnregisterAllSymbolsRequest
+	(*GetExchangeRequest)(nil),                // 83: kabuspb.GetExchangeRequest
+	(*GetRegulationRequest)(nil),              // 84: kabuspb.GetRegulationRequest
+	(*GetPrimaryExchangeRequest)(nil),         // 85: kabuspb.GetPrimaryExchangeRequest
+	(*GetSoftLimitRequest)(nil),               // 86: kabuspb.GetSoftLimitRequest
+	(*GetMarginPremiumRequest)(nil),           // 87: kabuspb.GetMarginPremiumRequest
+	(*GetBoardsStreamingRequest)(nil),         // 88: kabuspb.GetBoardsStreamingRequest
+	(*GetOrderEventsStreamingRequest)(nil),    // 89: kabuspb.GetOrderEventsStreamingRequest
+	(*GetPositionEventsStreamingRequest)(nil), // 90: kabuspb.GetPositionEventsStreamingRequest
+	(*GetThrottleStatusRequest)(nil),          // 91: kabuspb.GetThrottleStatusRequest
+	(*GetBoardStreamStatusRequest)(nil),       // 92: kabuspb.GetBoardStreamStatusRequest
+	(*GetBoardWebSocketStatusRequest)(nil),    // 93: kabuspb.GetBoardWebSocketStatusRequest
+	(*GetUsageRequest)(nil),                   // 94: kabuspb.GetUsageRequest
+	(*GetStationStatusRequest)(nil),           // 95: kabuspb.GetStationStatusRequest
+	(*ActivateKillSwitchRequest)(nil),         // 96: kabuspb.ActivateKillSwitchRequest
+	(*DeactivateKillSwitchRequest)(nil),       // 97: kabuspb.DeactivateKillSwitchRequest
+	(*GetKillSwitchStatusRequest)(nil),        // 98: kabuspb.GetKillSwitchStatusRequest
+	(*GetAuditLogsRequest)(nil),               // 99: kabuspb.GetAuditLogsRequest
+	(*Token)(nil),                             // 100: kabuspb.Token
+	(*Board)(nil),                             // 101: kabuspb.Board
+	(*BoardStreamNotice)(nil),                 // 102: kabuspb.BoardStreamNotice
+	(*Symbol)(nil),                            // 103: kabuspb.Symbol
+	(*SymbolCodeInfo)(nil),                    // 104: kabuspb.SymbolCodeInfo
+	(*FirstQuote)(nil),                        // 105: kabuspb.FirstQuote
+	(*Quote)(nil),                             // 106: kabuspb.Quote
+	(*Orders)(nil),                            // 107: kabuspb.Orders
+	(*OrderHistory)(nil),                      // 108: kabuspb.OrderHistory
+	(*OrderHistoryRecord)(nil),                // 109: kabuspb.OrderHistoryRecord
+	(*Order)(nil),                             // 110: kabuspb.Order
+	(*OrderDetail)(nil),                       // 111: kabuspb.OrderDetail
+	(*Positions)(nil),                         // 112: kabuspb.Positions
+	(*Position)(nil),                          // 113: kabuspb.Position
+	(*RegisteredSymbols)(nil),                 // 114: kabuspb.RegisteredSymbols
+	(*RegisterSymbol)(nil),                    // 115: kabuspb.RegisterSymbol
+	(*PriceRanking)(nil),                      // 116: kabuspb.PriceRanking
+	(*PriceRankingInfo)(nil),                  // 117: kabuspb.PriceRankingInfo
+	(*TickRanking)(nil),                       // 118: kabuspb.TickRanking
+	(*TickRankingInfo)(nil),                   // 119: kabuspb.TickRankingInfo
+	(*VolumeRanking)(nil),                     // 120: kabuspb.VolumeRanking
+	(*VolumeRankingInfo)(nil),                 // 121: kabuspb.VolumeRankingInfo
+	(*ValueRanking)(nil),                      // 122: kabuspb.ValueRanking
+	(*ValueRankingInfo)(nil),                  // 123: kabuspb.ValueRankingInfo
+	(*MarginRanking)(nil),                     // 124: kabuspb.MarginRanking
+	(*MarginRankingInfo)(nil),                 // 125: kabuspb.MarginRankingInfo
+	(*IndustryRanking)(nil),                   // 126: kabuspb.IndustryRanking
+	(*IndustryRankingInfo)(nil),               // 127: kabuspb.IndustryRankingInfo
+	(*OrderResponse)(nil),                     // 128: kabuspb.OrderResponse
+	(*StockWallet)(nil),                       // 129: kabuspb.StockWallet
+	(*MarginWallet)(nil),                      // 130: kabuspb.MarginWallet
+	(*FutureWallet)(nil),                      // 131: kabuspb.FutureWallet
+	(*OptionWallet)(nil),                      // 132: kabuspb.OptionWallet
+	(*ExchangeInfo)(nil),                      // 133: kabuspb.ExchangeInfo
+	(*Regulation)(nil),                        // 134: kabuspb.Regulation
+	(*RegulationInfo)(nil),                    // 135: kabuspb.RegulationInfo
+	(*PrimaryExchange)(nil),                   // 136: kabuspb.PrimaryExchange
+	(*SoftLimit)(nil),                         // 137: kabuspb.SoftLimit
+	(*MarginPremium)(nil),                     // 138: kabuspb.MarginPremium
+	(*MarginPremiumDetail)(nil),               // 139: kabuspb.MarginPremiumDetail
+	(*RequestError)(nil),                      // 140: kabuspb.RequestError
+	(*RiskRejection)(nil),                     // 141: kabuspb.RiskRejection
+	(*ThrottleStatus)(nil),                    // 142: kabuspb.ThrottleStatus
+	(*ThrottleLaneStatus)(nil),                // 143: kabuspb.ThrottleLaneStatus
+	(*BoardStreamStatus)(nil),                 // 144: kabuspb.BoardStreamStatus
+	(*BoardWebSocketStatus)(nil),              // 145: kabuspb.BoardWebSocketStatus
+	(*BoardSubscriberStatus)(nil),             // 146: kabuspb.BoardSubscriberStatus
+	(*Usages)(nil),                            // 147: kabuspb.Usages
+	(*Usage)(nil),                             // 148: kabuspb.Usage
+	(*StationStatus)(nil),                     // 149: kabuspb.StationStatus
+	(*KillSwitchStatus)(nil),                  // 150: kabuspb.KillSwitchStatus
+	(*OrderEvent)(nil),                        // 151: kabuspb.OrderEvent
+	(*PositionEvent)(nil),                     // 152: kabuspb.PositionEvent
+	(*AuditLogs)(nil),                         // 153: kabuspb.AuditLogs
+	(*AuditLog)(nil),                          // 154: kabuspb.AuditLog
+	(*KillSwitchCancel)(nil),                  // 155: kabuspb.KillSwitchCancel
+	(*timestamppb.Timestamp)(nil),             // 156: google.protobuf.Timestamp
 }
 var file_kabuspb_kabus_proto_depIdxs = []int32{
 	1,   // 0: kabuspb.SendStockOrderRequest.exchange:type_name -> kabuspb.StockExchange
@@ -11373,8 +14814,8 @@ var file_kabuspb_kabus_proto_depIdxs = []int32{
 	25,  // 3: kabuspb.SendStockOrderRequest.fund_type:type_name -> kabuspb.FundType
 	13,  // 4: kabuspb.SendStockOrderRequest.account_type:type_name -> kabuspb.AccountType
 	26,  // 5: kabuspb.SendStockOrderRequest.order_type:type_name -> kabuspb.StockOrderType
-	116, // 6: kabuspb.SendStockOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
-	43,  // 7: kabuspb.SendStockOrderRequest.stop_order:type_name -> kabuspb.StockStopOrder
+	156, // 6: kabuspb.SendStockOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
+	53,  // 7: kabuspb.SendStockOrderRequest.stop_order:type_name -> kabuspb.StockStopOrder
 	34,  // 8: kabuspb.StockStopOrder.trigger_type:type_name -> kabuspb.TriggerType
 	35,  // 9: kabuspb.StockStopOrder.under_over:type_name -> kabuspb.UnderOver
 	36,  // 10: kabuspb.StockStopOrder.after_hit_order_type:type_name -> kabuspb.StockAfterHitOrderType
@@ -11384,10 +14825,10 @@ var file_kabuspb_kabus_proto_depIdxs = []int32{
 	15,  // 14: kabuspb.SendMarginOrderRequest.margin_trade_type:type_name -> kabuspb.MarginTradeType
 	14,  // 15: kabuspb.SendMarginOrderRequest.delivery_type:type_name -> kabuspb.DeliveryType
 	13,  // 16: kabuspb.SendMarginOrderRequest.account_type:type_name -> kabuspb.AccountType
-	50,  // 17: kabuspb.SendMarginOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
+	60,  // 17: kabuspb.SendMarginOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
 	26,  // 18: kabuspb.SendMarginOrderRequest.order_type:type_name -> kabuspb.StockOrderType
-	116, // 19: kabuspb.SendMarginOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
-	45,  // 20: kabuspb.SendMarginOrderRequest.stop_order:type_name -> kabuspb.MarginStopOrder
+	156, // 19: kabuspb.SendMarginOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
+	55,  // 20: kabuspb.SendMarginOrderRequest.stop_order:type_name -> kabuspb.MarginStopOrder
 	34,  // 21: kabuspb.MarginStopOrder.trigger_type:type_name -> kabuspb.TriggerType
 	35,  // 22: kabuspb.MarginStopOrder.under_over:type_name -> kabuspb.UnderOver
 	36,  // 23: kabuspb.MarginStopOrder.after_hit_order_type:type_name -> kabuspb.StockAfterHitOrderType
@@ -11395,20 +14836,20 @@ var file_kabuspb_kabus_proto_depIdxs = []int32{
 	10,  // 25: kabuspb.SendFutureOrderRequest.trade_type:type_name -> kabuspb.TradeType
 	16,  // 26: kabuspb.SendFutureOrderRequest.time_in_force:type_name -> kabuspb.TimeInForce
 	9,   // 27: kabuspb.SendFutureOrderRequest.side:type_name -> kabuspb.Side
-	50,  // 28: kabuspb.SendFutureOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
+	60,  // 28: kabuspb.SendFutureOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
 	27,  // 29: kabuspb.SendFutureOrderRequest.order_type:type_name -> kabuspb.FutureOrderType
-	116, // 30: kabuspb.SendFutureOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
-	47,  // 31: kabuspb.SendFutureOrderRequest.stop_order:type_name -> kabuspb.FutureStopOrder
+	156, // 30: kabuspb.SendFutureOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
+	57,  // 31: kabuspb.SendFutureOrderRequest.stop_order:type_name -> kabuspb.FutureStopOrder
 	35,  // 32: kabuspb.FutureStopOrder.under_over:type_name -> kabuspb.UnderOver
 	37,  // 33: kabuspb.FutureStopOrder.after_hit_order_type:type_name -> kabuspb.FutureAfterHitOrderType
 	3,   // 34: kabuspb.SendOptionOrderRequest.exchange:type_name -> kabuspb.OptionExchange
 	10,  // 35: kabuspb.SendOptionOrderRequest.trade_type:type_name -> kabuspb.TradeType
 	16,  // 36: kabuspb.SendOptionOrderRequest.time_in_force:type_name -> kabuspb.TimeInForce
 	9,   // 37: kabuspb.SendOptionOrderRequest.side:type_name -> kabuspb.Side
-	50,  // 38: kabuspb.SendOptionOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
+	60,  // 38: kabuspb.SendOptionOrderRequest.close_positions:type_name -> kabuspb.ClosePosition
 	28,  // 39: kabuspb.SendOptionOrderRequest.order_type:type_name -> kabuspb.OptionOrderType
-	116, // 40: kabuspb.SendOptionOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
-	49,  // 41: kabuspb.SendOptionOrderRequest.stop_order:type_name -> kabuspb.OptionStopOrder
+	156, // 40: kabuspb.SendOptionOrderRequest.expire_day:type_name -> google.protobuf.Timestamp
+	59,  // 41: kabuspb.SendOptionOrderRequest.stop_order:type_name -> kabuspb.OptionStopOrder
 	35,  // 42: kabuspb.OptionStopOrder.under_over:type_name -> kabuspb.UnderOver
 	38,  // 43: kabuspb.OptionStopOrder.after_hit_order_type:type_name -> kabuspb.OptionAfterHitOrderType
 	1,   // 44: kabuspb.GetStockWalletRequest.exchange:type_name -> kabuspb.StockExchange
@@ -11418,201 +14859,272 @@ var file_kabuspb_kabus_proto_depIdxs = []int32{
 	0,   // 48: kabuspb.GetBoardRequest.exchange:type_name -> kabuspb.Exchange
 	0,   // 49: kabuspb.GetSymbolRequest.exchange:type_name -> kabuspb.Exchange
 	6,   // 50: kabuspb.GetOrdersRequest.product:type_name -> kabuspb.Product
-	116, // 51: kabuspb.GetOrdersRequest.update_time:type_name -> google.protobuf.Timestamp
+	156, // 51: kabuspb.GetOrdersRequest.update_time:type_name -> google.protobuf.Timestamp
 	8,   // 52: kabuspb.GetOrdersRequest.state:type_name -> kabuspb.OrderState
 	9,   // 53: kabuspb.GetOrdersRequest.side:type_name -> kabuspb.Side
 	10,  // 54: kabuspb.GetOrdersRequest.tradeType:type_name -> kabuspb.TradeType
-	6,   // 55: kabuspb.GetPositionsRequest.product:type_name -> kabuspb.Product
-	9,   // 56: kabuspb.GetPositionsRequest.side:type_name -> kabuspb.Side
-	4,   // 57: kabuspb.GetFutureSymbolCodeInfoRequest.future_code:type_name -> kabuspb.FutureCode
-	116, // 58: kabuspb.GetFutureSymbolCodeInfoRequest.derivative_month:type_name -> google.protobuf.Timestamp
-	116, // 59: kabuspb.GetOptionSymbolCodeInfoRequest.derivative_month:type_name -> google.protobuf.Timestamp
-	5,   // 60: kabuspb.GetOptionSymbolCodeInfoRequest.call_or_put:type_name -> kabuspb.CallPut
-	21,  // 61: kabuspb.GetPriceRankingRequest.ranking_type:type_name -> kabuspb.PriceRankingType
-	20,  // 62: kabuspb.GetPriceRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	20,  // 63: kabuspb.GetTickRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	20,  // 64: kabuspb.GetVolumeRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	20,  // 65: kabuspb.GetValueRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	22,  // 66: kabuspb.GetMarginRankingRequest.ranking_type:type_name -> kabuspb.MarginRankingType
-	20,  // 67: kabuspb.GetMarginRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	23,  // 68: kabuspb.GetIndustryRankingRequest.ranking_type:type_name -> kabuspb.IndustryRankingType
-	20,  // 69: kabuspb.GetIndustryRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
-	90,  // 70: kabuspb.RegisterSymbolsRequest.symbols:type_name -> kabuspb.RegisterSymbol
-	90,  // 71: kabuspb.UnregisterSymbolsRequest.symbols:type_name -> kabuspb.RegisterSymbol
-	29,  // 72: kabuspb.GetExchangeRequest.currency:type_name -> kabuspb.Currency
-	1,   // 73: kabuspb.GetRegulationRequest.exchange:type_name -> kabuspb.StockExchange
-	116, // 74: kabuspb.Token.expired_at:type_name -> google.protobuf.Timestamp
-	0,   // 75: kabuspb.Board.exchange:type_name -> kabuspb.Exchange
-	116, // 76: kabuspb.Board.current_price_time:type_name -> google.protobuf.Timestamp
-	116, // 77: kabuspb.Board.previous_close_time:type_name -> google.protobuf.Timestamp
-	116, // 78: kabuspb.Board.opening_price_time:type_name -> google.protobuf.Timestamp
-	116, // 79: kabuspb.Board.high_price_time:type_name -> google.protobuf.Timestamp
-	116, // 80: kabuspb.Board.low_price_time:type_name -> google.protobuf.Timestamp
-	116, // 81: kabuspb.Board.trading_volume_time:type_name -> google.protobuf.Timestamp
-	116, // 82: kabuspb.Board.bid_time:type_name -> google.protobuf.Timestamp
-	82,  // 83: kabuspb.Board.sell1:type_name -> kabuspb.FirstQuote
-	83,  // 84: kabuspb.Board.sell2:type_name -> kabuspb.Quote
-	83,  // 85: kabuspb.Board.sell3:type_name -> kabuspb.Quote
-	83,  // 86: kabuspb.Board.sell4:type_name -> kabuspb.Quote
-	83,  // 87: kabuspb.Board.sell5:type_name -> kabuspb.Quote
-	83,  // 88: kabuspb.Board.sell6:type_name -> kabuspb.Quote
-	83,  // 89: kabuspb.Board.sell7:type_name -> kabuspb.Quote
-	83,  // 90: kabuspb.Board.sell8:type_name -> kabuspb.Quote
-	83,  // 91: kabuspb.Board.sell9:type_name -> kabuspb.Quote
-	83,  // 92: kabuspb.Board.sell10:type_name -> kabuspb.Quote
-	116, // 93: kabuspb.Board.ask_time:type_name -> google.protobuf.Timestamp
-	82,  // 94: kabuspb.Board.buy1:type_name -> kabuspb.FirstQuote
-	83,  // 95: kabuspb.Board.buy2:type_name -> kabuspb.Quote
-	83,  // 96: kabuspb.Board.buy3:type_name -> kabuspb.Quote
-	83,  // 97: kabuspb.Board.buy4:type_name -> kabuspb.Quote
-	83,  // 98: kabuspb.Board.buy5:type_name -> kabuspb.Quote
-	83,  // 99: kabuspb.Board.buy6:type_name -> kabuspb.Quote
-	83,  // 100: kabuspb.Board.buy7:type_name -> kabuspb.Quote
-	83,  // 101: kabuspb.Board.buy8:type_name -> kabuspb.Quote
-	83,  // 102: kabuspb.Board.buy9:type_name -> kabuspb.Quote
-	83,  // 103: kabuspb.Board.buy10:type_name -> kabuspb.Quote
-	19,  // 104: kabuspb.Board.security_type:type_name -> kabuspb.SecurityType
-	0,   // 105: kabuspb.Symbol.exchange:type_name -> kabuspb.Exchange
-	116, // 106: kabuspb.Symbol.fiscal_year_end_basic:type_name -> google.protobuf.Timestamp
-	116, // 107: kabuspb.Symbol.derivative_month:type_name -> google.protobuf.Timestamp
-	116, // 108: kabuspb.Symbol.trade_start:type_name -> google.protobuf.Timestamp
-	116, // 109: kabuspb.Symbol.trade_end:type_name -> google.protobuf.Timestamp
-	5,   // 110: kabuspb.Symbol.call_or_put:type_name -> kabuspb.CallPut
-	116, // 111: kabuspb.FirstQuote.time:type_name -> google.protobuf.Timestamp
-	85,  // 112: kabuspb.Orders.orders:type_name -> kabuspb.Order
-	7,   // 113: kabuspb.Order.state:type_name -> kabuspb.State
-	8,   // 114: kabuspb.Order.order_state:type_name -> kabuspb.OrderState
-	11,  // 115: kabuspb.Order.order_type:type_name -> kabuspb.OrderType
-	116, // 116: kabuspb.Order.receive_time:type_name -> google.protobuf.Timestamp
-	12,  // 117: kabuspb.Order.exchange:type_name -> kabuspb.OrderExchange
-	16,  // 118: kabuspb.Order.time_in_force:type_name -> kabuspb.TimeInForce
-	9,   // 119: kabuspb.Order.side:type_name -> kabuspb.Side
-	10,  // 120: kabuspb.Order.trade_type:type_name -> kabuspb.TradeType
-	13,  // 121: kabuspb.Order.account_type:type_name -> kabuspb.AccountType
-	14,  // 122: kabuspb.Order.delivery_type:type_name -> kabuspb.DeliveryType
-	116, // 123: kabuspb.Order.expire_day:type_name -> google.protobuf.Timestamp
-	15,  // 124: kabuspb.Order.margin_trade_type:type_name -> kabuspb.MarginTradeType
-	86,  // 125: kabuspb.Order.details:type_name -> kabuspb.OrderDetail
-	17,  // 126: kabuspb.OrderDetail.record_type:type_name -> kabuspb.RecordType
-	18,  // 127: kabuspb.OrderDetail.state:type_name -> kabuspb.OrderDetailState
-	116, // 128: kabuspb.OrderDetail.transact_time:type_name -> google.protobuf.Timestamp
-	11,  // 129: kabuspb.OrderDetail.order_type:type_name -> kabuspb.OrderType
-	116, // 130: kabuspb.OrderDetail.execution_day:type_name -> google.protobuf.Timestamp
-	116, // 131: kabuspb.OrderDetail.delivery_day:type_name -> google.protobuf.Timestamp
-	88,  // 132: kabuspb.Positions.positions:type_name -> kabuspb.Position
-	13,  // 133: kabuspb.Position.account_type:type_name -> kabuspb.AccountType
-	0,   // 134: kabuspb.Position.exchange:type_name -> kabuspb.Exchange
-	19,  // 135: kabuspb.Position.security_type:type_name -> kabuspb.SecurityType
-	116, // 136: kabuspb.Position.execution_day:type_name -> google.protobuf.Timestamp
-	9,   // 137: kabuspb.Position.side:type_name -> kabuspb.Side
-	116, // 138: kabuspb.Position.expire_day:type_name -> google.protobuf.Timestamp
-	15,  // 139: kabuspb.Position.margin_trade_type:type_name -> kabuspb.MarginTradeType
-	90,  // 140: kabuspb.RegisteredSymbols.symbols:type_name -> kabuspb.RegisterSymbol
-	0,   // 141: kabuspb.RegisterSymbol.exchange:type_name -> kabuspb.Exchange
-	21,  // 142: kabuspb.PriceRanking.type:type_name -> kabuspb.PriceRankingType
-	20,  // 143: kabuspb.PriceRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	92,  // 144: kabuspb.PriceRanking.ranking:type_name -> kabuspb.PriceRankingInfo
-	24,  // 145: kabuspb.PriceRankingInfo.trend:type_name -> kabuspb.RankingTrend
-	116, // 146: kabuspb.PriceRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
-	20,  // 147: kabuspb.TickRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	94,  // 148: kabuspb.TickRanking.ranking:type_name -> kabuspb.TickRankingInfo
-	24,  // 149: kabuspb.TickRankingInfo.trend:type_name -> kabuspb.RankingTrend
-	20,  // 150: kabuspb.VolumeRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	96,  // 151: kabuspb.VolumeRanking.ranking:type_name -> kabuspb.VolumeRankingInfo
-	24,  // 152: kabuspb.VolumeRankingInfo.trend:type_name -> kabuspb.RankingTrend
-	116, // 153: kabuspb.VolumeRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
-	20,  // 154: kabuspb.ValueRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	98,  // 155: kabuspb.ValueRanking.ranking:type_name -> kabuspb.ValueRankingInfo
-	24,  // 156: kabuspb.ValueRankingInfo.trend:type_name -> kabuspb.RankingTrend
-	116, // 157: kabuspb.ValueRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
-	22,  // 158: kabuspb.MarginRanking.type:type_name -> kabuspb.MarginRankingType
-	20,  // 159: kabuspb.MarginRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	100, // 160: kabuspb.MarginRanking.ranking:type_name -> kabuspb.MarginRankingInfo
-	23,  // 161: kabuspb.IndustryRanking.type:type_name -> kabuspb.IndustryRankingType
-	20,  // 162: kabuspb.IndustryRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
-	102, // 163: kabuspb.IndustryRanking.ranking:type_name -> kabuspb.IndustryRankingInfo
-	24,  // 164: kabuspb.IndustryRankingInfo.trend:type_name -> kabuspb.RankingTrend
-	116, // 165: kabuspb.IndustryRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
-	29,  // 166: kabuspb.ExchangeInfo.currency:type_name -> kabuspb.Currency
-	116, // 167: kabuspb.ExchangeInfo.time:type_name -> google.protobuf.Timestamp
-	110, // 168: kabuspb.Regulation.regulation_info_list:type_name -> kabuspb.RegulationInfo
-	30,  // 169: kabuspb.RegulationInfo.exchange:type_name -> kabuspb.RegulationExchange
-	31,  // 170: kabuspb.RegulationInfo.product:type_name -> kabuspb.RegulationProduct
-	32,  // 171: kabuspb.RegulationInfo.side:type_name -> kabuspb.RegulationSide
-	116, // 172: kabuspb.RegulationInfo.limit_start_day:type_name -> google.protobuf.Timestamp
-	116, // 173: kabuspb.RegulationInfo.limit_end_day:type_name -> google.protobuf.Timestamp
-	33,  // 174: kabuspb.RegulationInfo.level:type_name -> kabuspb.RegulationLevel
-	1,   // 175: kabuspb.PrimaryExchange.primary_exchange:type_name -> kabuspb.StockExchange
-	114, // 176: kabuspb.MarginPremium.general_margin:type_name -> kabuspb.MarginPremiumDetail
-	114, // 177: kabuspb.MarginPremium.day_trade:type_name -> kabuspb.MarginPremiumDetail
-	39,  // 178: kabuspb.MarginPremiumDetail.margin_premium_type:type_name -> kabuspb.MarginPremiumType
-	42,  // 179: kabuspb.KabusService.SendStockOrder:input_type -> kabuspb.SendStockOrderRequest
-	44,  // 180: kabuspb.KabusService.SendMarginOrder:input_type -> kabuspb.SendMarginOrderRequest
-	46,  // 181: kabuspb.KabusService.SendFutureOrder:input_type -> kabuspb.SendFutureOrderRequest
-	48,  // 182: kabuspb.KabusService.SendOptionOrder:input_type -> kabuspb.SendOptionOrderRequest
-	51,  // 183: kabuspb.KabusService.CancelOrder:input_type -> kabuspb.CancelOrderRequest
-	52,  // 184: kabuspb.KabusService.GetStockWallet:input_type -> kabuspb.GetStockWalletRequest
-	53,  // 185: kabuspb.KabusService.GetMarginWallet:input_type -> kabuspb.GetMarginWalletRequest
-	54,  // 186: kabuspb.KabusService.GetFutureWallet:input_type -> kabuspb.GetFutureWalletRequest
-	55,  // 187: kabuspb.KabusService.GetOptionWallet:input_type -> kabuspb.GetOptionWalletRequest
-	56,  // 188: kabuspb.KabusService.GetBoard:input_type -> kabuspb.GetBoardRequest
-	57,  // 189: kabuspb.KabusService.GetSymbol:input_type -> kabuspb.GetSymbolRequest
-	58,  // 190: kabuspb.KabusService.GetOrders:input_type -> kabuspb.GetOrdersRequest
-	59,  // 191: kabuspb.KabusService.GetPositions:input_type -> kabuspb.GetPositionsRequest
-	60,  // 192: kabuspb.KabusService.GetFutureSymbolCodeInfo:input_type -> kabuspb.GetFutureSymbolCodeInfoRequest
-	61,  // 193: kabuspb.KabusService.GetOptionSymbolCodeInfo:input_type -> kabuspb.GetOptionSymbolCodeInfoRequest
-	62,  // 194: kabuspb.KabusService.GetPriceRanking:input_type -> kabuspb.GetPriceRankingRequest
-	63,  // 195: kabuspb.KabusService.GetTickRanking:input_type -> kabuspb.GetTickRankingRequest
-	64,  // 196: kabuspb.KabusService.GetVolumeRanking:input_type -> kabuspb.GetVolumeRankingRequest
-	65,  // 197: kabuspb.KabusService.GetValueRanking:input_type -> kabuspb.GetValueRankingRequest
-	66,  // 198: kabuspb.KabusService.GetMarginRanking:input_type -> kabuspb.GetMarginRankingRequest
-	67,  // 199: kabuspb.KabusService.GetIndustryRanking:input_type -> kabuspb.GetIndustryRankingRequest
-	72,  // 200: kabuspb.KabusService.GetExchange:input_type -> kabuspb.GetExchangeRequest
-	73,  // 201: kabuspb.KabusService.GetRegulation:input_type -> kabuspb.GetRegulationRequest
-	74,  // 202: kabuspb.KabusService.GetPrimaryExchange:input_type -> kabuspb.GetPrimaryExchangeRequest
-	75,  // 203: kabuspb.KabusService.GetSoftLimit:input_type -> kabuspb.GetSoftLimitRequest
-	68,  // 204: kabuspb.KabusService.GetRegisteredSymbols:input_type -> kabuspb.GetRegisteredSymbolsRequest
-	69,  // 205: kabuspb.KabusService.RegisterSymbols:input_type -> kabuspb.RegisterSymbolsRequest
-	70,  // 206: kabuspb.KabusService.UnregisterSymbols:input_type -> kabuspb.UnregisterSymbolsRequest
-	71,  // 207: kabuspb.KabusService.UnregisterAllSymbols:input_type -> kabuspb.UnregisterAllSymbolsRequest
-	76,  // 208: kabuspb.KabusService.GetMarginPremium:input_type -> kabuspb.GetMarginPremiumRequest
-	77,  // 209: kabuspb.KabusService.GetBoardsStreaming:input_type -> kabuspb.GetBoardsStreamingRequest
-	103, // 210: kabuspb.KabusService.SendStockOrder:output_type -> kabuspb.OrderResponse
-	103, // 211: kabuspb.KabusService.SendMarginOrder:output_type -> kabuspb.OrderResponse
-	103, // 212: kabuspb.KabusService.SendFutureOrder:output_type -> kabuspb.OrderResponse
-	103, // 213: kabuspb.KabusService.SendOptionOrder:output_type -> kabuspb.OrderResponse
-	103, // 214: kabuspb.KabusService.CancelOrder:output_type -> kabuspb.OrderResponse
-	104, // 215: kabuspb.KabusService.GetStockWallet:output_type -> kabuspb.StockWallet
-	105, // 216: kabuspb.KabusService.GetMarginWallet:output_type -> kabuspb.MarginWallet
-	106, // 217: kabuspb.KabusService.GetFutureWallet:output_type -> kabuspb.FutureWallet
-	107, // 218: kabuspb.KabusService.GetOptionWallet:output_type -> kabuspb.OptionWallet
-	79,  // 219: kabuspb.KabusService.GetBoard:output_type -> kabuspb.Board
-	80,  // 220: kabuspb.KabusService.GetSymbol:output_type -> kabuspb.Symbol
-	84,  // 221: kabuspb.KabusService.GetOrders:output_type -> kabuspb.Orders
-	87,  // 222: kabuspb.KabusService.GetPositions:output_type -> kabuspb.Positions
-	81,  // 223: kabuspb.KabusService.GetFutureSymbolCodeInfo:output_type -> kabuspb.SymbolCodeInfo
-	81,  // 224: kabuspb.KabusService.GetOptionSymbolCodeInfo:output_type -> kabuspb.SymbolCodeInfo
-	91,  // 225: kabuspb.KabusService.GetPriceRanking:output_type -> kabuspb.PriceRanking
-	93,  // 226: kabuspb.KabusService.GetTickRanking:output_type -> kabuspb.TickRanking
-	95,  // 227: kabuspb.KabusService.GetVolumeRanking:output_type -> kabuspb.VolumeRanking
-	97,  // 228: kabuspb.KabusService.GetValueRanking:output_type -> kabuspb.ValueRanking
-	99,  // 229: kabuspb.KabusService.GetMarginRanking:output_type -> kabuspb.MarginRanking
-	101, // 230: kabuspb.KabusService.GetIndustryRanking:output_type -> kabuspb.IndustryRanking
-	108, // 231: kabuspb.KabusService.GetExchange:output_type -> kabuspb.ExchangeInfo
-	109, // 232: kabuspb.KabusService.GetRegulation:output_type -> kabuspb.Regulation
-	111, // 233: kabuspb.KabusService.GetPrimaryExchange:output_type -> kabuspb.PrimaryExchange
-	112, // 234: kabuspb.KabusService.GetSoftLimit:output_type -> kabuspb.SoftLimit
-	89,  // 235: kabuspb.KabusService.GetRegisteredSymbols:output_type -> kabuspb.RegisteredSymbols
-	89,  // 236: kabuspb.KabusService.RegisterSymbols:output_type -> kabuspb.RegisteredSymbols
-	89,  // 237: kabuspb.KabusService.UnregisterSymbols:output_type -> kabuspb.RegisteredSymbols
-	89,  // 238: kabuspb.KabusService.UnregisterAllSymbols:output_type -> kabuspb.RegisteredSymbols
-	113, // 239: kabuspb.KabusService.GetMarginPremium:output_type -> kabuspb.MarginPremium
-	79,  // 240: kabuspb.KabusService.GetBoardsStreaming:output_type -> kabuspb.Board
-	210, // [210:241] is the sub-list for method output_type
-	179, // [179:210] is the sub-list for method input_type
-	179, // [179:179] is the sub-list for extension type_name
-	179, // [179:179] is the sub-list for extension extendee
-	0,   // [0:179] is the sub-list for field type_name
+	156, // 55: kabuspb.GetOrderHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	156, // 56: kabuspb.GetOrderHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	6,   // 57: kabuspb.GetOrderHistoryRequest.product:type_name -> kabuspb.Product
+	8,   // 58: kabuspb.GetOrderHistoryRequest.state:type_name -> kabuspb.OrderState
+	6,   // 59: kabuspb.GetPositionsRequest.product:type_name -> kabuspb.Product
+	9,   // 60: kabuspb.GetPositionsRequest.side:type_name -> kabuspb.Side
+	4,   // 61: kabuspb.GetFutureSymbolCodeInfoRequest.future_code:type_name -> kabuspb.FutureCode
+	156, // 62: kabuspb.GetFutureSymbolCodeInfoRequest.derivative_month:type_name -> google.protobuf.Timestamp
+	156, // 63: kabuspb.GetOptionSymbolCodeInfoRequest.derivative_month:type_name -> google.protobuf.Timestamp
+	5,   // 64: kabuspb.GetOptionSymbolCodeInfoRequest.call_or_put:type_name -> kabuspb.CallPut
+	21,  // 65: kabuspb.GetPriceRankingRequest.ranking_type:type_name -> kabuspb.PriceRankingType
+	20,  // 66: kabuspb.GetPriceRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	20,  // 67: kabuspb.GetTickRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	20,  // 68: kabuspb.GetVolumeRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	20,  // 69: kabuspb.GetValueRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	22,  // 70: kabuspb.GetMarginRankingRequest.ranking_type:type_name -> kabuspb.MarginRankingType
+	20,  // 71: kabuspb.GetMarginRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	23,  // 72: kabuspb.GetIndustryRankingRequest.ranking_type:type_name -> kabuspb.IndustryRankingType
+	20,  // 73: kabuspb.GetIndustryRankingRequest.exchange_division:type_name -> kabuspb.ExchangeDivision
+	115, // 74: kabuspb.RegisterSymbolsRequest.symbols:type_name -> kabuspb.RegisterSymbol
+	115, // 75: kabuspb.UnregisterSymbolsRequest.symbols:type_name -> kabuspb.RegisterSymbol
+	29,  // 76: kabuspb.GetExchangeRequest.currency:type_name -> kabuspb.Currency
+	1,   // 77: kabuspb.GetRegulationRequest.exchange:type_name -> kabuspb.StockExchange
+	115, // 78: kabuspb.GetBoardsStreamingRequest.symbols:type_name -> kabuspb.RegisterSymbol
+	156, // 79: kabuspb.GetAuditLogsRequest.from:type_name -> google.protobuf.Timestamp
+	156, // 80: kabuspb.GetAuditLogsRequest.to:type_name -> google.protobuf.Timestamp
+	156, // 81: kabuspb.Token.expired_at:type_name -> google.protobuf.Timestamp
+	0,   // 82: kabuspb.Board.exchange:type_name -> kabuspb.Exchange
+	156, // 83: kabuspb.Board.current_price_time:type_name -> google.protobuf.Timestamp
+	156, // 84: kabuspb.Board.previous_close_time:type_name -> google.protobuf.Timestamp
+	156, // 85: kabuspb.Board.opening_price_time:type_name -> google.protobuf.Timestamp
+	156, // 86: kabuspb.Board.high_price_time:type_name -> google.protobuf.Timestamp
+	156, // 87: kabuspb.Board.low_price_time:type_name -> google.protobuf.Timestamp
+	156, // 88: kabuspb.Board.trading_volume_time:type_name -> google.protobuf.Timestamp
+	156, // 89: kabuspb.Board.bid_time:type_name -> google.protobuf.Timestamp
+	105, // 90: kabuspb.Board.sell1:type_name -> kabuspb.FirstQuote
+	106, // 91: kabuspb.Board.sell2:type_name -> kabuspb.Quote
+	106, // 92: kabuspb.Board.sell3:type_name -> kabuspb.Quote
+	106, // 93: kabuspb.Board.sell4:type_name -> kabuspb.Quote
+	106, // 94: kabuspb.Board.sell5:type_name -> kabuspb.Quote
+	106, // 95: kabuspb.Board.sell6:type_name -> kabuspb.Quote
+	106, // 96: kabuspb.Board.sell7:type_name -> kabuspb.Quote
+	106, // 97: kabuspb.Board.sell8:type_name -> kabuspb.Quote
+	106, // 98: kabuspb.Board.sell9:type_name -> kabuspb.Quote
+	106, // 99: kabuspb.Board.sell10:type_name -> kabuspb.Quote
+	156, // 100: kabuspb.Board.ask_time:type_name -> google.protobuf.Timestamp
+	105, // 101: kabuspb.Board.buy1:type_name -> kabuspb.FirstQuote
+	106, // 102: kabuspb.Board.buy2:type_name -> kabuspb.Quote
+	106, // 103: kabuspb.Board.buy3:type_name -> kabuspb.Quote
+	106, // 104: kabuspb.Board.buy4:type_name -> kabuspb.Quote
+	106, // 105: kabuspb.Board.buy5:type_name -> kabuspb.Quote
+	106, // 106: kabuspb.Board.buy6:type_name -> kabuspb.Quote
+	106, // 107: kabuspb.Board.buy7:type_name -> kabuspb.Quote
+	106, // 108: kabuspb.Board.buy8:type_name -> kabuspb.Quote
+	106, // 109: kabuspb.Board.buy9:type_name -> kabuspb.Quote
+	106, // 110: kabuspb.Board.buy10:type_name -> kabuspb.Quote
+	19,  // 111: kabuspb.Board.security_type:type_name -> kabuspb.SecurityType
+	102, // 112: kabuspb.Board.notice:type_name -> kabuspb.BoardStreamNotice
+	156, // 113: kabuspb.Board.received_at:type_name -> google.protobuf.Timestamp
+	48,  // 114: kabuspb.BoardStreamNotice.state:type_name -> kabuspb.BoardWebSocketState
+	156, // 115: kabuspb.BoardStreamNotice.disconnected_at:type_name -> google.protobuf.Timestamp
+	156, // 116: kabuspb.BoardStreamNotice.reconnected_at:type_name -> google.protobuf.Timestamp
+	0,   // 117: kabuspb.Symbol.exchange:type_name -> kabuspb.Exchange
+	156, // 118: kabuspb.Symbol.fiscal_year_end_basic:type_name -> google.protobuf.Timestamp
+	156, // 119: kabuspb.Symbol.derivative_month:type_name -> google.protobuf.Timestamp
+	156, // 120: kabuspb.Symbol.trade_start:type_name -> google.protobuf.Timestamp
+	156, // 121: kabuspb.Symbol.trade_end:type_name -> google.protobuf.Timestamp
+	5,   // 122: kabuspb.Symbol.call_or_put:type_name -> kabuspb.CallPut
+	156, // 123: kabuspb.FirstQuote.time:type_name -> google.protobuf.Timestamp
+	110, // 124: kabuspb.Orders.orders:type_name -> kabuspb.Order
+	109, // 125: kabuspb.OrderHistory.records:type_name -> kabuspb.OrderHistoryRecord
+	110, // 126: kabuspb.OrderHistoryRecord.order:type_name -> kabuspb.Order
+	6,   // 127: kabuspb.OrderHistoryRecord.product:type_name -> kabuspb.Product
+	156, // 128: kabuspb.OrderHistoryRecord.saved_at:type_name -> google.protobuf.Timestamp
+	7,   // 129: kabuspb.Order.state:type_name -> kabuspb.State
+	8,   // 130: kabuspb.Order.order_state:type_name -> kabuspb.OrderState
+	11,  // 131: kabuspb.Order.order_type:type_name -> kabuspb.OrderType
+	156, // 132: kabuspb.Order.receive_time:type_name -> google.protobuf.Timestamp
+	12,  // 133: kabuspb.Order.exchange:type_name -> kabuspb.OrderExchange
+	16,  // 134: kabuspb.Order.time_in_force:type_name -> kabuspb.TimeInForce
+	9,   // 135: kabuspb.Order.side:type_name -> kabuspb.Side
+	10,  // 136: kabuspb.Order.trade_type:type_name -> kabuspb.TradeType
+	13,  // 137: kabuspb.Order.account_type:type_name -> kabuspb.AccountType
+	14,  // 138: kabuspb.Order.delivery_type:type_name -> kabuspb.DeliveryType
+	156, // 139: kabuspb.Order.expire_day:type_name -> google.protobuf.Timestamp
+	15,  // 140: kabuspb.Order.margin_trade_type:type_name -> kabuspb.MarginTradeType
+	111, // 141: kabuspb.Order.details:type_name -> kabuspb.OrderDetail
+	17,  // 142: kabuspb.OrderDetail.record_type:type_name -> kabuspb.RecordType
+	18,  // 143: kabuspb.OrderDetail.state:type_name -> kabuspb.OrderDetailState
+	156, // 144: kabuspb.OrderDetail.transact_time:type_name -> google.protobuf.Timestamp
+	11,  // 145: kabuspb.OrderDetail.order_type:type_name -> kabuspb.OrderType
+	156, // 146: kabuspb.OrderDetail.execution_day:type_name -> google.protobuf.Timestamp
+	156, // 147: kabuspb.OrderDetail.delivery_day:type_name -> google.protobuf.Timestamp
+	113, // 148: kabuspb.Positions.positions:type_name -> kabuspb.Position
+	13,  // 149: kabuspb.Position.account_type:type_name -> kabuspb.AccountType
+	0,   // 150: kabuspb.Position.exchange:type_name -> kabuspb.Exchange
+	19,  // 151: kabuspb.Position.security_type:type_name -> kabuspb.SecurityType
+	156, // 152: kabuspb.Position.execution_day:type_name -> google.protobuf.Timestamp
+	9,   // 153: kabuspb.Position.side:type_name -> kabuspb.Side
+	156, // 154: kabuspb.Position.expire_day:type_name -> google.protobuf.Timestamp
+	15,  // 155: kabuspb.Position.margin_trade_type:type_name -> kabuspb.MarginTradeType
+	115, // 156: kabuspb.RegisteredSymbols.symbols:type_name -> kabuspb.RegisterSymbol
+	0,   // 157: kabuspb.RegisterSymbol.exchange:type_name -> kabuspb.Exchange
+	21,  // 158: kabuspb.PriceRanking.type:type_name -> kabuspb.PriceRankingType
+	20,  // 159: kabuspb.PriceRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	117, // 160: kabuspb.PriceRanking.ranking:type_name -> kabuspb.PriceRankingInfo
+	24,  // 161: kabuspb.PriceRankingInfo.trend:type_name -> kabuspb.RankingTrend
+	156, // 162: kabuspb.PriceRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
+	20,  // 163: kabuspb.TickRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	119, // 164: kabuspb.TickRanking.ranking:type_name -> kabuspb.TickRankingInfo
+	24,  // 165: kabuspb.TickRankingInfo.trend:type_name -> kabuspb.RankingTrend
+	20,  // 166: kabuspb.VolumeRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	121, // 167: kabuspb.VolumeRanking.ranking:type_name -> kabuspb.VolumeRankingInfo
+	24,  // 168: kabuspb.VolumeRankingInfo.trend:type_name -> kabuspb.RankingTrend
+	156, // 169: kabuspb.VolumeRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
+	20,  // 170: kabuspb.ValueRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	123, // 171: kabuspb.ValueRanking.ranking:type_name -> kabuspb.ValueRankingInfo
+	24,  // 172: kabuspb.ValueRankingInfo.trend:type_name -> kabuspb.RankingTrend
+	156, // 173: kabuspb.ValueRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
+	22,  // 174: kabuspb.MarginRanking.type:type_name -> kabuspb.MarginRankingType
+	20,  // 175: kabuspb.MarginRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	125, // 176: kabuspb.MarginRanking.ranking:type_name -> kabuspb.MarginRankingInfo
+	23,  // 177: kabuspb.IndustryRanking.type:type_name -> kabuspb.IndustryRankingType
+	20,  // 178: kabuspb.IndustryRanking.exchange_division:type_name -> kabuspb.ExchangeDivision
+	127, // 179: kabuspb.IndustryRanking.ranking:type_name -> kabuspb.IndustryRankingInfo
+	24,  // 180: kabuspb.IndustryRankingInfo.trend:type_name -> kabuspb.RankingTrend
+	156, // 181: kabuspb.IndustryRankingInfo.current_price_time:type_name -> google.protobuf.Timestamp
+	29,  // 182: kabuspb.ExchangeInfo.currency:type_name -> kabuspb.Currency
+	156, // 183: kabuspb.ExchangeInfo.time:type_name -> google.protobuf.Timestamp
+	135, // 184: kabuspb.Regulation.regulation_info_list:type_name -> kabuspb.RegulationInfo
+	30,  // 185: kabuspb.RegulationInfo.exchange:type_name -> kabuspb.RegulationExchange
+	31,  // 186: kabuspb.RegulationInfo.product:type_name -> kabuspb.RegulationProduct
+	32,  // 187: kabuspb.RegulationInfo.side:type_name -> kabuspb.RegulationSide
+	156, // 188: kabuspb.RegulationInfo.limit_start_day:type_name -> google.protobuf.Timestamp
+	156, // 189: kabuspb.RegulationInfo.limit_end_day:type_name -> google.protobuf.Timestamp
+	33,  // 190: kabuspb.RegulationInfo.level:type_name -> kabuspb.RegulationLevel
+	1,   // 191: kabuspb.PrimaryExchange.primary_exchange:type_name -> kabuspb.StockExchange
+	139, // 192: kabuspb.MarginPremium.general_margin:type_name -> kabuspb.MarginPremiumDetail
+	139, // 193: kabuspb.MarginPremium.day_trade:type_name -> kabuspb.MarginPremiumDetail
+	39,  // 194: kabuspb.MarginPremiumDetail.margin_premium_type:type_name -> kabuspb.MarginPremiumType
+	42,  // 195: kabuspb.RequestError.reason:type_name -> kabuspb.RequestErrorReason
+	44,  // 196: kabuspb.RiskRejection.reason:type_name -> kabuspb.RiskRejectionReason
+	143, // 197: kabuspb.ThrottleStatus.lanes:type_name -> kabuspb.ThrottleLaneStatus
+	40,  // 198: kabuspb.ThrottleLaneStatus.category:type_name -> kabuspb.ThrottleCategory
+	146, // 199: kabuspb.BoardStreamStatus.subscribers:type_name -> kabuspb.BoardSubscriberStatus
+	48,  // 200: kabuspb.BoardWebSocketStatus.state:type_name -> kabuspb.BoardWebSocketState
+	156, // 201: kabuspb.BoardWebSocketStatus.connected_at:type_name -> google.protobuf.Timestamp
+	156, // 202: kabuspb.BoardWebSocketStatus.disconnected_at:type_name -> google.protobuf.Timestamp
+	156, // 203: kabuspb.BoardWebSocketStatus.next_retry_at:type_name -> google.protobuf.Timestamp
+	148, // 204: kabuspb.Usages.usages:type_name -> kabuspb.Usage
+	40,  // 205: kabuspb.Usage.category:type_name -> kabuspb.ThrottleCategory
+	156, // 206: kabuspb.StationStatus.checked_at:type_name -> google.protobuf.Timestamp
+	156, // 207: kabuspb.StationStatus.token_expired_at:type_name -> google.protobuf.Timestamp
+	156, // 208: kabuspb.KillSwitchStatus.activated_at:type_name -> google.protobuf.Timestamp
+	155, // 209: kabuspb.KillSwitchStatus.cancels:type_name -> kabuspb.KillSwitchCancel
+	45,  // 210: kabuspb.OrderEvent.event_type:type_name -> kabuspb.OrderEventType
+	110, // 211: kabuspb.OrderEvent.order:type_name -> kabuspb.Order
+	111, // 212: kabuspb.OrderEvent.new_details:type_name -> kabuspb.OrderDetail
+	156, // 213: kabuspb.OrderEvent.detected_at:type_name -> google.protobuf.Timestamp
+	46,  // 214: kabuspb.PositionEvent.event_type:type_name -> kabuspb.PositionEventType
+	113, // 215: kabuspb.PositionEvent.position:type_name -> kabuspb.Position
+	113, // 216: kabuspb.PositionEvent.previous:type_name -> kabuspb.Position
+	156, // 217: kabuspb.PositionEvent.detected_at:type_name -> google.protobuf.Timestamp
+	154, // 218: kabuspb.AuditLogs.logs:type_name -> kabuspb.AuditLog
+	156, // 219: kabuspb.AuditLog.requested_at:type_name -> google.protobuf.Timestamp
+	156, // 220: kabuspb.AuditLog.responded_at:type_name -> google.protobuf.Timestamp
+	49,  // 221: kabuspb.AuditLog.log_type:type_name -> kabuspb.AuditLogType
+	52,  // 222: kabuspb.KabusService.SendStockOrder:input_type -> kabuspb.SendStockOrderRequest
+	54,  // 223: kabuspb.KabusService.SendMarginOrder:input_type -> kabuspb.SendMarginOrderRequest
+	56,  // 224: kabuspb.KabusService.SendFutureOrder:input_type -> kabuspb.SendFutureOrderRequest
+	58,  // 225: kabuspb.KabusService.SendOptionOrder:input_type -> kabuspb.SendOptionOrderRequest
+	61,  // 226: kabuspb.KabusService.CancelOrder:input_type -> kabuspb.CancelOrderRequest
+	62,  // 227: kabuspb.KabusService.GetStockWallet:input_type -> kabuspb.GetStockWalletRequest
+	63,  // 228: kabuspb.KabusService.GetMarginWallet:input_type -> kabuspb.GetMarginWalletRequest
+	64,  // 229: kabuspb.KabusService.GetFutureWallet:input_type -> kabuspb.GetFutureWalletRequest
+	65,  // 230: kabuspb.KabusService.GetOptionWallet:input_type -> kabuspb.GetOptionWalletRequest
+	66,  // 231: kabuspb.KabusService.GetBoard:input_type -> kabuspb.GetBoardRequest
+	67,  // 232: kabuspb.KabusService.GetSymbol:input_type -> kabuspb.GetSymbolRequest
+	68,  // 233: kabuspb.KabusService.GetOrders:input_type -> kabuspb.GetOrdersRequest
+	69,  // 234: kabuspb.KabusService.GetOrderHistory:input_type -> kabuspb.GetOrderHistoryRequest
+	70,  // 235: kabuspb.KabusService.GetPositions:input_type -> kabuspb.GetPositionsRequest
+	71,  // 236: kabuspb.KabusService.GetFutureSymbolCodeInfo:input_type -> kabuspb.GetFutureSymbolCodeInfoRequest
+	72,  // 237: kabuspb.KabusService.GetOptionSymbolCodeInfo:input_type -> kabuspb.GetOptionSymbolCodeInfoRequest
+	73,  // 238: kabuspb.KabusService.GetPriceRanking:input_type -> kabuspb.GetPriceRankingRequest
+	74,  // 239: kabuspb.KabusService.GetTickRanking:input_type -> kabuspb.GetTickRankingRequest
+	75,  // 240: kabuspb.KabusService.GetVolumeRanking:input_type -> kabuspb.GetVolumeRankingRequest
+	76,  // 241: kabuspb.KabusService.GetValueRanking:input_type -> kabuspb.GetValueRankingRequest
+	77,  // 242: kabuspb.KabusService.GetMarginRanking:input_type -> kabuspb.GetMarginRankingRequest
+	78,  // 243: kabuspb.KabusService.GetIndustryRanking:input_type -> kabuspb.GetIndustryRankingRequest
+	83,  // 244: kabuspb.KabusService.GetExchange:input_type -> kabuspb.GetExchangeRequest
+	84,  // 245: kabuspb.KabusService.GetRegulation:input_type -> kabuspb.GetRegulationRequest
+	85,  // 246: kabuspb.KabusService.GetPrimaryExchange:input_type -> kabuspb.GetPrimaryExchangeRequest
+	86,  // 247: kabuspb.KabusService.GetSoftLimit:input_type -> kabuspb.GetSoftLimitRequest
+	79,  // 248: kabuspb.KabusService.GetRegisteredSymbols:input_type -> kabuspb.GetRegisteredSymbolsRequest
+	80,  // 249: kabuspb.KabusService.RegisterSymbols:input_type -> kabuspb.RegisterSymbolsRequest
+	81,  // 250: kabuspb.KabusService.UnregisterSymbols:input_type -> kabuspb.UnregisterSymbolsRequest
+	82,  // 251: kabuspb.KabusService.UnregisterAllSymbols:input_type -> kabuspb.UnregisterAllSymbolsRequest
+	87,  // 252: kabuspb.KabusService.GetMarginPremium:input_type -> kabuspb.GetMarginPremiumRequest
+	91,  // 253: kabuspb.KabusService.GetThrottleStatus:input_type -> kabuspb.GetThrottleStatusRequest
+	92,  // 254: kabuspb.KabusService.GetBoardStreamStatus:input_type -> kabuspb.GetBoardStreamStatusRequest
+	93,  // 255: kabuspb.KabusService.GetBoardWebSocketStatus:input_type -> kabuspb.GetBoardWebSocketStatusRequest
+	94,  // 256: kabuspb.KabusService.GetUsage:input_type -> kabuspb.GetUsageRequest
+	50,  // 257: kabuspb.KabusService.GetToken:input_type -> kabuspb.GetTokenRequest
+	51,  // 258: kabuspb.KabusService.RefreshToken:input_type -> kabuspb.RefreshTokenRequest
+	95,  // 259: kabuspb.KabusService.GetStationStatus:input_type -> kabuspb.GetStationStatusRequest
+	96,  // 260: kabuspb.KabusService.ActivateKillSwitch:input_type -> kabuspb.ActivateKillSwitchRequest
+	97,  // 261: kabuspb.KabusService.DeactivateKillSwitch:input_type -> kabuspb.DeactivateKillSwitchRequest
+	98,  // 262: kabuspb.KabusService.GetKillSwitchStatus:input_type -> kabuspb.GetKillSwitchStatusRequest
+	99,  // 263: kabuspb.KabusService.GetAuditLogs:input_type -> kabuspb.GetAuditLogsRequest
+	88,  // 264: kabuspb.KabusService.GetBoardsStreaming:input_type -> kabuspb.GetBoardsStreamingRequest
+	89,  // 265: kabuspb.KabusService.GetOrderEventsStreaming:input_type -> kabuspb.GetOrderEventsStreamingRequest
+	90,  // 266: kabuspb.KabusService.GetPositionEventsStreaming:input_type -> kabuspb.GetPositionEventsStreamingRequest
+	128, // 267: kabuspb.KabusService.SendStockOrder:output_type -> kabuspb.OrderResponse
+	128, // 268: kabuspb.KabusService.SendMarginOrder:output_type -> kabuspb.OrderResponse
+	128, // 269: kabuspb.KabusService.SendFutureOrder:output_type -> kabuspb.OrderResponse
+	128, // 270: kabuspb.KabusService.SendOptionOrder:output_type -> kabuspb.OrderResponse
+	128, // 271: kabuspb.KabusService.CancelOrder:output_type -> kabuspb.OrderResponse
+	129, // 272: kabuspb.KabusService.GetStockWallet:output_type -> kabuspb.StockWallet
+	130, // 273: kabuspb.KabusService.GetMarginWallet:output_type -> kabuspb.MarginWallet
+	131, // 274: kabuspb.KabusService.GetFutureWallet:output_type -> kabuspb.FutureWallet
+	132, // 275: kabuspb.KabusService.GetOptionWallet:output_type -> kabuspb.OptionWallet
+	101, // 276: kabuspb.KabusService.GetBoard:output_type -> kabuspb.Board
+	103, // 277: kabuspb.KabusService.GetSymbol:output_type -> kabuspb.Symbol
+	107, // 278: kabuspb.KabusService.GetOrders:output_type -> kabuspb.Orders
+	108, // 279: kabuspb.KabusService.GetOrderHistory:output_type -> kabuspb.OrderHistory
+	112, // 280: kabuspb.KabusService.GetPositions:output_type -> kabuspb.Positions
+	104, // 281: kabuspb.KabusService.GetFutureSymbolCodeInfo:output_type -> kabuspb.SymbolCodeInfo
+	104, // 282: kabuspb.KabusService.GetOptionSymbolCodeInfo:output_type -> kabuspb.SymbolCodeInfo
+	116, // 283: kabuspb.KabusService.GetPriceRanking:output_type -> kabuspb.PriceRanking
+	118, // 284: kabuspb.KabusService.GetTickRanking:output_type -> kabuspb.TickRanking
+	120, // 285: kabuspb.KabusService.GetVolumeRanking:output_type -> kabuspb.VolumeRanking
+	122, // 286: kabuspb.KabusService.GetValueRanking:output_type -> kabuspb.ValueRanking
+	124, // 287: kabuspb.KabusService.GetMarginRanking:output_type -> kabuspb.MarginRanking
+	126, // 288: kabuspb.KabusService.GetIndustryRanking:output_type -> kabuspb.IndustryRanking
+	133, // 289: kabuspb.KabusService.GetExchange:output_type -> kabuspb.ExchangeInfo
+	134, // 290: kabuspb.KabusService.GetRegulation:output_type -> kabuspb.Regulation
+	136, // 291: kabuspb.KabusService.GetPrimaryExchange:output_type -> kabuspb.PrimaryExchange
+	137, // 292: kabuspb.KabusService.GetSoftLimit:output_type -> kabuspb.SoftLimit
+	114, // 293: kabuspb.KabusService.GetRegisteredSymbols:output_type -> kabuspb.RegisteredSymbols
+	114, // 294: kabuspb.KabusService.RegisterSymbols:output_type -> kabuspb.RegisteredSymbols
+	114, // 295: kabuspb.KabusService.UnregisterSymbols:output_type -> kabuspb.RegisteredSymbols
+	114, // 296: kabuspb.KabusService.UnregisterAllSymbols:output_type -> kabuspb.RegisteredSymbols
+	138, // 297: kabuspb.KabusService.GetMarginPremium:output_type -> kabuspb.MarginPremium
+	142, // 298: kabuspb.KabusService.GetThrottleStatus:output_type -> kabuspb.ThrottleStatus
+	144, // 299: kabuspb.KabusService.GetBoardStreamStatus:output_type -> kabuspb.BoardStreamStatus
+	145, // 300: kabuspb.KabusService.GetBoardWebSocketStatus:output_type -> kabuspb.BoardWebSocketStatus
+	147, // 301: kabuspb.KabusService.GetUsage:output_type -> kabuspb.Usages
+	100, // 302: kabuspb.KabusService.GetToken:output_type -> kabuspb.Token
+	100, // 303: kabuspb.KabusService.RefreshToken:output_type -> kabuspb.Token
+	149, // 304: kabuspb.KabusService.GetStationStatus:output_type -> kabuspb.StationStatus
+	150, // 305: kabuspb.KabusService.ActivateKillSwitch:output_type -> kabuspb.KillSwitchStatus
+	150, // 306: kabuspb.KabusService.DeactivateKillSwitch:output_type -> kabuspb.KillSwitchStatus
+	150, // 307: kabuspb.KabusService.GetKillSwitchStatus:output_type -> kabuspb.KillSwitchStatus
+	153, // 308: kabuspb.KabusService.GetAuditLogs:output_type -> kabuspb.AuditLogs
+	101, // 309: kabuspb.KabusService.GetBoardsStreaming:output_type -> kabuspb.Board
+	151, // 310: kabuspb.KabusService.GetOrderEventsStreaming:output_type -> kabuspb.OrderEvent
+	152, // 311: kabuspb.KabusService.GetPositionEventsStreaming:output_type -> kabuspb.PositionEvent
+	267, // [267:312] is the sub-list for method output_type
+	222, // [222:267] is the sub-list for method input_type
+	222, // [222:222] is the sub-list for extension type_name
+	222, // [222:222] is the sub-list for extension extendee
+	0,   // [0:222] is the sub-list for field type_name
 }
 
 func init() { file_kabuspb_kabus_proto_init() }
@@ -11633,8 +15145,356 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendStockOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StockStopOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMarginOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginStopOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFutureOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FutureStopOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendOptionOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionStopOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStockWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarginWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFutureWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOptionWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSymbolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFutureSymbolCodeInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOptionSymbolCodeInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTickRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValueRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarginRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIndustryRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegisteredSymbolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11645,8 +15505,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendStockOrderRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11657,8 +15517,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StockStopOrder); i {
+		file_kabuspb_kabus_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnregisterAllSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11669,8 +15529,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMarginOrderRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExchangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11681,8 +15541,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginStopOrder); i {
+		file_kabuspb_kabus_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegulationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11693,8 +15553,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFutureOrderRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrimaryExchangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11705,8 +15565,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FutureStopOrder); i {
+		file_kabuspb_kabus_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSoftLimitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11717,8 +15577,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendOptionOrderRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMarginPremiumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11729,8 +15589,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptionStopOrder); i {
+		file_kabuspb_kabus_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardsStreamingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11741,8 +15601,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClosePosition); i {
+		file_kabuspb_kabus_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderEventsStreamingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11753,8 +15613,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelOrderRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPositionEventsStreamingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11765,8 +15625,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStockWalletRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThrottleStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11777,8 +15637,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarginWalletRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardStreamStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11789,8 +15649,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFutureWalletRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardWebSocketStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11801,8 +15661,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOptionWalletRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11813,8 +15673,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11825,8 +15685,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSymbolRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateKillSwitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11837,8 +15697,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOrdersRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeactivateKillSwitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11849,8 +15709,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPositionsRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKillSwitchStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11861,8 +15721,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFutureSymbolCodeInfoRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11873,8 +15733,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOptionSymbolCodeInfoRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Token); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11885,8 +15745,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPriceRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Board); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11897,8 +15757,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTickRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardStreamNotice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11909,8 +15769,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVolumeRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Symbol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11921,8 +15781,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValueRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolCodeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11933,8 +15793,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarginRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirstQuote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11945,8 +15805,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIndustryRankingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11957,8 +15817,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRegisteredSymbolsRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Orders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11969,8 +15829,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterSymbolsRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11981,8 +15841,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterSymbolsRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderHistoryRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11993,8 +15853,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterAllSymbolsRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12005,8 +15865,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExchangeRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12017,8 +15877,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRegulationRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Positions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12029,8 +15889,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPrimaryExchangeRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12041,8 +15901,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSoftLimitRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredSymbols); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12053,8 +15913,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarginPremiumRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterSymbol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12065,8 +15925,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardsStreamingRequest); i {
+		file_kabuspb_kabus_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12077,8 +15937,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Token); i {
+		file_kabuspb_kabus_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12089,8 +15949,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Board); i {
+		file_kabuspb_kabus_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12101,8 +15961,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Symbol); i {
+		file_kabuspb_kabus_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12113,8 +15973,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SymbolCodeInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12125,8 +15985,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FirstQuote); i {
+		file_kabuspb_kabus_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12137,8 +15997,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Quote); i {
+		file_kabuspb_kabus_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12149,8 +16009,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Orders); i {
+		file_kabuspb_kabus_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValueRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12161,8 +16021,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Order); i {
+		file_kabuspb_kabus_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12173,8 +16033,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderDetail); i {
+		file_kabuspb_kabus_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12185,8 +16045,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Positions); i {
+		file_kabuspb_kabus_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndustryRanking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12197,8 +16057,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Position); i {
+		file_kabuspb_kabus_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndustryRankingInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12209,8 +16069,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredSymbols); i {
+		file_kabuspb_kabus_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12221,8 +16081,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterSymbol); i {
+		file_kabuspb_kabus_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StockWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12233,8 +16093,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12245,8 +16105,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FutureWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12257,8 +16117,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TickRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionWallet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12269,8 +16129,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TickRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12281,8 +16141,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Regulation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12293,8 +16153,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VolumeRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegulationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12305,8 +16165,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValueRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrimaryExchange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12317,8 +16177,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValueRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12329,8 +16189,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginPremium); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12341,8 +16201,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarginPremiumDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12353,8 +16213,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndustryRanking); i {
+		file_kabuspb_kabus_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12365,8 +16225,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndustryRankingInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RiskRejection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12377,8 +16237,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderResponse); i {
+		file_kabuspb_kabus_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThrottleStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12389,8 +16249,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StockWallet); i {
+		file_kabuspb_kabus_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThrottleLaneStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12401,8 +16261,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginWallet); i {
+		file_kabuspb_kabus_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardStreamStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12413,8 +16273,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FutureWallet); i {
+		file_kabuspb_kabus_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardWebSocketStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12425,8 +16285,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptionWallet); i {
+		file_kabuspb_kabus_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardSubscriberStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12437,8 +16297,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Usages); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12449,8 +16309,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Regulation); i {
+		file_kabuspb_kabus_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12461,8 +16321,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegulationInfo); i {
+		file_kabuspb_kabus_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12473,8 +16333,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrimaryExchange); i {
+		file_kabuspb_kabus_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12485,8 +16345,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SoftLimit); i {
+		file_kabuspb_kabus_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12497,8 +16357,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginPremium); i {
+		file_kabuspb_kabus_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12509,8 +16369,8 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarginPremiumDetail); i {
+		file_kabuspb_kabus_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12521,8 +16381,20 @@ func file_kabuspb_kabus_proto_init() {
 				return nil
 			}
 		}
-		file_kabuspb_kabus_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestError); i {
+		file_kabuspb_kabus_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kabuspb_kabus_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchCancel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12539,8 +16411,8 @@ func file_kabuspb_kabus_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kabuspb_kabus_proto_rawDesc,
-			NumEnums:      40,
-			NumMessages:   76,
+			NumEnums:      50,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
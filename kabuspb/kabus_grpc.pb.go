@@ -30,6 +30,7 @@ type KabusServiceClient interface {
 	GetBoard(ctx context.Context, in *GetBoardRequest, opts ...grpc.CallOption) (*Board, error)
 	GetSymbol(ctx context.Context, in *GetSymbolRequest, opts ...grpc.CallOption) (*Symbol, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*Orders, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistory, error)
 	GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*Positions, error)
 	GetFutureSymbolCodeInfo(ctx context.Context, in *GetFutureSymbolCodeInfoRequest, opts ...grpc.CallOption) (*SymbolCodeInfo, error)
 	GetOptionSymbolCodeInfo(ctx context.Context, in *GetOptionSymbolCodeInfoRequest, opts ...grpc.CallOption) (*SymbolCodeInfo, error)
@@ -48,7 +49,20 @@ type KabusServiceClient interface {
 	UnregisterSymbols(ctx context.Context, in *UnregisterSymbolsRequest, opts ...grpc.CallOption) (*RegisteredSymbols, error)
 	UnregisterAllSymbols(ctx context.Context, in *UnregisterAllSymbolsRequest, opts ...grpc.CallOption) (*RegisteredSymbols, error)
 	GetMarginPremium(ctx context.Context, in *GetMarginPremiumRequest, opts ...grpc.CallOption) (*MarginPremium, error)
+	GetThrottleStatus(ctx context.Context, in *GetThrottleStatusRequest, opts ...grpc.CallOption) (*ThrottleStatus, error)
+	GetBoardStreamStatus(ctx context.Context, in *GetBoardStreamStatusRequest, opts ...grpc.CallOption) (*BoardStreamStatus, error)
+	GetBoardWebSocketStatus(ctx context.Context, in *GetBoardWebSocketStatusRequest, opts ...grpc.CallOption) (*BoardWebSocketStatus, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*Usages, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*Token, error)
+	GetStationStatus(ctx context.Context, in *GetStationStatusRequest, opts ...grpc.CallOption) (*StationStatus, error)
+	ActivateKillSwitch(ctx context.Context, in *ActivateKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error)
+	DeactivateKillSwitch(ctx context.Context, in *DeactivateKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error)
+	GetKillSwitchStatus(ctx context.Context, in *GetKillSwitchStatusRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error)
+	GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*AuditLogs, error)
 	GetBoardsStreaming(ctx context.Context, in *GetBoardsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetBoardsStreamingClient, error)
+	GetOrderEventsStreaming(ctx context.Context, in *GetOrderEventsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetOrderEventsStreamingClient, error)
+	GetPositionEventsStreaming(ctx context.Context, in *GetPositionEventsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetPositionEventsStreamingClient, error)
 }
 
 type kabusServiceClient struct {
@@ -167,6 +181,15 @@ func (c *kabusServiceClient) GetOrders(ctx context.Context, in *GetOrdersRequest
 	return out, nil
 }
 
+func (c *kabusServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*OrderHistory, error) {
+	out := new(OrderHistory)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kabusServiceClient) GetPositions(ctx context.Context, in *GetPositionsRequest, opts ...grpc.CallOption) (*Positions, error) {
 	out := new(Positions)
 	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetPositions", in, out, opts...)
@@ -329,6 +352,105 @@ func (c *kabusServiceClient) GetMarginPremium(ctx context.Context, in *GetMargin
 	return out, nil
 }
 
+func (c *kabusServiceClient) GetThrottleStatus(ctx context.Context, in *GetThrottleStatusRequest, opts ...grpc.CallOption) (*ThrottleStatus, error) {
+	out := new(ThrottleStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetThrottleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetBoardStreamStatus(ctx context.Context, in *GetBoardStreamStatusRequest, opts ...grpc.CallOption) (*BoardStreamStatus, error) {
+	out := new(BoardStreamStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetBoardStreamStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetBoardWebSocketStatus(ctx context.Context, in *GetBoardWebSocketStatusRequest, opts ...grpc.CallOption) (*BoardWebSocketStatus, error) {
+	out := new(BoardWebSocketStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetBoardWebSocketStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*Usages, error) {
+	out := new(Usages)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*Token, error) {
+	out := new(Token)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetStationStatus(ctx context.Context, in *GetStationStatusRequest, opts ...grpc.CallOption) (*StationStatus, error) {
+	out := new(StationStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetStationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) ActivateKillSwitch(ctx context.Context, in *ActivateKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error) {
+	out := new(KillSwitchStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/ActivateKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) DeactivateKillSwitch(ctx context.Context, in *DeactivateKillSwitchRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error) {
+	out := new(KillSwitchStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/DeactivateKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetKillSwitchStatus(ctx context.Context, in *GetKillSwitchStatusRequest, opts ...grpc.CallOption) (*KillSwitchStatus, error) {
+	out := new(KillSwitchStatus)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetKillSwitchStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kabusServiceClient) GetAuditLogs(ctx context.Context, in *GetAuditLogsRequest, opts ...grpc.CallOption) (*AuditLogs, error) {
+	out := new(AuditLogs)
+	err := c.cc.Invoke(ctx, "/kabuspb.KabusService/GetAuditLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kabusServiceClient) GetBoardsStreaming(ctx context.Context, in *GetBoardsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetBoardsStreamingClient, error) {
 	stream, err := c.cc.NewStream(ctx, &KabusService_ServiceDesc.Streams[0], "/kabuspb.KabusService/GetBoardsStreaming", opts...)
 	if err != nil {
@@ -361,6 +483,70 @@ func (x *kabusServiceGetBoardsStreamingClient) Recv() (*Board, error) {
 	return m, nil
 }
 
+func (c *kabusServiceClient) GetOrderEventsStreaming(ctx context.Context, in *GetOrderEventsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetOrderEventsStreamingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KabusService_ServiceDesc.Streams[1], "/kabuspb.KabusService/GetOrderEventsStreaming", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kabusServiceGetOrderEventsStreamingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KabusService_GetOrderEventsStreamingClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type kabusServiceGetOrderEventsStreamingClient struct {
+	grpc.ClientStream
+}
+
+func (x *kabusServiceGetOrderEventsStreamingClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kabusServiceClient) GetPositionEventsStreaming(ctx context.Context, in *GetPositionEventsStreamingRequest, opts ...grpc.CallOption) (KabusService_GetPositionEventsStreamingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KabusService_ServiceDesc.Streams[2], "/kabuspb.KabusService/GetPositionEventsStreaming", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kabusServiceGetPositionEventsStreamingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KabusService_GetPositionEventsStreamingClient interface {
+	Recv() (*PositionEvent, error)
+	grpc.ClientStream
+}
+
+type kabusServiceGetPositionEventsStreamingClient struct {
+	grpc.ClientStream
+}
+
+func (x *kabusServiceGetPositionEventsStreamingClient) Recv() (*PositionEvent, error) {
+	m := new(PositionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // KabusServiceServer is the server API for KabusService service.
 // All implementations must embed UnimplementedKabusServiceServer
 // for forward compatibility
@@ -377,6 +563,7 @@ type KabusServiceServer interface {
 	GetBoard(context.Context, *GetBoardRequest) (*Board, error)
 	GetSymbol(context.Context, *GetSymbolRequest) (*Symbol, error)
 	GetOrders(context.Context, *GetOrdersRequest) (*Orders, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*OrderHistory, error)
 	GetPositions(context.Context, *GetPositionsRequest) (*Positions, error)
 	GetFutureSymbolCodeInfo(context.Context, *GetFutureSymbolCodeInfoRequest) (*SymbolCodeInfo, error)
 	GetOptionSymbolCodeInfo(context.Context, *GetOptionSymbolCodeInfoRequest) (*SymbolCodeInfo, error)
@@ -395,7 +582,20 @@ type KabusServiceServer interface {
 	UnregisterSymbols(context.Context, *UnregisterSymbolsRequest) (*RegisteredSymbols, error)
 	UnregisterAllSymbols(context.Context, *UnregisterAllSymbolsRequest) (*RegisteredSymbols, error)
 	GetMarginPremium(context.Context, *GetMarginPremiumRequest) (*MarginPremium, error)
+	GetThrottleStatus(context.Context, *GetThrottleStatusRequest) (*ThrottleStatus, error)
+	GetBoardStreamStatus(context.Context, *GetBoardStreamStatusRequest) (*BoardStreamStatus, error)
+	GetBoardWebSocketStatus(context.Context, *GetBoardWebSocketStatusRequest) (*BoardWebSocketStatus, error)
+	GetUsage(context.Context, *GetUsageRequest) (*Usages, error)
+	GetToken(context.Context, *GetTokenRequest) (*Token, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*Token, error)
+	GetStationStatus(context.Context, *GetStationStatusRequest) (*StationStatus, error)
+	ActivateKillSwitch(context.Context, *ActivateKillSwitchRequest) (*KillSwitchStatus, error)
+	DeactivateKillSwitch(context.Context, *DeactivateKillSwitchRequest) (*KillSwitchStatus, error)
+	GetKillSwitchStatus(context.Context, *GetKillSwitchStatusRequest) (*KillSwitchStatus, error)
+	GetAuditLogs(context.Context, *GetAuditLogsRequest) (*AuditLogs, error)
 	GetBoardsStreaming(*GetBoardsStreamingRequest, KabusService_GetBoardsStreamingServer) error
+	GetOrderEventsStreaming(*GetOrderEventsStreamingRequest, KabusService_GetOrderEventsStreamingServer) error
+	GetPositionEventsStreaming(*GetPositionEventsStreamingRequest, KabusService_GetPositionEventsStreamingServer) error
 	mustEmbedUnimplementedKabusServiceServer()
 }
 
@@ -439,6 +639,9 @@ func (UnimplementedKabusServiceServer) GetSymbol(context.Context, *GetSymbolRequ
 func (UnimplementedKabusServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*Orders, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
+func (UnimplementedKabusServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*OrderHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
 func (UnimplementedKabusServiceServer) GetPositions(context.Context, *GetPositionsRequest) (*Positions, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPositions not implemented")
 }
@@ -493,9 +696,48 @@ func (UnimplementedKabusServiceServer) UnregisterAllSymbols(context.Context, *Un
 func (UnimplementedKabusServiceServer) GetMarginPremium(context.Context, *GetMarginPremiumRequest) (*MarginPremium, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMarginPremium not implemented")
 }
+func (UnimplementedKabusServiceServer) GetThrottleStatus(context.Context, *GetThrottleStatusRequest) (*ThrottleStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThrottleStatus not implemented")
+}
+func (UnimplementedKabusServiceServer) GetBoardStreamStatus(context.Context, *GetBoardStreamStatusRequest) (*BoardStreamStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardStreamStatus not implemented")
+}
+func (UnimplementedKabusServiceServer) GetBoardWebSocketStatus(context.Context, *GetBoardWebSocketStatusRequest) (*BoardWebSocketStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardWebSocketStatus not implemented")
+}
+func (UnimplementedKabusServiceServer) GetUsage(context.Context, *GetUsageRequest) (*Usages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedKabusServiceServer) GetToken(context.Context, *GetTokenRequest) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedKabusServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*Token, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedKabusServiceServer) GetStationStatus(context.Context, *GetStationStatusRequest) (*StationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationStatus not implemented")
+}
+func (UnimplementedKabusServiceServer) ActivateKillSwitch(context.Context, *ActivateKillSwitchRequest) (*KillSwitchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateKillSwitch not implemented")
+}
+func (UnimplementedKabusServiceServer) DeactivateKillSwitch(context.Context, *DeactivateKillSwitchRequest) (*KillSwitchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateKillSwitch not implemented")
+}
+func (UnimplementedKabusServiceServer) GetKillSwitchStatus(context.Context, *GetKillSwitchStatusRequest) (*KillSwitchStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKillSwitchStatus not implemented")
+}
+func (UnimplementedKabusServiceServer) GetAuditLogs(context.Context, *GetAuditLogsRequest) (*AuditLogs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogs not implemented")
+}
 func (UnimplementedKabusServiceServer) GetBoardsStreaming(*GetBoardsStreamingRequest, KabusService_GetBoardsStreamingServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetBoardsStreaming not implemented")
 }
+func (UnimplementedKabusServiceServer) GetOrderEventsStreaming(*GetOrderEventsStreamingRequest, KabusService_GetOrderEventsStreamingServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderEventsStreaming not implemented")
+}
+func (UnimplementedKabusServiceServer) GetPositionEventsStreaming(*GetPositionEventsStreamingRequest, KabusService_GetPositionEventsStreamingServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPositionEventsStreaming not implemented")
+}
 func (UnimplementedKabusServiceServer) mustEmbedUnimplementedKabusServiceServer() {}
 
 // UnsafeKabusServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -725,6 +967,24 @@ func _KabusService_GetOrders_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KabusService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KabusService_GetPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPositionsRequest)
 	if err := dec(in); err != nil {
@@ -1049,6 +1309,204 @@ func _KabusService_GetMarginPremium_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KabusService_GetThrottleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThrottleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetThrottleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetThrottleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetThrottleStatus(ctx, req.(*GetThrottleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetBoardStreamStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardStreamStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetBoardStreamStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetBoardStreamStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetBoardStreamStatus(ctx, req.(*GetBoardStreamStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetBoardWebSocketStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardWebSocketStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetBoardWebSocketStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetBoardWebSocketStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetBoardWebSocketStatus(ctx, req.(*GetBoardWebSocketStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetStationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetStationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetStationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetStationStatus(ctx, req.(*GetStationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_ActivateKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).ActivateKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/ActivateKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).ActivateKillSwitch(ctx, req.(*ActivateKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_DeactivateKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).DeactivateKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/DeactivateKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).DeactivateKillSwitch(ctx, req.(*DeactivateKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetKillSwitchStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKillSwitchStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetKillSwitchStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetKillSwitchStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetKillSwitchStatus(ctx, req.(*GetKillSwitchStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KabusService_GetAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KabusServiceServer).GetAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kabuspb.KabusService/GetAuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KabusServiceServer).GetAuditLogs(ctx, req.(*GetAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KabusService_GetBoardsStreaming_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetBoardsStreamingRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1070,6 +1528,48 @@ func (x *kabusServiceGetBoardsStreamingServer) Send(m *Board) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _KabusService_GetOrderEventsStreaming_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderEventsStreamingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KabusServiceServer).GetOrderEventsStreaming(m, &kabusServiceGetOrderEventsStreamingServer{stream})
+}
+
+type KabusService_GetOrderEventsStreamingServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type kabusServiceGetOrderEventsStreamingServer struct {
+	grpc.ServerStream
+}
+
+func (x *kabusServiceGetOrderEventsStreamingServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KabusService_GetPositionEventsStreaming_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPositionEventsStreamingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KabusServiceServer).GetPositionEventsStreaming(m, &kabusServiceGetPositionEventsStreamingServer{stream})
+}
+
+type KabusService_GetPositionEventsStreamingServer interface {
+	Send(*PositionEvent) error
+	grpc.ServerStream
+}
+
+type kabusServiceGetPositionEventsStreamingServer struct {
+	grpc.ServerStream
+}
+
+func (x *kabusServiceGetPositionEventsStreamingServer) Send(m *PositionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // KabusService_ServiceDesc is the grpc.ServiceDesc for KabusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1125,6 +1625,10 @@ var KabusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrders",
 			Handler:    _KabusService_GetOrders_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _KabusService_GetOrderHistory_Handler,
+		},
 		{
 			MethodName: "GetPositions",
 			Handler:    _KabusService_GetPositions_Handler,
@@ -1197,6 +1701,50 @@ var KabusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMarginPremium",
 			Handler:    _KabusService_GetMarginPremium_Handler,
 		},
+		{
+			MethodName: "GetThrottleStatus",
+			Handler:    _KabusService_GetThrottleStatus_Handler,
+		},
+		{
+			MethodName: "GetBoardStreamStatus",
+			Handler:    _KabusService_GetBoardStreamStatus_Handler,
+		},
+		{
+			MethodName: "GetBoardWebSocketStatus",
+			Handler:    _KabusService_GetBoardWebSocketStatus_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _KabusService_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _KabusService_GetToken_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _KabusService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "GetStationStatus",
+			Handler:    _KabusService_GetStationStatus_Handler,
+		},
+		{
+			MethodName: "ActivateKillSwitch",
+			Handler:    _KabusService_ActivateKillSwitch_Handler,
+		},
+		{
+			MethodName: "DeactivateKillSwitch",
+			Handler:    _KabusService_DeactivateKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetKillSwitchStatus",
+			Handler:    _KabusService_GetKillSwitchStatus_Handler,
+		},
+		{
+			MethodName: "GetAuditLogs",
+			Handler:    _KabusService_GetAuditLogs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1204,6 +1752,16 @@ var KabusService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _KabusService_GetBoardsStreaming_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetOrderEventsStreaming",
+			Handler:       _KabusService_GetOrderEventsStreaming_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetPositionEventsStreaming",
+			Handler:       _KabusService_GetPositionEventsStreaming_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "kabuspb/kabus.proto",
 }
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/services"
+)
+
+var (
+	permissionMarketData     = []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}
+	permissionAccount        = []kabuspb.Permission{kabuspb.Permission_PERMISSION_ACCOUNT}
+	permissionVirtualTrading = []kabuspb.Permission{kabuspb.Permission_PERMISSION_VIRTUAL_TRADING}
+	permissionAdmin          = []kabuspb.Permission{kabuspb.Permission_PERMISSION_ADMIN}
+	permissionAnyTrading     = []kabuspb.Permission{
+		kabuspb.Permission_PERMISSION_STOCK_TRADING,
+		kabuspb.Permission_PERMISSION_MARGIN_TRADING,
+		kabuspb.Permission_PERMISSION_FUTURE_TRADING,
+		kabuspb.Permission_PERMISSION_OPTION_TRADING,
+	}
+)
+
+// requiredPermissions - unaryの呼出しに必要な権限を返す、いずれかを持っていれば呼び出せる、知らないリクエストは管理者だけが呼び出せる
+func requiredPermissions(req interface{}) []kabuspb.Permission {
+	switch req := req.(type) {
+	case *kabuspb.SendStockOrderRequest:
+		if req.IsVirtual {
+			return permissionVirtualTrading
+		}
+		return []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}
+	case *kabuspb.SendMarginOrderRequest:
+		if req.IsVirtual {
+			return permissionVirtualTrading
+		}
+		return []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARGIN_TRADING}
+	case *kabuspb.SendFutureOrderRequest:
+		return []kabuspb.Permission{kabuspb.Permission_PERMISSION_FUTURE_TRADING}
+	case *kabuspb.SendOptionOrderRequest:
+		return []kabuspb.Permission{kabuspb.Permission_PERMISSION_OPTION_TRADING}
+	case *kabuspb.CancelOrderRequest:
+		if req.IsVirtual {
+			return permissionVirtualTrading
+		}
+		return permissionAnyTrading // 注文IDだけでは商品がわからないので、いずれかの発注の権限があれば取り消せる
+	case *kabuspb.GetOrdersRequest:
+		if req.IsVirtual {
+			return permissionVirtualTrading
+		}
+		return permissionAccount
+	case *kabuspb.GetPositionsRequest:
+		if req.IsVirtual {
+			return permissionVirtualTrading
+		}
+		return permissionAccount
+	case *kabuspb.GetStockWalletRequest, *kabuspb.GetMarginWalletRequest, *kabuspb.GetFutureWalletRequest, *kabuspb.GetOptionWalletRequest,
+		*kabuspb.GetSoftLimitRequest, *kabuspb.GetOrderHistoryRequest:
+		return permissionAccount
+	case *kabuspb.GetBoardRequest, *kabuspb.GetSymbolRequest, *kabuspb.GetFutureSymbolCodeInfoRequest, *kabuspb.GetOptionSymbolCodeInfoRequest,
+		*kabuspb.GetPriceRankingRequest, *kabuspb.GetTickRankingRequest, *kabuspb.GetVolumeRankingRequest, *kabuspb.GetValueRankingRequest,
+		*kabuspb.GetMarginRankingRequest, *kabuspb.GetIndustryRankingRequest, *kabuspb.GetExchangeRequest, *kabuspb.GetRegulationRequest,
+		*kabuspb.GetPrimaryExchangeRequest, *kabuspb.GetMarginPremiumRequest, *kabuspb.GetRegisteredSymbolsRequest,
+		*kabuspb.RegisterSymbolsRequest, *kabuspb.UnregisterSymbolsRequest, *kabuspb.UnregisterAllSymbolsRequest:
+		return permissionMarketData
+	case *kabuspb.GetThrottleStatusRequest, *kabuspb.GetUsageRequest, *kabuspb.GetStationStatusRequest, *kabuspb.GetKillSwitchStatusRequest,
+		*kabuspb.GetBoardStreamStatusRequest, *kabuspb.GetBoardWebSocketStatusRequest:
+		return nil // サーバーの状態は認証されていれば誰でも見られる
+	}
+	return permissionAdmin
+}
+
+// requiredStreamPermissions - streamの呼出しに必要な権限を返す、streamはハンドラに入るまでリクエストを読めないのでメソッド名で判断する
+func requiredStreamPermissions(fullMethod string) []kabuspb.Permission {
+	switch fullMethod {
+	case "/kabuspb.KabusService/GetBoardsStreaming":
+		return permissionMarketData
+	case "/kabuspb.KabusService/GetOrderEventsStreaming", "/kabuspb.KabusService/GetPositionEventsStreaming":
+		return permissionAccount
+	}
+	return permissionAdmin
+}
+
+// UnaryInterceptor - ツールを認証して、呼出しの権限を確認してからハンドラを呼ぶ、発注・取消の呼出しは拒否したものも含めて監査ログに記録する
+func (s *server) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	client, err := s.authorize(ctx, info.FullMethod, requiredPermissions(req))
+	if err != nil {
+		if isAuditTarget(req) {
+			var clientName string
+			if client != nil {
+				clientName = client.Name
+			}
+			s.auditService.Reject(info.FullMethod, clientName, requesterFromContext(ctx), req.(proto.Message), err)
+		}
+		return nil, err
+	}
+	ctx = contextWithClient(ctx, client)
+	if !isAuditTarget(req) {
+		return handler(ctx, req)
+	}
+
+	done := s.auditService.Begin(info.FullMethod, client.Name, requesterFromContext(ctx), req.(proto.Message))
+	res, err := handler(ctx, req)
+	msg, _ := res.(proto.Message)
+	done(msg, err)
+	return res, err
+}
+
+// StreamInterceptor - ツールを認証して、呼出しの権限を確認してからハンドラを呼ぶ
+func (s *server) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, err := s.authorize(ss.Context(), info.FullMethod, requiredStreamPermissions(info.FullMethod)); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authorize - ツールを認証して呼出しの権限を確認し、認証したツールを返す、権限がなければ監査ログに残せるように認証したツールも返す
+func (s *server) authorize(ctx context.Context, method string, permissions []kabuspb.Permission) (*services.AuthClient, error) {
+	client, err := s.authService.Authenticate(apiKeyFromContext(ctx), tlsSubjectFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authService.Authorize(client, method, permissions); err != nil {
+		return client, err
+	}
+	return client, nil
+}
+
+// authClientKey - 認証したツールをcontextに入れるときのキー
+type authClientKey struct{}
+
+// contextWithClient - 認証したツールを入れたcontextを返す
+func contextWithClient(ctx context.Context, client *services.AuthClient) context.Context {
+	return context.WithValue(ctx, authClientKey{}, client)
+}
+
+// clientFromContext - contextに入っている認証したツールを返す、なければnilを返す
+func clientFromContext(ctx context.Context) *services.AuthClient {
+	client, _ := ctx.Value(authClientKey{}).(*services.AuthClient)
+	return client
+}
+
+// ownerFromContext - 呼び出したツールの名前を返す、認証していればメタデータで他のツールを名乗れないように認証したツール名を返す
+func ownerFromContext(ctx context.Context) string {
+	if client := clientFromContext(ctx); client != nil && client.Name != "" {
+		return client.Name
+	}
+	return requesterFromContext(ctx)
+}
+
+// priorityOf - メタデータで指定されたリクエスト優先度を、認証していればツールに許された上限までに抑えて返す
+func (s *server) priorityOf(ctx context.Context) kabuspb.RequestPriority {
+	priority := priorityFromContext(ctx)
+	if client := clientFromContext(ctx); client != nil {
+		return s.authService.Priority(client, priority)
+	}
+	return priority
+}
+
+// isAuditTarget - 監査ログに記録する呼出しか
+func isAuditTarget(req interface{}) bool {
+	switch req.(type) {
+	case *kabuspb.SendStockOrderRequest, *kabuspb.SendMarginOrderRequest, *kabuspb.SendFutureOrderRequest, *kabuspb.SendOptionOrderRequest, *kabuspb.CancelOrderRequest:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/services"
+)
+
+func Test_requiredPermissions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		req  interface{}
+		want []kabuspb.Permission
+	}{
+		{name: "現物注文は現物の発注の権限が必要", req: &kabuspb.SendStockOrderRequest{}, want: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}},
+		{name: "仮想証券会社への現物注文は仮想証券会社の権限が必要", req: &kabuspb.SendStockOrderRequest{IsVirtual: true}, want: permissionVirtualTrading},
+		{name: "信用注文は信用の発注の権限が必要", req: &kabuspb.SendMarginOrderRequest{}, want: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARGIN_TRADING}},
+		{name: "先物注文は先物の発注の権限が必要", req: &kabuspb.SendFutureOrderRequest{}, want: []kabuspb.Permission{kabuspb.Permission_PERMISSION_FUTURE_TRADING}},
+		{name: "オプション注文はオプションの発注の権限が必要", req: &kabuspb.SendOptionOrderRequest{}, want: []kabuspb.Permission{kabuspb.Permission_PERMISSION_OPTION_TRADING}},
+		{name: "取消はいずれかの発注の権限が必要", req: &kabuspb.CancelOrderRequest{}, want: permissionAnyTrading},
+		{name: "仮想証券会社の取消は仮想証券会社の権限が必要", req: &kabuspb.CancelOrderRequest{IsVirtual: true}, want: permissionVirtualTrading},
+		{name: "注文一覧は口座情報の権限が必要", req: &kabuspb.GetOrdersRequest{}, want: permissionAccount},
+		{name: "仮想証券会社の残高一覧は仮想証券会社の権限が必要", req: &kabuspb.GetPositionsRequest{IsVirtual: true}, want: permissionVirtualTrading},
+		{name: "余力は口座情報の権限が必要", req: &kabuspb.GetMarginWalletRequest{}, want: permissionAccount},
+		{name: "注文履歴は口座情報の権限が必要", req: &kabuspb.GetOrderHistoryRequest{}, want: permissionAccount},
+		{name: "板情報は市場情報の権限が必要", req: &kabuspb.GetBoardRequest{}, want: permissionMarketData},
+		{name: "銘柄登録は市場情報の権限が必要", req: &kabuspb.RegisterSymbolsRequest{}, want: permissionMarketData},
+		{name: "サーバーの状態は権限が不要", req: &kabuspb.GetStationStatusRequest{}, want: nil},
+		{name: "緊急停止の状態は権限が不要", req: &kabuspb.GetKillSwitchStatusRequest{}, want: nil},
+		{name: "板情報ストリーミングの配信状況は権限が不要", req: &kabuspb.GetBoardStreamStatusRequest{}, want: nil},
+		{name: "板情報websocketの接続状態は権限が不要", req: &kabuspb.GetBoardWebSocketStatusRequest{}, want: nil},
+		{name: "トークンは管理者の権限が必要", req: &kabuspb.GetTokenRequest{}, want: permissionAdmin},
+		{name: "緊急停止は管理者の権限が必要", req: &kabuspb.ActivateKillSwitchRequest{}, want: permissionAdmin},
+		{name: "監査ログは管理者の権限が必要", req: &kabuspb.GetAuditLogsRequest{}, want: permissionAdmin},
+		{name: "知らないリクエストは管理者の権限が必要", req: "foo", want: permissionAdmin},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := requiredPermissions(test.req)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_requiredStreamPermissions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		fullMethod string
+		want       []kabuspb.Permission
+	}{
+		{name: "板情報のストリームは市場情報の権限が必要", fullMethod: "/kabuspb.KabusService/GetBoardsStreaming", want: permissionMarketData},
+		{name: "注文イベントのストリームは口座の権限が必要", fullMethod: "/kabuspb.KabusService/GetOrderEventsStreaming", want: permissionAccount},
+		{name: "建玉イベントのストリームは口座の権限が必要", fullMethod: "/kabuspb.KabusService/GetPositionEventsStreaming", want: permissionAccount},
+		{name: "知らないストリームは管理者の権限が必要", fullMethod: "/kabuspb.KabusService/Foo", want: permissionAdmin},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := requiredStreamPermissions(test.fullMethod)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_server_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		req         interface{}
+		authService *testAuthService
+		wantCalled  bool
+		wantCode    codes.Code
+		wantReject  []string
+	}{
+		{name: "認証できなければハンドラを呼ばずにエラーを返し、発注なら拒否を記録する",
+			req:         &kabuspb.SendStockOrderRequest{},
+			authService: &testAuthService{authenticate2: status.Error(codes.Unauthenticated, "unknown client")},
+			wantCode:    codes.Unauthenticated,
+			wantReject:  []string{"/kabuspb.KabusService/SendStockOrder", "", "tool"}},
+		{name: "権限がなければハンドラを呼ばずにエラーを返し、発注なら認証したツールと一緒に拒否を記録する",
+			req:         &kabuspb.SendStockOrderRequest{},
+			authService: &testAuthService{authenticate1: &services.AuthClient{Name: "screener"}, authorize: status.Error(codes.PermissionDenied, "denied")},
+			wantCode:    codes.PermissionDenied,
+			wantReject:  []string{"/kabuspb.KabusService/SendStockOrder", "screener", "tool"}},
+		{name: "発注・取消以外は拒否しても記録しない",
+			req:         &kabuspb.GetOrdersRequest{},
+			authService: &testAuthService{authenticate1: &services.AuthClient{Name: "screener"}, authorize: status.Error(codes.PermissionDenied, "denied")},
+			wantCode:    codes.PermissionDenied},
+		{name: "権限があればハンドラを呼ぶ",
+			req:         &kabuspb.SendStockOrderRequest{},
+			authService: &testAuthService{authenticate1: &services.AuthClient{Name: "trader"}},
+			wantCalled:  true,
+			wantCode:    codes.OK},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			auditService := &testAuditService{}
+			server := &server{authService: test.authService, auditService: auditService}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-api-key", "API_KEY", "kabus-requester", "tool"))
+			var called bool
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+			_, err := server.UnaryInterceptor(ctx, test.req, &grpc.UnaryServerInfo{FullMethod: "/kabuspb.KabusService/SendStockOrder"}, handler)
+			if called != test.wantCalled || status.Code(err) != test.wantCode || test.authService.lastAPIKey != "API_KEY" ||
+				!reflect.DeepEqual(test.wantReject, auditService.lastReject) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.wantCalled, test.wantCode, "API_KEY", test.wantReject, called, err, test.authService.lastAPIKey, auditService.lastReject)
+			}
+		})
+	}
+}
+
+func Test_server_UnaryInterceptor_audit(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		req           interface{}
+		res           interface{}
+		err           error
+		wantBegin     []string
+		wantResponses []interface{}
+		wantErrors    []error
+	}{
+		{name: "発注は結果と一緒に記録する",
+			req:           &kabuspb.SendStockOrderRequest{SymbolCode: "1320"},
+			res:           &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantBegin:     []string{"/kabuspb.KabusService/SendStockOrder", "trader", "tool"},
+			wantResponses: []interface{}{&kabuspb.OrderResponse{OrderId: "ORDER-ID"}},
+			wantErrors:    []error{nil}},
+		{name: "取消のエラーも記録する",
+			req:           &kabuspb.CancelOrderRequest{OrderId: "ORDER-ID"},
+			err:           errors.New("error message"),
+			wantBegin:     []string{"/kabuspb.KabusService/SendStockOrder", "trader", "tool"},
+			wantResponses: []interface{}{nil},
+			wantErrors:    []error{errors.New("error message")}},
+		{name: "発注・取消以外は記録しない", req: &kabuspb.GetOrdersRequest{}, res: &kabuspb.Orders{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			auditService := &testAuditService{}
+			server := &server{authService: &testAuthService{authenticate1: &services.AuthClient{Name: "trader"}}, auditService: auditService}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-requester", "tool"))
+			var begunBeforeHandler []string
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				begunBeforeHandler = auditService.lastBegin
+				return test.res, test.err
+			}
+			_, _ = server.UnaryInterceptor(ctx, test.req, &grpc.UnaryServerInfo{FullMethod: "/kabuspb.KabusService/SendStockOrder"}, handler)
+			if !reflect.DeepEqual(test.wantBegin, auditService.lastBegin) || !reflect.DeepEqual(test.wantResponses, auditService.responses) || !reflect.DeepEqual(test.wantErrors, auditService.errors) ||
+				!reflect.DeepEqual(test.wantBegin, begunBeforeHandler) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.wantBegin, test.wantResponses, test.wantErrors, test.wantBegin, auditService.lastBegin, auditService.responses, auditService.errors, begunBeforeHandler)
+			}
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (t *testServerStream) Context() context.Context { return t.ctx }
+
+func Test_server_StreamInterceptor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		authService *testAuthService
+		wantCalled  bool
+		hasError    bool
+	}{
+		{name: "権限がなければハンドラを呼ばずにエラーを返す",
+			authService: &testAuthService{authenticate1: &services.AuthClient{Name: "trader"}, authorize: errors.New("error message")},
+			hasError:    true},
+		{name: "権限があればハンドラを呼ぶ",
+			authService: &testAuthService{authenticate1: &services.AuthClient{Name: "screener"}},
+			wantCalled:  true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{authService: test.authService}
+			var called bool
+			handler := func(interface{}, grpc.ServerStream) error {
+				called = true
+				return nil
+			}
+			err := server.StreamInterceptor(nil, &testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/kabuspb.KabusService/GetBoardsStreaming"}, handler)
+			if called != test.wantCalled || (err != nil) != test.hasError || !reflect.DeepEqual(permissionMarketData, test.authService.lastPermissions) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantCalled, test.hasError, permissionMarketData, called, err, test.authService.lastPermissions)
+			}
+		})
+	}
+}
+
+func Test_server_wait_client(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		client        *services.AuthClient
+		wantRequester string
+		wantPriority  kabuspb.RequestPriority
+	}{
+		{name: "認証していなければメタデータのツール名と優先度を使う",
+			wantRequester: "tool", wantPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+		{name: "認証が不要な設定ならメタデータのツール名を使う", client: &services.AuthClient{},
+			wantRequester: "tool", wantPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW},
+		{name: "認証していれば認証したツール名と上限に抑えた優先度を使う", client: &services.AuthClient{Name: "screener"},
+			wantRequester: "screener", wantPriority: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			quotaService := &testQuotaService{}
+			throttleService := &testThrottleService{}
+			server := &server{
+				stationService:  &testStationService{},
+				quotaService:    quotaService,
+				throttleService: throttleService,
+				authService:     &testAuthService{priority: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW}}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-requester", "tool", "kabus-priority", "high"))
+			if test.client != nil {
+				ctx = contextWithClient(ctx, test.client)
+			}
+			_ = server.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO)
+			if test.wantRequester != quotaService.lastRequester || test.wantPriority != throttleService.lastPriority {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantRequester, test.wantPriority, quotaService.lastRequester, throttleService.lastPriority)
+			}
+		})
+	}
+}
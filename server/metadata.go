@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+const (
+	priorityMetadataKey  = "kabus-priority"  // リクエスト優先度
+	requesterMetadataKey = "kabus-requester" // ツール名
+	apiKeyMetadataKey    = "kabus-api-key"   // ツールのAPIキー
+)
+
+// requesterFromContext - メタデータで指定されたツール名を返す、指定がなければ空文字を返す
+func requesterFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, v := range md.Get(requesterMetadataKey) {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// priorityFromContext - メタデータで指定されたリクエスト優先度を返す、指定がないか解釈できなければ未指定を返す
+func priorityFromContext(ctx context.Context) kabuspb.RequestPriority {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED
+	}
+
+	for _, v := range md.Get(priorityMetadataKey) {
+		name := strings.ToUpper(strings.TrimSpace(v))
+		if !strings.HasPrefix(name, "REQUEST_PRIORITY_") {
+			name = "REQUEST_PRIORITY_" + name
+		}
+		if p, ok := kabuspb.RequestPriority_value[name]; ok {
+			return kabuspb.RequestPriority(p)
+		}
+	}
+	return kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED
+}
+
+// apiKeyFromContext - メタデータで指定されたAPIキーを返す、指定がなければ空文字を返す
+func apiKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, v := range md.Get(apiKeyMetadataKey) {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// tlsSubjectFromContext - 検証済みのクライアント証明書のCommonNameを返す、mTLSでなければ空文字を返す
+func tlsSubjectFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.CommonName
+}
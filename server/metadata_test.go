@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_priorityFromContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want kabuspb.RequestPriority
+	}{
+		{name: "メタデータがなければ未指定", ctx: context.Background(), want: kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED},
+		{name: "優先度の指定がなければ未指定", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar")), want: kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED},
+		{name: "enumの名前で指定できる", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-priority", "REQUEST_PRIORITY_HIGH")), want: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+		{name: "接頭辞を省略して小文字でも指定できる", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-priority", "low")), want: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW},
+		{name: "解釈できなければ未指定", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-priority", "urgent")), want: kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := priorityFromContext(test.ctx)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_requesterFromContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "メタデータがなければ空文字", ctx: context.Background(), want: ""},
+		{name: "ツール名の指定がなければ空文字", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar")), want: ""},
+		{name: "ツール名が指定されていればそれを返す", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-requester", " screener ")), want: "screener"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := requesterFromContext(test.ctx)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_apiKeyFromContext(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "メタデータがなければ空文字", ctx: context.Background(), want: ""},
+		{name: "APIキーの指定がなければ空文字", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar")), want: ""},
+		{name: "APIキーが指定されていればそれを返す", ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("kabus-api-key", " API_KEY ")), want: "API_KEY"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := apiKeyFromContext(test.ctx)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_tlsSubjectFromContext(t *testing.T) {
+	t.Parallel()
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 12345}
+	verified := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "trader"}}}}}}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "peerがなければ空文字", ctx: context.Background(), want: ""},
+		{name: "TLSでなければ空文字", ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), want: ""},
+		{name: "クライアント証明書が検証されていなければ空文字", ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: credentials.TLSInfo{}}), want: ""},
+		{name: "検証済みのクライアント証明書があればCommonNameを返す", ctx: peer.NewContext(context.Background(), &peer.Peer{Addr: addr, AuthInfo: verified}), want: "trader"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := tlsSubjectFromContext(test.ctx)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
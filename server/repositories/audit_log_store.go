@@ -0,0 +1,8 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type AuditLogStore interface {
+	Append(log *kabuspb.AuditLog) error
+	All() ([]*kabuspb.AuditLog, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+type BoardStore interface {
+	Save(board *kabuspb.Board, receivedAt time.Time)
+	Get(symbolCode string, exchange kabuspb.Exchange) (board *kabuspb.Board, receivedAt time.Time, ok bool)
+	All() []*kabuspb.Board
+}
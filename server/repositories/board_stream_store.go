@@ -5,6 +5,7 @@ import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 type BoardStreamStore interface {
 	HasStream() bool
 	All() map[int]kabuspb.KabusService_GetBoardsStreamingServer
-	Add(stream kabuspb.KabusService_GetBoardsStreamingServer, ch chan error)
+	Request(seq int) *kabuspb.GetBoardsStreamingRequest
+	Add(stream kabuspb.KabusService_GetBoardsStreamingServer, req *kabuspb.GetBoardsStreamingRequest, ch chan error) int
 	Remove(seq int, err error)
 }
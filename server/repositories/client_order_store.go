@@ -0,0 +1,9 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type ClientOrderStore interface {
+	Get(owner string, clientOrderID string) (*kabuspb.OrderResponse, bool)
+	GetClientOrderID(orderID string) (owner string, clientOrderID string, ok bool)
+	Save(owner string, clientOrderID string, res *kabuspb.OrderResponse)
+}
@@ -0,0 +1,8 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type KillSwitchStore interface {
+	Get() *kabuspb.KillSwitchStatus
+	Save(status *kabuspb.KillSwitchStatus) error
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type OrderEventStreamStore interface {
+	HasStream() bool
+	All() map[int]kabuspb.KabusService_GetOrderEventsStreamingServer
+	Add(stream kabuspb.KabusService_GetOrderEventsStreamingServer, ch chan error) int
+	Remove(seq int, err error)
+}
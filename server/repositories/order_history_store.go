@@ -0,0 +1,8 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type OrderHistoryStore interface {
+	Save(records []*kabuspb.OrderHistoryRecord) error
+	All() ([]*kabuspb.OrderHistoryRecord, error)
+}
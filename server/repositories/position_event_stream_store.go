@@ -0,0 +1,10 @@
+package repositories
+
+import "gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+
+type PositionEventStreamStore interface {
+	HasStream() bool
+	All() map[int]kabuspb.KabusService_GetPositionEventsStreamingServer
+	Add(stream kabuspb.KabusService_GetPositionEventsStreamingServer, ch chan error) int
+	Remove(seq int, err error)
+}
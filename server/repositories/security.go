@@ -37,5 +37,5 @@ type Security interface {
 	PrimaryExchange(ctx context.Context, token string, req *kabuspb.GetPrimaryExchangeRequest) (*kabuspb.PrimaryExchange, error)
 	SoftLimit(ctx context.Context, token string, req *kabuspb.GetSoftLimitRequest) (*kabuspb.SoftLimit, error)
 	MarginPremium(ctx context.Context, token string, req *kabuspb.GetMarginPremiumRequest) (*kabuspb.MarginPremium, error)
-	IsMissMatchApiKeyError(err error) bool
+	ErrorCode(err error) int
 }
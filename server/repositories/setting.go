@@ -1,6 +1,38 @@
 package repositories
 
+import (
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
 type Setting interface {
 	IsProduction() bool
 	Password() string
+	Quota(requester string, category kabuspb.ThrottleCategory) (perSecond int, perMinute int)
+	TokenRetryPolicy() (maxAttempts int, codes []int)
+	TokenResetTime() (hour int, minute int, location *time.Location)
+	ThrottleInterval(category kabuspb.ThrottleCategory) time.Duration
+	IsVirtualEnabled() bool
+	IsAuthRequired() bool
+	ClientByAPIKey(apiKey string) (name string, permissions []kabuspb.Permission, ok bool)
+	ClientByTLSSubject(subject string) (name string, permissions []kabuspb.Permission, ok bool)
+	ClientMaxPriority(name string) kabuspb.RequestPriority
+	RiskLimit() RiskLimit
+	KillSwitchFile() string
+	AuditLogFile() string
+	OrderHistoryFile() string
+	BoardStreamBuffer() (size int, policy kabuspb.BoardOverflowPolicy)
+}
+
+// RiskLimit - 発注前のリスクチェックの上限、0や空は制限しない
+type RiskLimit struct {
+	MaxQuantity            float64           // 1注文の数量の上限
+	MaxNotional            float64           // 1注文の代金(数量×価格)の上限
+	MaxDailyNotional       float64           // 1日に発注する代金の合計の上限
+	MaxOpenOrdersPerSymbol int               // 銘柄ごとの未約定の注文数の上限
+	AllowedSymbols         []string          // 発注できる銘柄コード
+	AllowedProducts        []kabuspb.Product // 発注できる商品
+	PriceBandPercent       float64           // 指値が現値から離れてもいい割合(%)
+	PriceLimitCheck        bool              // 指値が値幅制限の中にあるかを確認するか
 }
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/services"
+)
+
+// 発注の市場と情報の市場は同じ値なので、そのまま変換する
+
+func stockRiskOrder(req *kabuspb.SendStockOrderRequest) *services.RiskOrder {
+	return &services.RiskOrder{
+		Product:    kabuspb.Product_PRODUCT_STOCK,
+		SymbolCode: req.SymbolCode,
+		Exchange:   kabuspb.Exchange(req.Exchange),
+		Quantity:   req.Quantity,
+		Price:      req.Price,
+	}
+}
+
+func marginRiskOrder(req *kabuspb.SendMarginOrderRequest) *services.RiskOrder {
+	return &services.RiskOrder{
+		Product:    kabuspb.Product_PRODUCT_MARGIN,
+		SymbolCode: req.SymbolCode,
+		Exchange:   kabuspb.Exchange(req.Exchange),
+		Quantity:   req.Quantity,
+		Price:      req.Price,
+	}
+}
+
+func futureRiskOrder(req *kabuspb.SendFutureOrderRequest) *services.RiskOrder {
+	return &services.RiskOrder{
+		Product:    kabuspb.Product_PRODUCT_FUTURE,
+		SymbolCode: req.SymbolCode,
+		Exchange:   kabuspb.Exchange(req.Exchange),
+		Quantity:   req.Quantity,
+		Price:      req.Price,
+	}
+}
+
+func optionRiskOrder(req *kabuspb.SendOptionOrderRequest) *services.RiskOrder {
+	return &services.RiskOrder{
+		Product:    kabuspb.Product_PRODUCT_OPTION,
+		SymbolCode: req.SymbolCode,
+		Exchange:   kabuspb.Exchange(req.Exchange),
+		Quantity:   req.Quantity,
+		Price:      req.Price,
+	}
+}
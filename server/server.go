@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
-	"sync"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
@@ -16,16 +19,47 @@ func NewServer(
 	virtual repositories.VirtualSecurity,
 	tokenService services.TokenService,
 	registerSymbolService services.RegisterSymbolService,
-	boardStreamService services.BoardStreamService) kabuspb.KabusServiceServer {
+	boardStreamService services.BoardStreamService,
+	throttleService services.ThrottleService,
+	quotaService services.QuotaService,
+	stationService services.StationService,
+	authService services.AuthService,
+	riskService services.RiskService,
+	killSwitchService services.KillSwitchService,
+	clientOrderService services.ClientOrderService,
+	orderEventService services.OrderEventService,
+	positionService services.PositionService,
+	auditService services.AuditService,
+	orderHistoryService services.OrderHistoryService) Server {
 	return &server{
 		security:              security,
 		virtual:               virtual,
 		tokenService:          tokenService,
 		registerSymbolService: registerSymbolService,
 		boardStreamService:    boardStreamService,
+		throttleService:       throttleService,
+		quotaService:          quotaService,
+		stationService:        stationService,
+		authService:           authService,
+		riskService:           riskService,
+		killSwitchService:     killSwitchService,
+		clientOrderService:    clientOrderService,
+		orderEventService:     orderEventService,
+		positionService:       positionService,
+		auditService:          auditService,
+		orderHistoryService:   orderHistoryService,
 	}
 }
 
+// Server - kabusapiを中継するgRPCサーバー
+type Server interface {
+	kabuspb.KabusServiceServer
+	UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error)
+	StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error
+	CloseStreams()
+	Shutdown(ctx context.Context) error
+}
+
 type server struct {
 	kabuspb.UnimplementedKabusServiceServer
 	security              repositories.Security
@@ -33,369 +67,295 @@ type server struct {
 	tokenService          services.TokenService
 	registerSymbolService services.RegisterSymbolService
 	boardStreamService    services.BoardStreamService
-	orderMtx              sync.Mutex
-	walletMtx             sync.Mutex
-	infoMtx               sync.Mutex
+	throttleService       services.ThrottleService
+	quotaService          services.QuotaService
+	stationService        services.StationService
+	authService           services.AuthService
+	riskService           services.RiskService
+	killSwitchService     services.KillSwitchService
+	clientOrderService    services.ClientOrderService
+	orderEventService     services.OrderEventService
+	positionService       services.PositionService
+	auditService          services.AuditService
+	orderHistoryService   services.OrderHistoryService
 }
 
-func (s *server) SendStockOrder(ctx context.Context, req *kabuspb.SendStockOrderRequest) (*kabuspb.OrderResponse, error) {
-	s.orderMtx.Lock()
-	defer func() {
-		<-time.After(200 * time.Millisecond) // 0.2s
-		s.orderMtx.Unlock()
-	}()
-
-	// 仮想証券会社の利用
-	if req.IsVirtual {
-		return s.virtual.SendOrderStock(ctx, "", req)
+// wait - kabuステーションが利用可能か、ツールの利用枠があるかを確認してから、kabusapiの流量制限に合わせて自分の順番が来るまで待つ
+func (s *server) wait(ctx context.Context, category kabuspb.ThrottleCategory) error {
+	if err := s.admit(ctx, category); err != nil {
+		return err
 	}
+	return s.throttle(ctx, category)
+}
 
-	token, err := s.tokenService.GetToken(context.Background())
-	if err != nil {
-		return nil, err
+// admit - 接続先を確認して、呼び出し元のクォータを使う
+func (s *server) admit(ctx context.Context, category kabuspb.ThrottleCategory) error {
+	if err := s.stationService.Check(); err != nil {
+		return err
 	}
+	return s.quotaService.Use(ownerFromContext(ctx), category)
+}
 
-	res, err := s.security.SendOrderStock(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		res, err = s.security.SendOrderStock(ctx, token, req)
+// throttle - kabusapiの流量制限に合わせて順番を待つ
+func (s *server) throttle(ctx context.Context, category kabuspb.ThrottleCategory) error {
+	if err := s.throttleService.Wait(ctx, category, s.priorityOf(ctx)); err != nil {
+		return status.FromContextError(err).Err()
 	}
-	return res, err
+	return nil
 }
 
-func (s *server) SendMarginOrder(ctx context.Context, req *kabuspb.SendMarginOrderRequest) (*kabuspb.OrderResponse, error) {
-	s.orderMtx.Lock()
-	defer func() {
-		<-time.After(200 * time.Millisecond) // 0.2s
-		s.orderMtx.Unlock()
-	}()
-
-	// 仮想証券会社の利用
-	if req.IsVirtual {
-		return s.virtual.SendOrderMargin(ctx, "", req)
+// sendOrder - 緊急停止中でないこととリスクチェックを確認してから、kabusapiの流量制限に合わせて発注する、kabusapiに届かなかったか受け付けなかったらリスクチェックで予約した代金を戻す
+func (s *server) sendOrder(ctx context.Context, order *services.RiskOrder, send func(token string) (*kabuspb.OrderResponse, error)) (*kabuspb.OrderResponse, error) {
+	if err := s.killSwitchService.Check(); err != nil {
+		return nil, err
 	}
-
-	token, err := s.tokenService.GetToken(context.Background())
-	if err != nil {
+	// リスクチェックでもkabusapiを呼ぶので、クォータを使い切った呼び出し元にはリスクチェックもさせない
+	if err := s.admit(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER); err != nil {
 		return nil, err
 	}
-
-	res, err := s.security.SendOrderMargin(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		res, err = s.security.SendOrderMargin(ctx, token, req)
+	if err := s.riskService.Check(ctx, order); err != nil {
+		return nil, err
 	}
-	return res, err
-}
-
-func (s *server) SendFutureOrder(ctx context.Context, req *kabuspb.SendFutureOrderRequest) (*kabuspb.OrderResponse, error) {
-	s.orderMtx.Lock()
-	defer func() {
-		<-time.After(200 * time.Millisecond) // 0.2s
-		s.orderMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(context.Background())
-	if err != nil {
+	if err := s.throttle(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER); err != nil {
+		s.riskService.Release(order)
 		return nil, err
 	}
 
-	res, err := s.security.SendOrderFuture(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
+	var res *kabuspb.OrderResponse
+	var sent bool
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		// 順番待ちの間に緊急停止されているかもしれないので、送る直前にもう一度確認する
+		if err := s.killSwitchService.Check(); err != nil {
+			return err
 		}
-
-		res, err = s.security.SendOrderFuture(ctx, token, req)
+		sent = true
+		res, err = send(token)
+		return err
+	})
+	// 通信エラーなどでは注文が届いている可能性があるので、送っていないかkabusapiのエラーのときだけ戻す
+	if err != nil && (!sent || s.security.ErrorCode(err) != 0) {
+		s.riskService.Release(order)
+	}
+	// タイムアウトなどでkabusapiの結果を受け取れなかったら、同じクライアント注文IDで再送しても二重に発注しないようにする
+	if err != nil && sent && s.security.ErrorCode(err) == 0 {
+		return res, services.OrderResultUnknown(err)
 	}
 	return res, err
 }
 
-func (s *server) SendOptionOrder(ctx context.Context, req *kabuspb.SendOptionOrderRequest) (*kabuspb.OrderResponse, error) {
-	s.orderMtx.Lock()
-	defer func() {
-		<-time.After(200 * time.Millisecond) // 0.2s
-		s.orderMtx.Unlock()
-	}()
+func (s *server) SendStockOrder(ctx context.Context, req *kabuspb.SendStockOrderRequest) (*kabuspb.OrderResponse, error) {
+	return s.clientOrderService.Send(ownerFromContext(ctx), req.ClientOrderId, func() (*kabuspb.OrderResponse, error) {
+		// 仮想証券会社の利用、緊急停止で仮想証券会社だけにしているときも仮想証券会社に送る
+		if req.IsVirtual || s.killSwitchService.IsVirtualOnly() {
+			return s.virtual.SendOrderStock(ctx, "", req)
+		}
 
-	token, err := s.tokenService.GetToken(context.Background())
-	if err != nil {
-		return nil, err
-	}
+		return s.sendOrder(ctx, stockRiskOrder(req), func(token string) (*kabuspb.OrderResponse, error) {
+			return s.security.SendOrderStock(ctx, token, req)
+		})
+	})
+}
 
-	res, err := s.security.SendOrderOption(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
+func (s *server) SendMarginOrder(ctx context.Context, req *kabuspb.SendMarginOrderRequest) (*kabuspb.OrderResponse, error) {
+	return s.clientOrderService.Send(ownerFromContext(ctx), req.ClientOrderId, func() (*kabuspb.OrderResponse, error) {
+		// 仮想証券会社の利用、緊急停止で仮想証券会社だけにしているときも仮想証券会社に送る
+		if req.IsVirtual || s.killSwitchService.IsVirtualOnly() {
+			return s.virtual.SendOrderMargin(ctx, "", req)
 		}
 
-		res, err = s.security.SendOrderOption(ctx, token, req)
-	}
-	return res, err
+		return s.sendOrder(ctx, marginRiskOrder(req), func(token string) (*kabuspb.OrderResponse, error) {
+			return s.security.SendOrderMargin(ctx, token, req)
+		})
+	})
 }
 
-func (s *server) CancelOrder(ctx context.Context, req *kabuspb.CancelOrderRequest) (*kabuspb.OrderResponse, error) {
-	s.orderMtx.Lock()
-	defer func() {
-		<-time.After(200 * time.Millisecond) // 0.2s
-		s.orderMtx.Unlock()
-	}()
+func (s *server) SendFutureOrder(ctx context.Context, req *kabuspb.SendFutureOrderRequest) (*kabuspb.OrderResponse, error) {
+	return s.clientOrderService.Send(ownerFromContext(ctx), req.ClientOrderId, func() (*kabuspb.OrderResponse, error) {
+		return s.sendOrder(ctx, futureRiskOrder(req), func(token string) (*kabuspb.OrderResponse, error) {
+			return s.security.SendOrderFuture(ctx, token, req)
+		})
+	})
+}
 
+func (s *server) SendOptionOrder(ctx context.Context, req *kabuspb.SendOptionOrderRequest) (*kabuspb.OrderResponse, error) {
+	return s.clientOrderService.Send(ownerFromContext(ctx), req.ClientOrderId, func() (*kabuspb.OrderResponse, error) {
+		return s.sendOrder(ctx, optionRiskOrder(req), func(token string) (*kabuspb.OrderResponse, error) {
+			return s.security.SendOrderOption(ctx, token, req)
+		})
+	})
+}
+
+func (s *server) CancelOrder(ctx context.Context, req *kabuspb.CancelOrderRequest) (*kabuspb.OrderResponse, error) {
 	// 仮想証券会社の利用
 	if req.IsVirtual {
 		return s.virtual.CancelOrder(ctx, "", req)
 	}
 
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.CancelOrder(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.OrderResponse
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.CancelOrder(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetStockWallet(ctx context.Context, req *kabuspb.GetStockWalletRequest) (*kabuspb.StockWallet, error) {
-	s.walletMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.walletMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.GetStockWallet(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.StockWallet
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.GetStockWallet(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetMarginWallet(ctx context.Context, req *kabuspb.GetMarginWalletRequest) (*kabuspb.MarginWallet, error) {
-	s.walletMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.walletMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.GetMarginWallet(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.MarginWallet
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.GetMarginWallet(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetFutureWallet(ctx context.Context, req *kabuspb.GetFutureWalletRequest) (*kabuspb.FutureWallet, error) {
-	s.walletMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.walletMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.GetFutureWallet(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.FutureWallet
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.GetFutureWallet(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetOptionWallet(ctx context.Context, req *kabuspb.GetOptionWalletRequest) (*kabuspb.OptionWallet, error) {
-	s.walletMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.walletMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.GetOptionWallet(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.OptionWallet
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.GetOptionWallet(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetBoard(ctx context.Context, req *kabuspb.GetBoardRequest) (*kabuspb.Board, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
+	// 保持している板情報が許容する古さ以内ならそれを返す
+	if req.MaxAgeMs > 0 {
+		if res, ok := s.boardStreamService.Cached(req, time.Duration(req.MaxAgeMs)*time.Millisecond); ok {
+			return res, nil
+		}
+	}
 
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Board(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.Board
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Board(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetOrders(ctx context.Context, req *kabuspb.GetOrdersRequest) (*kabuspb.Orders, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
+	res, err := s.orders(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 発注時に指定されたクライアント注文IDを入れて、クライアント注文IDで絞り込む
+	res.Orders = s.clientOrderService.Attach(ownerFromContext(ctx), req.ClientOrderId, res.Orders)
+	return res, nil
+}
 
+func (s *server) orders(ctx context.Context, req *kabuspb.GetOrdersRequest) (*kabuspb.Orders, error) {
 	// 仮想証券会社の利用
 	if req.IsVirtual {
 		return s.virtual.Orders(ctx, "", req)
 	}
 
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Orders(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.Orders
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Orders(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
+func (s *server) GetOrderHistory(_ context.Context, req *kabuspb.GetOrderHistoryRequest) (*kabuspb.OrderHistory, error) {
+	return s.orderHistoryService.Find(req)
+}
+
 func (s *server) GetPositions(ctx context.Context, req *kabuspb.GetPositionsRequest) (*kabuspb.Positions, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
+	// 保持している建玉が許容する古さ以内ならそれを返す
+	if req.MaxAgeMs > 0 {
+		if res, ok := s.positionService.Cached(req, time.Duration(req.MaxAgeMs)*time.Millisecond); ok {
+			return res, nil
+		}
+	}
 
 	// 仮想証券会社の利用
 	if req.IsVirtual {
 		return s.virtual.Positions(ctx, "", req)
 	}
 
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Positions(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.Positions
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Positions(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetFutureSymbolCodeInfo(ctx context.Context, req *kabuspb.GetFutureSymbolCodeInfoRequest) (*kabuspb.SymbolCodeInfo, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.SymbolNameFuture(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.SymbolCodeInfo
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.SymbolNameFuture(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetOptionSymbolCodeInfo(ctx context.Context, req *kabuspb.GetOptionSymbolCodeInfoRequest) (*kabuspb.SymbolCodeInfo, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.SymbolNameOption(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.SymbolCodeInfo
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.SymbolNameOption(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
@@ -407,20 +367,10 @@ func (s *server) GetRegisteredSymbols(_ context.Context, req *kabuspb.GetRegiste
 }
 
 func (s *server) RegisterSymbols(ctx context.Context, req *kabuspb.RegisterSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.security.RegisterSymbols(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = s.security.RegisterSymbols(ctx, token, req)
-	}
+	err := s.tokenService.Do(ctx, func(token string) error {
+		_, err := s.security.RegisterSymbols(ctx, token, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -435,20 +385,10 @@ func (s *server) RegisterSymbols(ctx context.Context, req *kabuspb.RegisterSymbo
 }
 
 func (s *server) UnregisterSymbols(ctx context.Context, req *kabuspb.UnregisterSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.security.UnregisterSymbols(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = s.security.UnregisterSymbols(ctx, token, req)
-	}
+	err := s.tokenService.Do(ctx, func(token string) error {
+		_, err := s.security.UnregisterSymbols(ctx, token, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -463,20 +403,10 @@ func (s *server) UnregisterSymbols(ctx context.Context, req *kabuspb.UnregisterS
 }
 
 func (s *server) UnregisterAllSymbols(ctx context.Context, req *kabuspb.UnregisterAllSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = s.security.UnregisterAll(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		_, err = s.security.UnregisterAll(ctx, token, req)
-	}
+	err := s.tokenService.Do(ctx, func(token string) error {
+		_, err := s.security.UnregisterAll(ctx, token, req)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -489,293 +419,241 @@ func (s *server) UnregisterAllSymbols(ctx context.Context, req *kabuspb.Unregist
 }
 
 func (s *server) GetSymbol(ctx context.Context, req *kabuspb.GetSymbolRequest) (*kabuspb.Symbol, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Symbol(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.Symbol
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Symbol(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetPriceRanking(ctx context.Context, req *kabuspb.GetPriceRankingRequest) (*kabuspb.PriceRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.PriceRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.PriceRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.PriceRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetTickRanking(ctx context.Context, req *kabuspb.GetTickRankingRequest) (*kabuspb.TickRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.TickRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.TickRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.TickRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetVolumeRanking(ctx context.Context, req *kabuspb.GetVolumeRankingRequest) (*kabuspb.VolumeRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.VolumeRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.VolumeRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.VolumeRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetValueRanking(ctx context.Context, req *kabuspb.GetValueRankingRequest) (*kabuspb.ValueRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.ValueRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.ValueRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.ValueRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetMarginRanking(ctx context.Context, req *kabuspb.GetMarginRankingRequest) (*kabuspb.MarginRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.MarginRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.MarginRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.MarginRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetIndustryRanking(ctx context.Context, req *kabuspb.GetIndustryRankingRequest) (*kabuspb.IndustryRanking, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.IndustryRanking(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.IndustryRanking
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.IndustryRanking(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetExchange(ctx context.Context, req *kabuspb.GetExchangeRequest) (*kabuspb.ExchangeInfo, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Exchange(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.ExchangeInfo
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Exchange(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetRegulation(ctx context.Context, req *kabuspb.GetRegulationRequest) (*kabuspb.Regulation, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.Regulation(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.Regulation
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.Regulation(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetPrimaryExchange(ctx context.Context, req *kabuspb.GetPrimaryExchangeRequest) (*kabuspb.PrimaryExchange, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.PrimaryExchange(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.PrimaryExchange
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.PrimaryExchange(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetSoftLimit(ctx context.Context, req *kabuspb.GetSoftLimitRequest) (*kabuspb.SoftLimit, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
-
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
 		return nil, err
 	}
 
-	res, err := s.security.SoftLimit(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-
+	var res *kabuspb.SoftLimit
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
 		res, err = s.security.SoftLimit(ctx, token, req)
-	}
+		return err
+	})
 	return res, err
 }
 
 func (s *server) GetMarginPremium(ctx context.Context, req *kabuspb.GetMarginPremiumRequest) (*kabuspb.MarginPremium, error) {
-	s.infoMtx.Lock()
-	defer func() {
-		<-time.After(100 * time.Millisecond) // 0.1s
-		s.infoMtx.Unlock()
-	}()
+	if err := s.wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO); err != nil {
+		return nil, err
+	}
 
-	token, err := s.tokenService.GetToken(ctx)
-	if err != nil {
+	var res *kabuspb.MarginPremium
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		res, err = s.security.MarginPremium(ctx, token, req)
+		return err
+	})
+	return res, err
+}
+
+func (s *server) GetBoardsStreaming(req *kabuspb.GetBoardsStreamingRequest, stream kabuspb.KabusService_GetBoardsStreamingServer) error {
+	return s.boardStreamService.Connect(req, stream)
+}
+
+func (s *server) GetOrderEventsStreaming(_ *kabuspb.GetOrderEventsStreamingRequest, stream kabuspb.KabusService_GetOrderEventsStreamingServer) error {
+	return s.orderEventService.Connect(stream)
+}
+
+func (s *server) GetPositionEventsStreaming(_ *kabuspb.GetPositionEventsStreamingRequest, stream kabuspb.KabusService_GetPositionEventsStreamingServer) error {
+	return s.positionService.Connect(stream)
+}
+
+func (s *server) GetThrottleStatus(context.Context, *kabuspb.GetThrottleStatusRequest) (*kabuspb.ThrottleStatus, error) {
+	return s.throttleService.Status(), nil
+}
+
+func (s *server) GetBoardStreamStatus(context.Context, *kabuspb.GetBoardStreamStatusRequest) (*kabuspb.BoardStreamStatus, error) {
+	return s.boardStreamService.Status(), nil
+}
+
+func (s *server) GetBoardWebSocketStatus(context.Context, *kabuspb.GetBoardWebSocketStatusRequest) (*kabuspb.BoardWebSocketStatus, error) {
+	return s.boardStreamService.WebSocketStatus(), nil
+}
+
+func (s *server) GetUsage(_ context.Context, req *kabuspb.GetUsageRequest) (*kabuspb.Usages, error) {
+	return &kabuspb.Usages{Usages: s.quotaService.Usage(req.RequesterName)}, nil
+}
+
+// GetToken - トークンを取得して有効期限を返す、トークンがあればkabusapiを直接呼べてしまうのでトークン自体は返さない
+func (s *server) GetToken(ctx context.Context, _ *kabuspb.GetTokenRequest) (*kabuspb.Token, error) {
+	if _, err := s.tokenService.GetToken(ctx); err != nil {
 		return nil, err
 	}
+	return &kabuspb.Token{ExpiredAt: timestamppb.New(s.tokenService.GetExpiredAt())}, nil
+}
 
-	res, err := s.security.MarginPremium(ctx, token, req)
-	if s.security.IsMissMatchApiKeyError(err) { // APIキー不一致なら再発行して再実行
-		token, err = s.tokenService.Refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
+func (s *server) GetStationStatus(context.Context, *kabuspb.GetStationStatusRequest) (*kabuspb.StationStatus, error) {
+	return s.stationService.Status(), nil
+}
 
-		res, err = s.security.MarginPremium(ctx, token, req)
+// RefreshToken - トークンを取り直して有効期限を返す、GetTokenと同じくトークン自体は返さない
+func (s *server) RefreshToken(ctx context.Context, _ *kabuspb.RefreshTokenRequest) (*kabuspb.Token, error) {
+	if _, err := s.tokenService.Refresh(ctx); err != nil {
+		return nil, err
 	}
-	return res, err
+	return &kabuspb.Token{ExpiredAt: timestamppb.New(s.tokenService.GetExpiredAt())}, nil
+}
+
+func (s *server) ActivateKillSwitch(ctx context.Context, req *kabuspb.ActivateKillSwitchRequest) (*kabuspb.KillSwitchStatus, error) {
+	return s.killSwitchService.Activate(ctx, req)
+}
+
+func (s *server) DeactivateKillSwitch(context.Context, *kabuspb.DeactivateKillSwitchRequest) (*kabuspb.KillSwitchStatus, error) {
+	return s.killSwitchService.Deactivate()
+}
+
+func (s *server) GetKillSwitchStatus(context.Context, *kabuspb.GetKillSwitchStatusRequest) (*kabuspb.KillSwitchStatus, error) {
+	return s.killSwitchService.Status(), nil
 }
 
-func (s *server) GetBoardsStreaming(_ *kabuspb.GetBoardsStreamingRequest, stream kabuspb.KabusService_GetBoardsStreamingServer) error {
-	return s.boardStreamService.Connect(stream)
+func (s *server) GetAuditLogs(_ context.Context, req *kabuspb.GetAuditLogsRequest) (*kabuspb.AuditLogs, error) {
+	return s.auditService.Find(req)
+}
+
+// CloseStreams - 板情報・注文イベント・建玉イベントのストリームを全て終了してwebsocketの切断とポーリングの停止をする、ストリームが残っているとgRPCサーバーを止められないので先に呼ぶ
+func (s *server) CloseStreams() {
+	s.boardStreamService.Close()
+	s.orderEventService.Close()
+	s.positionService.Close()
+}
+
+// Shutdown - このサーバーで登録した銘柄をkabusapiから登録解除する、実行中の呼出しが終わってから呼ぶ
+func (s *server) Shutdown(ctx context.Context) error {
+	symbols := s.registerSymbolService.GetAll()
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	return s.tokenService.Do(ctx, func(token string) error {
+		_, err := s.security.UnregisterSymbols(ctx, token, &kabuspb.UnregisterSymbolsRequest{Symbols: symbols})
+		return err
+	})
 }
@@ -7,6 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/services"
@@ -18,72 +21,74 @@ import (
 
 type testSecurity struct {
 	repositories.Security
-	register1               *kabuspb.RegisteredSymbols
-	register2               error
-	unregister1             *kabuspb.RegisteredSymbols
-	unregister2             error
-	unregisterAll1          *kabuspb.RegisteredSymbols
-	unregisterAll2          error
-	symbolNameFuture1       *kabuspb.SymbolCodeInfo
-	symbolNameFuture2       error
-	symbolNameOption1       *kabuspb.SymbolCodeInfo
-	symbolNameOption2       error
-	board1                  *kabuspb.Board
-	board2                  error
-	symbol1                 *kabuspb.Symbol
-	symbol2                 error
-	orders1                 *kabuspb.Orders
-	orders2                 error
-	positions1              *kabuspb.Positions
-	positions2              error
-	priceRanking1           *kabuspb.PriceRanking
-	priceRanking2           error
-	tickRanking1            *kabuspb.TickRanking
-	tickRanking2            error
-	volumeRanking1          *kabuspb.VolumeRanking
-	volumeRanking2          error
-	valueRanking1           *kabuspb.ValueRanking
-	valueRanking2           error
-	marginRanking1          *kabuspb.MarginRanking
-	marginRanking2          error
-	industryRanking1        *kabuspb.IndustryRanking
-	industryRanking2        error
-	sendOrderStock1         *kabuspb.OrderResponse
-	sendOrderStock2         error
-	sendOrderMargin1        *kabuspb.OrderResponse
-	sendOrderMargin2        error
-	sendOrderFuture1        *kabuspb.OrderResponse
-	sendOrderFuture2        error
-	sendOrderOption1        *kabuspb.OrderResponse
-	sendOrderOption2        error
-	cancelOrder1            *kabuspb.OrderResponse
-	cancelOrder2            error
-	getStockWallet1         *kabuspb.StockWallet
-	getStockWallet2         error
-	getMarginWallet1        *kabuspb.MarginWallet
-	getMarginWallet2        error
-	getFutureWallet1        *kabuspb.FutureWallet
-	getFutureWallet2        error
-	getOptionWallet1        *kabuspb.OptionWallet
-	getOptionWallet2        error
-	exchange1               *kabuspb.ExchangeInfo
-	exchange2               error
-	regulation1             *kabuspb.Regulation
-	regulation2             error
-	primaryExchange1        *kabuspb.PrimaryExchange
-	primaryExchange2        error
-	softLimit1              *kabuspb.SoftLimit
-	softLimit2              error
-	marginPremium1          *kabuspb.MarginPremium
-	marginPremium2          error
-	isMissMatchApiKeyError1 bool
+	errorCode         int
+	register1         *kabuspb.RegisteredSymbols
+	register2         error
+	unregister1       *kabuspb.RegisteredSymbols
+	unregister2       error
+	lastUnregister    *kabuspb.UnregisterSymbolsRequest
+	unregisterAll1    *kabuspb.RegisteredSymbols
+	unregisterAll2    error
+	symbolNameFuture1 *kabuspb.SymbolCodeInfo
+	symbolNameFuture2 error
+	symbolNameOption1 *kabuspb.SymbolCodeInfo
+	symbolNameOption2 error
+	board1            *kabuspb.Board
+	board2            error
+	symbol1           *kabuspb.Symbol
+	symbol2           error
+	orders1           *kabuspb.Orders
+	orders2           error
+	positions1        *kabuspb.Positions
+	positions2        error
+	priceRanking1     *kabuspb.PriceRanking
+	priceRanking2     error
+	tickRanking1      *kabuspb.TickRanking
+	tickRanking2      error
+	volumeRanking1    *kabuspb.VolumeRanking
+	volumeRanking2    error
+	valueRanking1     *kabuspb.ValueRanking
+	valueRanking2     error
+	marginRanking1    *kabuspb.MarginRanking
+	marginRanking2    error
+	industryRanking1  *kabuspb.IndustryRanking
+	industryRanking2  error
+	sendOrderStock1   *kabuspb.OrderResponse
+	sendOrderStock2   error
+	sendOrderMargin1  *kabuspb.OrderResponse
+	sendOrderMargin2  error
+	sendOrderFuture1  *kabuspb.OrderResponse
+	sendOrderFuture2  error
+	sendOrderOption1  *kabuspb.OrderResponse
+	sendOrderOption2  error
+	cancelOrder1      *kabuspb.OrderResponse
+	cancelOrder2      error
+	getStockWallet1   *kabuspb.StockWallet
+	getStockWallet2   error
+	getMarginWallet1  *kabuspb.MarginWallet
+	getMarginWallet2  error
+	getFutureWallet1  *kabuspb.FutureWallet
+	getFutureWallet2  error
+	getOptionWallet1  *kabuspb.OptionWallet
+	getOptionWallet2  error
+	exchange1         *kabuspb.ExchangeInfo
+	exchange2         error
+	regulation1       *kabuspb.Regulation
+	regulation2       error
+	primaryExchange1  *kabuspb.PrimaryExchange
+	primaryExchange2  error
+	softLimit1        *kabuspb.SoftLimit
+	softLimit2        error
+	marginPremium1    *kabuspb.MarginPremium
+	marginPremium2    error
 }
 
 func (t *testSecurity) RegisterSymbols(context.Context, string, *kabuspb.RegisterSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
 	return t.register1, t.register2
 }
 
-func (t *testSecurity) UnregisterSymbols(context.Context, string, *kabuspb.UnregisterSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
+func (t *testSecurity) UnregisterSymbols(_ context.Context, _ string, req *kabuspb.UnregisterSymbolsRequest) (*kabuspb.RegisteredSymbols, error) {
+	t.lastUnregister = req
 	return t.unregister1, t.unregister2
 }
 
@@ -191,14 +196,12 @@ func (t *testSecurity) SoftLimit(context.Context, string, *kabuspb.GetSoftLimitR
 	return t.softLimit1, t.softLimit2
 }
 
+func (t *testSecurity) ErrorCode(error) int { return t.errorCode }
+
 func (t *testSecurity) MarginPremium(context.Context, string, *kabuspb.GetMarginPremiumRequest) (*kabuspb.MarginPremium, error) {
 	return t.marginPremium1, t.marginPremium2
 }
 
-func (t *testSecurity) IsMissMatchApiKeyError(error) bool {
-	return t.isMissMatchApiKeyError1
-}
-
 type testTokenService struct {
 	services.TokenService
 	getToken1    string
@@ -206,11 +209,25 @@ type testTokenService struct {
 	refresh1     string
 	refresh2     error
 	getExpiredAt time.Time
+	retry        bool // fのエラーを再実行の対象として扱う
 }
 
 func (t *testTokenService) GetToken(context.Context) (string, error) { return t.getToken1, t.getToken2 }
 func (t *testTokenService) GetExpiredAt() time.Time                  { return t.getExpiredAt }
 func (t *testTokenService) Refresh(context.Context) (string, error)  { return t.refresh1, t.refresh2 }
+func (t *testTokenService) Do(_ context.Context, f func(token string) error) error {
+	if t.getToken2 != nil {
+		return t.getToken2
+	}
+	err := f(t.getToken1)
+	if err != nil && t.retry {
+		if t.refresh2 != nil {
+			return t.refresh2
+		}
+		err = f(t.refresh1)
+	}
+	return err
+}
 
 type testRegisterSymbolService struct {
 	services.RegisterSymbolService
@@ -241,14 +258,221 @@ func (t *testRegisterSymbolService) Remove(requester string, symbols []*kabuspb.
 
 type testBoardStreamService struct {
 	services.BoardStreamService
-	connect error
+	connect    error
+	closeCount int
+	status     *kabuspb.BoardStreamStatus
+	wsStatus   *kabuspb.BoardWebSocketStatus
+	cached1    *kabuspb.Board
+	cached2    bool
+	lastMaxAge time.Duration
+}
+
+func (t *testBoardStreamService) Cached(_ *kabuspb.GetBoardRequest, maxAge time.Duration) (*kabuspb.Board, bool) {
+	t.lastMaxAge = maxAge
+	return t.cached1, t.cached2
 }
 
+func (t *testBoardStreamService) Status() *kabuspb.BoardStreamStatus { return t.status }
+func (t *testBoardStreamService) WebSocketStatus() *kabuspb.BoardWebSocketStatus {
+	return t.wsStatus
+}
+
+func (t *testBoardStreamService) Close() { t.closeCount++ }
 func (t *testBoardStreamService) Start() {}
-func (t *testBoardStreamService) Connect(kabuspb.KabusService_GetBoardsStreamingServer) error {
+func (t *testBoardStreamService) Connect(*kabuspb.GetBoardsStreamingRequest, kabuspb.KabusService_GetBoardsStreamingServer) error {
+	return t.connect
+}
+
+type testOrderEventService struct {
+	services.OrderEventService
+	connect    error
+	closeCount int
+}
+
+func (t *testOrderEventService) Close() { t.closeCount++ }
+func (t *testOrderEventService) Connect(kabuspb.KabusService_GetOrderEventsStreamingServer) error {
+	return t.connect
+}
+
+type testPositionService struct {
+	services.PositionService
+	cached1    *kabuspb.Positions
+	cached2    bool
+	lastMaxAge time.Duration
+	connect    error
+	closeCount int
+}
+
+func (t *testPositionService) Cached(_ *kabuspb.GetPositionsRequest, maxAge time.Duration) (*kabuspb.Positions, bool) {
+	t.lastMaxAge = maxAge
+	return t.cached1, t.cached2
+}
+func (t *testPositionService) Close() { t.closeCount++ }
+func (t *testPositionService) Connect(kabuspb.KabusService_GetPositionEventsStreamingServer) error {
 	return t.connect
 }
 
+type testAuditService struct {
+	services.AuditService
+	find1      *kabuspb.AuditLogs
+	find2      error
+	lastBegin  []string
+	responses  []interface{}
+	errors     []error
+	lastReject []string
+	rejected   []error
+}
+
+func (t *testAuditService) Begin(method string, clientName string, requester string, _ proto.Message) func(res proto.Message, err error) {
+	t.lastBegin = []string{method, clientName, requester}
+	return func(res proto.Message, err error) {
+		t.responses = append(t.responses, res)
+		t.errors = append(t.errors, err)
+	}
+}
+func (t *testAuditService) Reject(method string, clientName string, requester string, _ proto.Message, err error) {
+	t.lastReject = []string{method, clientName, requester}
+	t.rejected = append(t.rejected, err)
+}
+func (t *testAuditService) Find(*kabuspb.GetAuditLogsRequest) (*kabuspb.AuditLogs, error) {
+	return t.find1, t.find2
+}
+
+type testOrderHistoryService struct {
+	services.OrderHistoryService
+	find1 *kabuspb.OrderHistory
+	find2 error
+}
+
+func (t *testOrderHistoryService) Find(*kabuspb.GetOrderHistoryRequest) (*kabuspb.OrderHistory, error) {
+	return t.find1, t.find2
+}
+
+type testThrottleService struct {
+	services.ThrottleService
+	wait         error
+	status       *kabuspb.ThrottleStatus
+	lastPriority kabuspb.RequestPriority
+}
+
+func (t *testThrottleService) Wait(_ context.Context, _ kabuspb.ThrottleCategory, priority kabuspb.RequestPriority) error {
+	t.lastPriority = priority
+	return t.wait
+}
+func (t *testThrottleService) Status() *kabuspb.ThrottleStatus { return t.status }
+
+type testQuotaService struct {
+	services.QuotaService
+	use           error
+	usage         []*kabuspb.Usage
+	lastRequester string
+}
+
+func (t *testQuotaService) Use(requester string, _ kabuspb.ThrottleCategory) error {
+	t.lastRequester = requester
+	return t.use
+}
+func (t *testQuotaService) Usage(string) []*kabuspb.Usage { return t.usage }
+
+type testAuthService struct {
+	services.AuthService
+	authenticate1   *services.AuthClient
+	authenticate2   error
+	authorize       error
+	lastAPIKey      string
+	lastTLSSubject  string
+	lastPermissions []kabuspb.Permission
+	priority        kabuspb.RequestPriority
+}
+
+func (t *testAuthService) Priority(*services.AuthClient, kabuspb.RequestPriority) kabuspb.RequestPriority {
+	return t.priority
+}
+
+func (t *testAuthService) Authenticate(apiKey string, tlsSubject string) (*services.AuthClient, error) {
+	t.lastAPIKey = apiKey
+	t.lastTLSSubject = tlsSubject
+	return t.authenticate1, t.authenticate2
+}
+func (t *testAuthService) Authorize(_ *services.AuthClient, _ string, permissions []kabuspb.Permission) error {
+	t.lastPermissions = permissions
+	return t.authorize
+}
+
+type testRiskService struct {
+	services.RiskService
+	check        error
+	checkCount   int
+	releaseCount int
+}
+
+func (t *testRiskService) Check(context.Context, *services.RiskOrder) error {
+	t.checkCount++
+	return t.check
+}
+func (t *testRiskService) Release(*services.RiskOrder) { t.releaseCount++ }
+
+type testKillSwitchService struct {
+	services.KillSwitchService
+	activate1     *kabuspb.KillSwitchStatus
+	activate2     error
+	deactivate1   *kabuspb.KillSwitchStatus
+	deactivate2   error
+	status        *kabuspb.KillSwitchStatus
+	check         error
+	checks        []error // 呼ばれた順に返すCheckの結果、使い切ったらcheckを返す
+	isVirtualOnly bool
+	lastActivate  *kabuspb.ActivateKillSwitchRequest
+}
+
+func (t *testKillSwitchService) Activate(_ context.Context, req *kabuspb.ActivateKillSwitchRequest) (*kabuspb.KillSwitchStatus, error) {
+	t.lastActivate = req
+	return t.activate1, t.activate2
+}
+func (t *testKillSwitchService) Deactivate() (*kabuspb.KillSwitchStatus, error) {
+	return t.deactivate1, t.deactivate2
+}
+func (t *testKillSwitchService) Status() *kabuspb.KillSwitchStatus { return t.status }
+func (t *testKillSwitchService) Check() error {
+	if len(t.checks) > 0 {
+		err := t.checks[0]
+		t.checks = t.checks[1:]
+		return err
+	}
+	return t.check
+}
+func (t *testKillSwitchService) IsVirtualOnly() bool { return t.isVirtualOnly }
+
+type testClientOrderService struct {
+	services.ClientOrderService
+	attach            []*kabuspb.Order
+	lastOwner         string
+	lastClientOrderID string
+}
+
+func (t *testClientOrderService) Send(owner string, clientOrderID string, send func() (*kabuspb.OrderResponse, error)) (*kabuspb.OrderResponse, error) {
+	t.lastOwner = owner
+	t.lastClientOrderID = clientOrderID
+	return send()
+}
+func (t *testClientOrderService) Attach(owner string, clientOrderID string, orders []*kabuspb.Order) []*kabuspb.Order {
+	t.lastOwner = owner
+	t.lastClientOrderID = clientOrderID
+	if t.attach != nil {
+		return t.attach
+	}
+	return orders
+}
+
+type testStationService struct {
+	services.StationService
+	check  error
+	status *kabuspb.StationStatus
+}
+
+func (t *testStationService) Check() error                   { return t.check }
+func (t *testStationService) Status() *kabuspb.StationStatus { return t.status }
+
 type testVirtualSecurity struct {
 	repositories.VirtualSecurity
 	orders1          *kabuspb.Orders
@@ -285,8 +509,19 @@ func Test_NewServer(t *testing.T) {
 	tokenService := &testTokenService{}
 	registerSymbolService := &testRegisterSymbolService{}
 	boardStreamService := &testBoardStreamService{}
-	got := NewServer(security, virtual, tokenService, registerSymbolService, boardStreamService)
-	want := &server{security: security, virtual: virtual, tokenService: tokenService, registerSymbolService: registerSymbolService, boardStreamService: boardStreamService}
+	throttleService := &testThrottleService{}
+	quotaService := &testQuotaService{}
+	stationService := &testStationService{}
+	authService := &testAuthService{}
+	riskService := &testRiskService{}
+	killSwitchService := &testKillSwitchService{}
+	clientOrderService := &testClientOrderService{}
+	orderEventService := &testOrderEventService{}
+	positionService := &testPositionService{}
+	auditService := &testAuditService{}
+	orderHistoryService := &testOrderHistoryService{}
+	got := NewServer(security, virtual, tokenService, registerSymbolService, boardStreamService, throttleService, quotaService, stationService, authService, riskService, killSwitchService, clientOrderService, orderEventService, positionService, auditService, orderHistoryService)
+	want := &server{security: security, virtual: virtual, tokenService: tokenService, registerSymbolService: registerSymbolService, boardStreamService: boardStreamService, throttleService: throttleService, quotaService: quotaService, stationService: stationService, authService: authService, riskService: riskService, killSwitchService: killSwitchService, clientOrderService: clientOrderService, orderEventService: orderEventService, positionService: positionService, auditService: auditService, orderHistoryService: orderHistoryService}
 	t.Parallel()
 	if !reflect.DeepEqual(want, got) {
 		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
@@ -327,21 +562,21 @@ func Test_server_GetRegisteredSymbols(t *testing.T) {
 func Test_server_RegisterSymbols(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		register1               *kabuspb.RegisteredSymbols
-		register2               error
-		isMissMatchApiKeyError1 bool
-		countAll                int
-		get                     []*kabuspb.RegisterSymbol
-		arg                     *kabuspb.RegisterSymbolsRequest
-		want                    *kabuspb.RegisteredSymbols
-		hasError                bool
-		wantAdd1                string
-		wantAdd2                []*kabuspb.RegisterSymbol
+		name      string
+		getToken1 string
+		getToken2 error
+		refresh1  string
+		refresh2  error
+		register1 *kabuspb.RegisteredSymbols
+		register2 error
+		retry     bool
+		countAll  int
+		get       []*kabuspb.RegisterSymbol
+		arg       *kabuspb.RegisterSymbolsRequest
+		want      *kabuspb.RegisteredSymbols
+		hasError  bool
+		wantAdd1  string
+		wantAdd2  []*kabuspb.RegisterSymbol
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -351,17 +586,17 @@ func Test_server_RegisterSymbols(t *testing.T) {
 			register2: errors.New("register error message"),
 			hasError:  true},
 		{name: "RegisterのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			register2:               errors.New("miss match api key error message"),
-			refresh2:                errors.New("refresh error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			register2: errors.New("miss match api key error message"),
+			refresh2:  errors.New("refresh error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "RegisterのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			register2:               errors.New("miss match api key error message"),
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			register2: errors.New("miss match api key error message"),
+			refresh1:  "REFRESHED_TOKEN_STRING",
+			retry:     true,
+			hasError:  true},
 		{name: "リクエストをStoreに保存してから結果を返す",
 			getToken1: "TOKEN_STRING",
 			register1: &kabuspb.RegisteredSymbols{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}},
@@ -379,8 +614,11 @@ func Test_server_RegisterSymbols(t *testing.T) {
 			t.Parallel()
 			registerSymbolService := &testRegisterSymbolService{countAll: test.countAll, get: test.get}
 			server := &server{
-				security:              &testSecurity{register1: test.register1, register2: test.register2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService:          &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
+				stationService:        &testStationService{},
+				throttleService:       &testThrottleService{},
+				quotaService:          &testQuotaService{},
+				security:              &testSecurity{register1: test.register1, register2: test.register2},
+				tokenService:          &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
 				registerSymbolService: registerSymbolService,
 				boardStreamService:    &testBoardStreamService{}}
 			got1, got2 := server.RegisterSymbols(context.Background(), test.arg)
@@ -398,21 +636,21 @@ func Test_server_RegisterSymbols(t *testing.T) {
 func Test_server_UnregisterSymbols(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		unregister1             *kabuspb.RegisteredSymbols
-		unregister2             error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.RegisteredSymbols
-		hasError                bool
-		countAll                int
-		get                     []*kabuspb.RegisterSymbol
-		arg                     *kabuspb.UnregisterSymbolsRequest
-		wantRemove1             string
-		wantRemove2             []*kabuspb.RegisterSymbol
+		name        string
+		getToken1   string
+		getToken2   error
+		refresh1    string
+		refresh2    error
+		unregister1 *kabuspb.RegisteredSymbols
+		unregister2 error
+		retry       bool
+		want        *kabuspb.RegisteredSymbols
+		hasError    bool
+		countAll    int
+		get         []*kabuspb.RegisterSymbol
+		arg         *kabuspb.UnregisterSymbolsRequest
+		wantRemove1 string
+		wantRemove2 []*kabuspb.RegisterSymbol
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -422,17 +660,17 @@ func Test_server_UnregisterSymbols(t *testing.T) {
 			unregister2: errors.New("register error message"),
 			hasError:    true},
 		{name: "UnregisterのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			unregister2:             errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:   "TOKEN_STRING",
+			refresh2:    errors.New("refresh error message"),
+			unregister2: errors.New("miss match api key error message"),
+			retry:       true,
+			hasError:    true},
 		{name: "UnregisterのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			unregister2:             errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:   "TOKEN_STRING",
+			refresh1:    "REFRESHED_TOKEN_STRING",
+			unregister2: errors.New("miss match api key error message"),
+			retry:       true,
+			hasError:    true},
 		{name: "リクエストの結果をStoreに保存してから結果を返す",
 			getToken1:   "TOKEN_STRING",
 			unregister1: &kabuspb.RegisteredSymbols{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "2345", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}},
@@ -451,8 +689,11 @@ func Test_server_UnregisterSymbols(t *testing.T) {
 			t.Parallel()
 			registerSymbolService := &testRegisterSymbolService{countAll: test.countAll, get: test.get}
 			server := &server{
-				security:              &testSecurity{unregister1: test.unregister1, unregister2: test.unregister2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService:          &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
+				stationService:        &testStationService{},
+				throttleService:       &testThrottleService{},
+				quotaService:          &testQuotaService{},
+				security:              &testSecurity{unregister1: test.unregister1, unregister2: test.unregister2},
+				tokenService:          &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
 				registerSymbolService: registerSymbolService,
 				boardStreamService:    &testBoardStreamService{}}
 			got1, got2 := server.UnregisterSymbols(context.Background(), test.arg)
@@ -468,21 +709,21 @@ func Test_server_UnregisterSymbols(t *testing.T) {
 func Test_server_UnregisterAllSymbols(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		unregisterAll1          *kabuspb.RegisteredSymbols
-		unregisterAll2          error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.RegisteredSymbols
-		hasError                bool
-		countAll                int
-		get                     []*kabuspb.RegisterSymbol
-		arg                     *kabuspb.UnregisterAllSymbolsRequest
-		wantRemove1             string
-		wantRemove2             []*kabuspb.RegisterSymbol
+		name           string
+		getToken1      string
+		getToken2      error
+		refresh1       string
+		refresh2       error
+		unregisterAll1 *kabuspb.RegisteredSymbols
+		unregisterAll2 error
+		retry          bool
+		want           *kabuspb.RegisteredSymbols
+		hasError       bool
+		countAll       int
+		get            []*kabuspb.RegisterSymbol
+		arg            *kabuspb.UnregisterAllSymbolsRequest
+		wantRemove1    string
+		wantRemove2    []*kabuspb.RegisterSymbol
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -492,17 +733,17 @@ func Test_server_UnregisterAllSymbols(t *testing.T) {
 			unregisterAll2: errors.New("register error message"),
 			hasError:       true},
 		{name: "UnregisterAllのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			unregisterAll2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh2:       errors.New("refresh error message"),
+			unregisterAll2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "UnregisterAllのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			unregisterAll2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh1:       "REFRESHED_TOKEN_STRING",
+			unregisterAll2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "UnregisterAllの結果をStoreに保存してから結果を返す",
 			getToken1:      "TOKEN_STRING",
 			unregisterAll1: &kabuspb.RegisteredSymbols{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}},
@@ -520,8 +761,11 @@ func Test_server_UnregisterAllSymbols(t *testing.T) {
 			t.Parallel()
 			registerSymbolService := &testRegisterSymbolService{countAll: test.countAll, get: test.get}
 			server := &server{
-				security:              &testSecurity{unregisterAll1: test.unregisterAll1, unregisterAll2: test.unregisterAll2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService:          &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
+				stationService:        &testStationService{},
+				throttleService:       &testThrottleService{},
+				quotaService:          &testQuotaService{},
+				security:              &testSecurity{unregisterAll1: test.unregisterAll1, unregisterAll2: test.unregisterAll2},
+				tokenService:          &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2},
 				registerSymbolService: registerSymbolService}
 			got1, got2 := server.UnregisterAllSymbols(context.Background(), test.arg)
 			got3 := registerSymbolService.lastRemoveRequester
@@ -536,16 +780,16 @@ func Test_server_UnregisterAllSymbols(t *testing.T) {
 func Test_server_GetFutureSymbolCodeInfo(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		symbolNameFuture1       *kabuspb.SymbolCodeInfo
-		symbolNameFuture2       error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.SymbolCodeInfo
-		hasError                bool
+		name              string
+		getToken1         string
+		getToken2         error
+		refresh1          string
+		refresh2          error
+		symbolNameFuture1 *kabuspb.SymbolCodeInfo
+		symbolNameFuture2 error
+		retry             bool
+		want              *kabuspb.SymbolCodeInfo
+		hasError          bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -555,17 +799,17 @@ func Test_server_GetFutureSymbolCodeInfo(t *testing.T) {
 			symbolNameFuture2: errors.New("register error message"),
 			hasError:          true},
 		{name: "SymbolNameFutureのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			symbolNameFuture2:       errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:         "TOKEN_STRING",
+			refresh2:          errors.New("refresh error message"),
+			symbolNameFuture2: errors.New("miss match api key error message"),
+			retry:             true,
+			hasError:          true},
 		{name: "SymbolNameFutureのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			symbolNameFuture2:       errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:         "TOKEN_STRING",
+			refresh1:          "REFRESHED_TOKEN_STRING",
+			symbolNameFuture2: errors.New("miss match api key error message"),
+			retry:             true,
+			hasError:          true},
 		{name: "SymbolNameFutureの結果を結果を返す",
 			getToken1:         "TOKEN_STRING",
 			symbolNameFuture1: &kabuspb.SymbolCodeInfo{Code: "166060018", Name: "日経平均先物 21/06"},
@@ -577,8 +821,11 @@ func Test_server_GetFutureSymbolCodeInfo(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{symbolNameFuture1: test.symbolNameFuture1, symbolNameFuture2: test.symbolNameFuture2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{symbolNameFuture1: test.symbolNameFuture1, symbolNameFuture2: test.symbolNameFuture2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetFutureSymbolCodeInfo(context.Background(), &kabuspb.GetFutureSymbolCodeInfoRequest{
 				FutureCode:      kabuspb.FutureCode_FUTURE_CODE_NK225,
 				DerivativeMonth: timestamppb.Now()})
@@ -592,16 +839,16 @@ func Test_server_GetFutureSymbolCodeInfo(t *testing.T) {
 func Test_server_GetOptionSymbolCodeInfo(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		symbolNameOption1       *kabuspb.SymbolCodeInfo
-		symbolNameOption2       error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.SymbolCodeInfo
-		hasError                bool
+		name              string
+		getToken1         string
+		getToken2         error
+		refresh1          string
+		refresh2          error
+		symbolNameOption1 *kabuspb.SymbolCodeInfo
+		symbolNameOption2 error
+		retry             bool
+		want              *kabuspb.SymbolCodeInfo
+		hasError          bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -611,17 +858,17 @@ func Test_server_GetOptionSymbolCodeInfo(t *testing.T) {
 			symbolNameOption2: errors.New("register error message"),
 			hasError:          true},
 		{name: "SymbolNameOptionのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			symbolNameOption2:       errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:         "TOKEN_STRING",
+			refresh2:          errors.New("refresh error message"),
+			symbolNameOption2: errors.New("miss match api key error message"),
+			retry:             true,
+			hasError:          true},
 		{name: "SymbolNameOptionのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			symbolNameOption2:       errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:         "TOKEN_STRING",
+			refresh1:          "REFRESHED_TOKEN_STRING",
+			symbolNameOption2: errors.New("miss match api key error message"),
+			retry:             true,
+			hasError:          true},
 		{name: "SymbolNameOptionの結果を結果を返す",
 			getToken1:         "TOKEN_STRING",
 			symbolNameOption1: &kabuspb.SymbolCodeInfo{Code: "166060018", Name: "日経平均先物 21/06"},
@@ -633,8 +880,11 @@ func Test_server_GetOptionSymbolCodeInfo(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{symbolNameOption1: test.symbolNameOption1, symbolNameOption2: test.symbolNameOption2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{symbolNameOption1: test.symbolNameOption1, symbolNameOption2: test.symbolNameOption2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetOptionSymbolCodeInfo(context.Background(), &kabuspb.GetOptionSymbolCodeInfoRequest{
 				DerivativeMonth: timestamppb.Now(),
 				CallOrPut:       kabuspb.CallPut_CALL_PUT_CALL,
@@ -649,16 +899,16 @@ func Test_server_GetOptionSymbolCodeInfo(t *testing.T) {
 func Test_server_GetBoard(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		board1                  *kabuspb.Board
-		board2                  error
-		refresh1                string
-		refresh2                error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.Board
-		hasError                bool
+		name      string
+		getToken1 string
+		getToken2 error
+		board1    *kabuspb.Board
+		board2    error
+		refresh1  string
+		refresh2  error
+		retry     bool
+		want      *kabuspb.Board
+		hasError  bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -668,17 +918,17 @@ func Test_server_GetBoard(t *testing.T) {
 			board2:    errors.New("register error message"),
 			hasError:  true},
 		{name: "BoardのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			board2:                  errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh2:  errors.New("refresh error message"),
+			board2:    errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "BoardのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			board2:                  errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh1:  "REFRESHED_TOKEN_STRING",
+			board2:    errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "Boardの結果を結果を返す",
 			getToken1: "TOKEN_STRING",
 			board1:    &kabuspb.Board{SymbolCode: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
@@ -690,8 +940,11 @@ func Test_server_GetBoard(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{board1: test.board1, board2: test.board2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{board1: test.board1, board2: test.board2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetBoard(context.Background(), &kabuspb.GetBoardRequest{SymbolCode: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -700,19 +953,60 @@ func Test_server_GetBoard(t *testing.T) {
 	}
 }
 
+func Test_server_GetBoard_maxAge(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		server         *server
+		arg            *kabuspb.GetBoardRequest
+		want           *kabuspb.Board
+		wantLastMaxAge time.Duration
+	}{
+		{name: "許容する古さがあり、保持している板情報が使えればそれを返す",
+			server: &server{
+				boardStreamService: &testBoardStreamService{cached1: &kabuspb.Board{SymbolCode: "5401", SymbolName: "CACHED"}, cached2: true}},
+			arg:            &kabuspb.GetBoardRequest{SymbolCode: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, MaxAgeMs: 1500},
+			want:           &kabuspb.Board{SymbolCode: "5401", SymbolName: "CACHED"},
+			wantLastMaxAge: 1500 * time.Millisecond},
+		{name: "許容する古さがあっても、保持している板情報が使えなければkabusapiから取得する",
+			server: &server{
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				boardStreamService: &testBoardStreamService{},
+				security:           &testSecurity{board1: &kabuspb.Board{SymbolCode: "5401"}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
+			arg:            &kabuspb.GetBoardRequest{SymbolCode: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, MaxAgeMs: 1500},
+			want:           &kabuspb.Board{SymbolCode: "5401"},
+			wantLastMaxAge: 1500 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, _ := test.server.GetBoard(context.Background(), test.arg)
+			gotLastMaxAge := test.server.boardStreamService.(*testBoardStreamService).lastMaxAge
+			if !reflect.DeepEqual(test.want, got) || test.wantLastMaxAge != gotLastMaxAge {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantLastMaxAge, got, gotLastMaxAge)
+			}
+		})
+	}
+}
+
 func Test_server_GetSymbol(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		symbol1                 *kabuspb.Symbol
-		symbol2                 error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.Symbol
-		hasError                bool
+		name      string
+		getToken1 string
+		getToken2 error
+		refresh1  string
+		refresh2  error
+		symbol1   *kabuspb.Symbol
+		symbol2   error
+		retry     bool
+		want      *kabuspb.Symbol
+		hasError  bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -722,17 +1016,17 @@ func Test_server_GetSymbol(t *testing.T) {
 			symbol2:   errors.New("register error message"),
 			hasError:  true},
 		{name: "SymbolのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			symbol2:                 errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh2:  errors.New("refresh error message"),
+			symbol2:   errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "SymbolのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			symbol2:                 errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh1:  "REFRESHED_TOKEN_STRING",
+			symbol2:   errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "Symbolの結果を結果を返す",
 			getToken1: "TOKEN_STRING",
 			symbol1:   &kabuspb.Symbol{Code: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
@@ -744,8 +1038,11 @@ func Test_server_GetSymbol(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{symbol1: test.symbol1, symbol2: test.symbol2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{symbol1: test.symbol1, symbol2: test.symbol2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetSymbol(context.Background(), &kabuspb.GetSymbolRequest{SymbolCode: "5401", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -765,45 +1062,83 @@ func Test_server_GetOrders(t *testing.T) {
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			server: &server{
-				tokenService: &testTokenService{getToken2: errors.New("get token error message")}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				tokenService:       &testTokenService{getToken2: errors.New("get token error message")}},
 			arg:      &kabuspb.GetOrdersRequest{IsVirtual: false},
 			hasError: true},
 		{name: "Ordersでエラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{orders2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				security:           &testSecurity{orders2: errors.New("register error message")},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.GetOrdersRequest{IsVirtual: false},
 			hasError: true},
 		{name: "OrdersのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{orders2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				security:           &testSecurity{orders2: errors.New("miss match api key error message")},
+				tokenService:       &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
 			arg:      &kabuspb.GetOrdersRequest{IsVirtual: false},
 			hasError: true},
 		{name: "OrdersのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
 			server: &server{
-				security:     &testSecurity{orders2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				security:           &testSecurity{orders2: errors.New("miss match api key error message")},
+				tokenService:       &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
 			arg:      &kabuspb.GetOrdersRequest{IsVirtual: false},
 			hasError: true},
 		{name: "Ordersの結果を結果を返す",
 			server: &server{
-				security:     &testSecurity{orders1: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				security:           &testSecurity{orders1: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.GetOrdersRequest{IsVirtual: false},
 			want: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
 		{name: "仮想証券会社を指定して、Ordersでエラーがあればエラーを返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{orders2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				virtual:            &testVirtualSecurity{orders2: errors.New("register error message")},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.GetOrdersRequest{IsVirtual: true},
 			hasError: true},
 		{name: "仮想証券会社を指定して、Ordersの結果を結果を返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{orders1: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{},
+				virtual:            &testVirtualSecurity{orders1: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.GetOrdersRequest{IsVirtual: true},
 			want: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}}}},
+		{name: "クライアント注文IDを入れて絞り込んだ結果を返す",
+			server: &server{
+				stationService:     &testStationService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				clientOrderService: &testClientOrderService{attach: []*kabuspb.Order{{Id: "20210331A02N36008399", ClientOrderId: "client-1"}}},
+				security:           &testSecurity{orders1: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399"}, {Id: "20210331A02N36008400"}}}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
+			arg:  &kabuspb.GetOrdersRequest{ClientOrderId: "client-1"},
+			want: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "20210331A02N36008399", ClientOrderId: "client-1"}}}},
 	}
 
 	for _, test := range tests {
@@ -818,6 +1153,34 @@ func Test_server_GetOrders(t *testing.T) {
 	}
 }
 
+func Test_server_GetOrderHistory(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		find1    *kabuspb.OrderHistory
+		find2    error
+		want     *kabuspb.OrderHistory
+		hasError bool
+	}{
+		{name: "注文履歴が読めなければエラーを返す", find2: errors.New("error message"), hasError: true},
+		{name: "注文履歴を返す",
+			find1: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{{Order: &kabuspb.Order{Id: "ORDER-ID"}}}},
+			want:  &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{{Order: &kabuspb.Order{Id: "ORDER-ID"}}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{orderHistoryService: &testOrderHistoryService{find1: test.find1, find2: test.find2}}
+			got1, got2 := server.GetOrderHistory(context.Background(), &kabuspb.GetOrderHistoryRequest{})
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
 func Test_server_GetPositions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -828,45 +1191,78 @@ func Test_server_GetPositions(t *testing.T) {
 		hasError bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
-			server:   &server{tokenService: &testTokenService{getToken2: errors.New("get token error message")}},
+			server:   &server{stationService: &testStationService{}, throttleService: &testThrottleService{}, quotaService: &testQuotaService{}, tokenService: &testTokenService{getToken2: errors.New("get token error message")}},
 			arg:      &kabuspb.GetPositionsRequest{IsVirtual: false},
 			hasError: true},
 		{name: "Positionsでエラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{positions2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{positions2: errors.New("register error message")},
+				tokenService:    &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.GetPositionsRequest{IsVirtual: false},
 			hasError: true},
 		{name: "PositionsのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{positions2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{positions2: errors.New("miss match api key error message")},
+				tokenService:    &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
 			arg:      &kabuspb.GetPositionsRequest{IsVirtual: false},
 			hasError: true},
 		{name: "PositionsのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
 			server: &server{
-				security:     &testSecurity{positions2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{positions2: errors.New("miss match api key error message")},
+				tokenService:    &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
 			arg:      &kabuspb.GetPositionsRequest{IsVirtual: false},
 			hasError: true},
 		{name: "Positionsの結果を結果を返す",
 			server: &server{
-				security:     &testSecurity{positions1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{positions1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
+				tokenService:    &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.GetPositionsRequest{IsVirtual: false},
 			want: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
 		{name: "仮想証券会社を指定して、Positionsでエラーがあればエラーを返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{positions2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				virtual:         &testVirtualSecurity{positions2: errors.New("register error message")},
+				tokenService:    &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.GetPositionsRequest{IsVirtual: true},
 			hasError: true},
 		{name: "仮想証券会社を指定して、Positionsの結果を結果を返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{positions1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				virtual:         &testVirtualSecurity{positions1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
+				tokenService:    &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.GetPositionsRequest{IsVirtual: true},
 			want: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
+		{name: "許容する古さがあり、保持している建玉が使えればそれを返す",
+			server: &server{
+				positionService: &testPositionService{cached1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "CACHED"}}}, cached2: true}},
+			arg:  &kabuspb.GetPositionsRequest{MaxAgeMs: 1000},
+			want: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "CACHED"}}}},
+		{name: "許容する古さがあっても、保持している建玉が使えなければkabusapiから取得する",
+			server: &server{
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				positionService: &testPositionService{},
+				security:        &testSecurity{positions1: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
+				tokenService:    &testTokenService{getToken1: "TOKEN_STRING"}},
+			arg:  &kabuspb.GetPositionsRequest{MaxAgeMs: 1000},
+			want: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "20210331A02N36008399"}}}},
 	}
 
 	for _, test := range tests {
@@ -884,16 +1280,16 @@ func Test_server_GetPositions(t *testing.T) {
 func Test_server_GetPriceRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		priceRanking1           *kabuspb.PriceRanking
-		priceRanking2           error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.PriceRanking
-		hasError                bool
+		name          string
+		getToken1     string
+		getToken2     error
+		refresh1      string
+		refresh2      error
+		priceRanking1 *kabuspb.PriceRanking
+		priceRanking2 error
+		retry         bool
+		want          *kabuspb.PriceRanking
+		hasError      bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -903,17 +1299,17 @@ func Test_server_GetPriceRanking(t *testing.T) {
 			priceRanking2: errors.New("register error message"),
 			hasError:      true},
 		{name: "PriceRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			priceRanking2:           errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:     "TOKEN_STRING",
+			refresh2:      errors.New("refresh error message"),
+			priceRanking2: errors.New("miss match api key error message"),
+			retry:         true,
+			hasError:      true},
 		{name: "PriceRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			priceRanking2:           errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:     "TOKEN_STRING",
+			refresh1:      "REFRESHED_TOKEN_STRING",
+			priceRanking2: errors.New("miss match api key error message"),
+			retry:         true,
+			hasError:      true},
 		{name: "PriceRankingの結果を結果を返す",
 			getToken1:     "TOKEN_STRING",
 			priceRanking1: &kabuspb.PriceRanking{Type: kabuspb.PriceRankingType_PRICE_RANKING_TYPE_INCREASE_RATE},
@@ -925,8 +1321,11 @@ func Test_server_GetPriceRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{priceRanking1: test.priceRanking1, priceRanking2: test.priceRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{priceRanking1: test.priceRanking1, priceRanking2: test.priceRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetPriceRanking(context.Background(), &kabuspb.GetPriceRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -938,16 +1337,16 @@ func Test_server_GetPriceRanking(t *testing.T) {
 func Test_server_GetTickRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		tickRanking1            *kabuspb.TickRanking
-		tickRanking2            error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.TickRanking
-		hasError                bool
+		name         string
+		getToken1    string
+		getToken2    error
+		refresh1     string
+		refresh2     error
+		tickRanking1 *kabuspb.TickRanking
+		tickRanking2 error
+		retry        bool
+		want         *kabuspb.TickRanking
+		hasError     bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -957,17 +1356,17 @@ func Test_server_GetTickRanking(t *testing.T) {
 			tickRanking2: errors.New("register error message"),
 			hasError:     true},
 		{name: "TickRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			tickRanking2:            errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:    "TOKEN_STRING",
+			refresh2:     errors.New("refresh error message"),
+			tickRanking2: errors.New("miss match api key error message"),
+			retry:        true,
+			hasError:     true},
 		{name: "TickRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			tickRanking2:            errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:    "TOKEN_STRING",
+			refresh1:     "REFRESHED_TOKEN_STRING",
+			tickRanking2: errors.New("miss match api key error message"),
+			retry:        true,
+			hasError:     true},
 		{name: "TickRankingの結果を結果を返す",
 			getToken1:    "TOKEN_STRING",
 			tickRanking1: &kabuspb.TickRanking{ExchangeDivision: kabuspb.ExchangeDivision_EXCHANGE_DIVISION_ALL},
@@ -979,8 +1378,11 @@ func Test_server_GetTickRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{tickRanking1: test.tickRanking1, tickRanking2: test.tickRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{tickRanking1: test.tickRanking1, tickRanking2: test.tickRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetTickRanking(context.Background(), &kabuspb.GetTickRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -992,16 +1394,16 @@ func Test_server_GetTickRanking(t *testing.T) {
 func Test_server_GetVolumeRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		volumeRanking1          *kabuspb.VolumeRanking
-		volumeRanking2          error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.VolumeRanking
-		hasError                bool
+		name           string
+		getToken1      string
+		getToken2      error
+		refresh1       string
+		refresh2       error
+		volumeRanking1 *kabuspb.VolumeRanking
+		volumeRanking2 error
+		retry          bool
+		want           *kabuspb.VolumeRanking
+		hasError       bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1011,17 +1413,17 @@ func Test_server_GetVolumeRanking(t *testing.T) {
 			volumeRanking2: errors.New("register error message"),
 			hasError:       true},
 		{name: "VolumeRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			volumeRanking2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh2:       errors.New("refresh error message"),
+			volumeRanking2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "VolumeRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			volumeRanking2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh1:       "REFRESHED_TOKEN_STRING",
+			volumeRanking2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "VolumeRankingの結果を結果を返す",
 			getToken1:      "TOKEN_STRING",
 			volumeRanking1: &kabuspb.VolumeRanking{ExchangeDivision: kabuspb.ExchangeDivision_EXCHANGE_DIVISION_ALL},
@@ -1033,8 +1435,11 @@ func Test_server_GetVolumeRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{volumeRanking1: test.volumeRanking1, volumeRanking2: test.volumeRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{volumeRanking1: test.volumeRanking1, volumeRanking2: test.volumeRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetVolumeRanking(context.Background(), &kabuspb.GetVolumeRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1046,16 +1451,16 @@ func Test_server_GetVolumeRanking(t *testing.T) {
 func Test_server_GetValueRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		valueRanking1           *kabuspb.ValueRanking
-		valueRanking2           error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.ValueRanking
-		hasError                bool
+		name          string
+		getToken1     string
+		getToken2     error
+		refresh1      string
+		refresh2      error
+		valueRanking1 *kabuspb.ValueRanking
+		valueRanking2 error
+		retry         bool
+		want          *kabuspb.ValueRanking
+		hasError      bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1065,17 +1470,17 @@ func Test_server_GetValueRanking(t *testing.T) {
 			valueRanking2: errors.New("register error message"),
 			hasError:      true},
 		{name: "ValueRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			valueRanking2:           errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:     "TOKEN_STRING",
+			refresh2:      errors.New("refresh error message"),
+			valueRanking2: errors.New("miss match api key error message"),
+			retry:         true,
+			hasError:      true},
 		{name: "ValueRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			valueRanking2:           errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:     "TOKEN_STRING",
+			refresh1:      "REFRESHED_TOKEN_STRING",
+			valueRanking2: errors.New("miss match api key error message"),
+			retry:         true,
+			hasError:      true},
 		{name: "ValueRankingの結果を結果を返す",
 			getToken1:     "TOKEN_STRING",
 			valueRanking1: &kabuspb.ValueRanking{ExchangeDivision: kabuspb.ExchangeDivision_EXCHANGE_DIVISION_ALL},
@@ -1087,8 +1492,11 @@ func Test_server_GetValueRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{valueRanking1: test.valueRanking1, valueRanking2: test.valueRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{valueRanking1: test.valueRanking1, valueRanking2: test.valueRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetValueRanking(context.Background(), &kabuspb.GetValueRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1100,16 +1508,16 @@ func Test_server_GetValueRanking(t *testing.T) {
 func Test_server_GetMarginRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		marginRanking1          *kabuspb.MarginRanking
-		marginRanking2          error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.MarginRanking
-		hasError                bool
+		name           string
+		getToken1      string
+		getToken2      error
+		refresh1       string
+		refresh2       error
+		marginRanking1 *kabuspb.MarginRanking
+		marginRanking2 error
+		retry          bool
+		want           *kabuspb.MarginRanking
+		hasError       bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1119,17 +1527,17 @@ func Test_server_GetMarginRanking(t *testing.T) {
 			marginRanking2: errors.New("register error message"),
 			hasError:       true},
 		{name: "MarginRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			marginRanking2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh2:       errors.New("refresh error message"),
+			marginRanking2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "MarginRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			marginRanking2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh1:       "REFRESHED_TOKEN_STRING",
+			marginRanking2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "MarginRankingの結果を結果を返す",
 			getToken1:      "TOKEN_STRING",
 			marginRanking1: &kabuspb.MarginRanking{ExchangeDivision: kabuspb.ExchangeDivision_EXCHANGE_DIVISION_ALL},
@@ -1141,8 +1549,11 @@ func Test_server_GetMarginRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{marginRanking1: test.marginRanking1, marginRanking2: test.marginRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{marginRanking1: test.marginRanking1, marginRanking2: test.marginRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetMarginRanking(context.Background(), &kabuspb.GetMarginRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1154,16 +1565,16 @@ func Test_server_GetMarginRanking(t *testing.T) {
 func Test_server_GetIndustryRanking(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		industryRanking1        *kabuspb.IndustryRanking
-		industryRanking2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.IndustryRanking
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		industryRanking1 *kabuspb.IndustryRanking
+		industryRanking2 error
+		retry            bool
+		want             *kabuspb.IndustryRanking
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1173,17 +1584,17 @@ func Test_server_GetIndustryRanking(t *testing.T) {
 			industryRanking2: errors.New("register error message"),
 			hasError:         true},
 		{name: "IndustryRankingのエラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			industryRanking2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			industryRanking2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "IndustryRankingのエラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			industryRanking2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			industryRanking2: errors.New("miss match api key error message"),
+			retry:            true,
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			hasError:         true},
 		{name: "IndustryRankingの結果を結果を返す",
 			getToken1:        "TOKEN_STRING",
 			industryRanking1: &kabuspb.IndustryRanking{ExchangeDivision: kabuspb.ExchangeDivision_EXCHANGE_DIVISION_ALL},
@@ -1195,8 +1606,11 @@ func Test_server_GetIndustryRanking(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{industryRanking1: test.industryRanking1, industryRanking2: test.industryRanking2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{industryRanking1: test.industryRanking1, industryRanking2: test.industryRanking2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetIndustryRanking(context.Background(), &kabuspb.GetIndustryRankingRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1214,46 +1628,102 @@ func Test_server_SendStockOrder(t *testing.T) {
 		arg      *kabuspb.SendStockOrderRequest
 		hasError bool
 	}{
+		{name: "kabuステーションが利用できなければエラーを返す",
+			server:   &server{riskService: &testRiskService{}, killSwitchService: &testKillSwitchService{}, clientOrderService: &testClientOrderService{}, stationService: &testStationService{check: errors.New("unavailable")}, throttleService: &testThrottleService{}, quotaService: &testQuotaService{}},
+			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
+			hasError: true},
+		{name: "利用枠を超えていたらエラーを返す",
+			server:   &server{stationService: &testStationService{}, riskService: &testRiskService{}, killSwitchService: &testKillSwitchService{}, clientOrderService: &testClientOrderService{}, throttleService: &testThrottleService{}, quotaService: &testQuotaService{use: errors.New("quota exceeded")}},
+			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
+			hasError: true},
+		{name: "順番待ちでエラーがあればエラーを返す",
+			server:   &server{stationService: &testStationService{}, riskService: &testRiskService{}, killSwitchService: &testKillSwitchService{}, clientOrderService: &testClientOrderService{}, throttleService: &testThrottleService{wait: context.DeadlineExceeded}, quotaService: &testQuotaService{}},
+			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
+			hasError: true},
 		{name: "token取得でエラーがあればエラーを返す",
-			server:   &server{tokenService: &testTokenService{getToken2: errors.New("get token error message")}},
+			server:   &server{stationService: &testStationService{}, riskService: &testRiskService{}, killSwitchService: &testKillSwitchService{}, clientOrderService: &testClientOrderService{}, throttleService: &testThrottleService{}, quotaService: &testQuotaService{}, security: &testSecurity{}, tokenService: &testTokenService{getToken2: errors.New("get token error message")}},
 			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
 			hasError: true},
 		{name: "エラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{sendOrderStock2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderStock2: errors.New("register error message")},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
 			hasError: true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
 			server: &server{
-				security:     &testSecurity{sendOrderStock2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderStock2: errors.New("miss match api key error message")},
+				tokenService:       &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh2: errors.New("refresh error message")}},
 			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
 			hasError: true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
 			server: &server{
-				security:     &testSecurity{sendOrderStock2: errors.New("miss match api key error message"), isMissMatchApiKeyError1: true},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderStock2: errors.New("miss match api key error message")},
+				tokenService:       &testTokenService{retry: true, getToken1: "TOKEN_STRING", refresh1: "REFRESHED_TOKEN_STRING"}},
 			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: false},
 			hasError: true},
 		{name: "エラーがなければ結果を返す",
 			server: &server{
-				security:     &testSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.SendStockOrderRequest{IsVirtual: false},
 			want: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
 		{name: "仮想証券会社を指定していて、エラーがあればエラーを返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{sendOrderStock2: errors.New("register error message")},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				virtual:            &testVirtualSecurity{sendOrderStock2: errors.New("register error message")},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:      &kabuspb.SendStockOrderRequest{IsVirtual: true},
 			hasError: true},
 		{name: "仮想証券会社を指定していて、エラーがなければ結果を返す",
 			server: &server{
-				virtual:      &testVirtualSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
-				tokenService: &testTokenService{getToken1: "TOKEN_STRING"}},
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				virtual:            &testVirtualSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
+				tokenService:       &testTokenService{getToken1: "TOKEN_STRING"}},
 			arg:  &kabuspb.SendStockOrderRequest{IsVirtual: true},
 			want: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
+		{name: "緊急停止で仮想証券会社だけにしていれば、仮想証券会社を指定していなくても仮想証券会社の結果を返す",
+			server: &server{
+				killSwitchService:  &testKillSwitchService{isVirtualOnly: true, check: errors.New("kill switch is active")},
+				clientOrderService: &testClientOrderService{},
+				security:           &testSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "REAL-ORDER-ID"}},
+				virtual:            &testVirtualSecurity{sendOrderStock1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}}},
+			arg:  &kabuspb.SendStockOrderRequest{IsVirtual: false},
+			want: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"}},
 	}
 
 	for _, test := range tests {
@@ -1280,7 +1750,7 @@ func Test_server_SendMarginOrder(t *testing.T) {
 		sendOrderMargin2        error
 		virtualSendOrderMargin1 *kabuspb.OrderResponse
 		virtualSendOrderMargin2 error
-		isMissMatchApiKeyError1 bool
+		retry                   bool
 		arg2                    *kabuspb.SendMarginOrderRequest
 		want                    *kabuspb.OrderResponse
 		hasError                bool
@@ -1295,19 +1765,19 @@ func Test_server_SendMarginOrder(t *testing.T) {
 			arg2:             &kabuspb.SendMarginOrderRequest{},
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			sendOrderMargin2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			arg2:                    &kabuspb.SendMarginOrderRequest{},
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			sendOrderMargin2: errors.New("miss match api key error message"),
+			retry:            true,
+			arg2:             &kabuspb.SendMarginOrderRequest{},
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			sendOrderMargin2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			arg2:                    &kabuspb.SendMarginOrderRequest{},
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			sendOrderMargin2: errors.New("miss match api key error message"),
+			retry:            true,
+			arg2:             &kabuspb.SendMarginOrderRequest{},
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			sendOrderMargin1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
@@ -1324,9 +1794,15 @@ func Test_server_SendMarginOrder(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{sendOrderMargin1: test.sendOrderMargin1, sendOrderMargin2: test.sendOrderMargin2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				virtual:      &testVirtualSecurity{sendOrderMargin1: test.virtualSendOrderMargin1, sendOrderMargin2: test.virtualSendOrderMargin2},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderMargin1: test.sendOrderMargin1, sendOrderMargin2: test.sendOrderMargin2},
+				virtual:            &testVirtualSecurity{sendOrderMargin1: test.virtualSendOrderMargin1, sendOrderMargin2: test.virtualSendOrderMargin2},
+				tokenService:       &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.SendMarginOrder(context.Background(), test.arg2)
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1335,19 +1811,34 @@ func Test_server_SendMarginOrder(t *testing.T) {
 	}
 }
 
+func Test_server_SendMarginOrder_clientOrderID(t *testing.T) {
+	t.Parallel()
+	clientOrderService := &testClientOrderService{}
+	server := &server{
+		clientOrderService: clientOrderService,
+		killSwitchService:  &testKillSwitchService{},
+		virtual:            &testVirtualSecurity{sendOrderMargin1: &kabuspb.OrderResponse{OrderId: "ORDER-ID"}}}
+	want := &kabuspb.OrderResponse{OrderId: "ORDER-ID"}
+	ctx := contextWithClient(context.Background(), &services.AuthClient{Name: "trader"})
+	got1, got2 := server.SendMarginOrder(ctx, &kabuspb.SendMarginOrderRequest{ClientOrderId: "client-1", IsVirtual: true})
+	if !reflect.DeepEqual(want, got1) || got2 != nil || clientOrderService.lastOwner != "trader" || clientOrderService.lastClientOrderID != "client-1" {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(), want, "trader", "client-1", got1, got2, clientOrderService.lastOwner, clientOrderService.lastClientOrderID)
+	}
+}
+
 func Test_server_SendFutureOrder(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		sendOrderFuture1        *kabuspb.OrderResponse
-		sendOrderFuture2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.OrderResponse
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		sendOrderFuture1 *kabuspb.OrderResponse
+		sendOrderFuture2 error
+		retry            bool
+		want             *kabuspb.OrderResponse
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1357,17 +1848,17 @@ func Test_server_SendFutureOrder(t *testing.T) {
 			sendOrderFuture2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			sendOrderFuture2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			sendOrderFuture2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			sendOrderFuture2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			sendOrderFuture2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			sendOrderFuture1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
@@ -1379,8 +1870,14 @@ func Test_server_SendFutureOrder(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{sendOrderFuture1: test.sendOrderFuture1, sendOrderFuture2: test.sendOrderFuture2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderFuture1: test.sendOrderFuture1, sendOrderFuture2: test.sendOrderFuture2},
+				tokenService:       &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.SendFutureOrder(context.Background(), &kabuspb.SendFutureOrderRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1392,16 +1889,16 @@ func Test_server_SendFutureOrder(t *testing.T) {
 func Test_server_SendOptionOrder(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		sendOrderOption1        *kabuspb.OrderResponse
-		sendOrderOption2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.OrderResponse
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		sendOrderOption1 *kabuspb.OrderResponse
+		sendOrderOption2 error
+		retry            bool
+		want             *kabuspb.OrderResponse
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1411,17 +1908,17 @@ func Test_server_SendOptionOrder(t *testing.T) {
 			sendOrderOption2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			sendOrderOption2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			sendOrderOption2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			sendOrderOption2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			sendOrderOption2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			sendOrderOption1: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
@@ -1433,8 +1930,14 @@ func Test_server_SendOptionOrder(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{sendOrderOption1: test.sendOrderOption1, sendOrderOption2: test.sendOrderOption2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:     &testStationService{},
+				riskService:        &testRiskService{},
+				killSwitchService:  &testKillSwitchService{},
+				clientOrderService: &testClientOrderService{},
+				throttleService:    &testThrottleService{},
+				quotaService:       &testQuotaService{},
+				security:           &testSecurity{sendOrderOption1: test.sendOrderOption1, sendOrderOption2: test.sendOrderOption2},
+				tokenService:       &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.SendOptionOrder(context.Background(), &kabuspb.SendOptionOrderRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1446,19 +1949,19 @@ func Test_server_SendOptionOrder(t *testing.T) {
 func Test_server_CancelOrder(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		cancelOrder1            *kabuspb.OrderResponse
-		cancelOrder2            error
-		virtualCancelOrder1     *kabuspb.OrderResponse
-		virtualCancelOrder2     error
-		isMissMatchApiKeyError1 bool
-		arg2                    *kabuspb.CancelOrderRequest
-		want                    *kabuspb.OrderResponse
-		hasError                bool
+		name                string
+		getToken1           string
+		getToken2           error
+		refresh1            string
+		refresh2            error
+		cancelOrder1        *kabuspb.OrderResponse
+		cancelOrder2        error
+		virtualCancelOrder1 *kabuspb.OrderResponse
+		virtualCancelOrder2 error
+		retry               bool
+		arg2                *kabuspb.CancelOrderRequest
+		want                *kabuspb.OrderResponse
+		hasError            bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			arg2:      &kabuspb.CancelOrderRequest{},
@@ -1470,19 +1973,19 @@ func Test_server_CancelOrder(t *testing.T) {
 			cancelOrder2: errors.New("register error message"),
 			hasError:     true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			cancelOrder2:            errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			arg2:                    &kabuspb.CancelOrderRequest{},
-			hasError:                true},
+			getToken1:    "TOKEN_STRING",
+			refresh2:     errors.New("refresh error message"),
+			cancelOrder2: errors.New("miss match api key error message"),
+			retry:        true,
+			arg2:         &kabuspb.CancelOrderRequest{},
+			hasError:     true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			cancelOrder2:            errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			arg2:                    &kabuspb.CancelOrderRequest{},
-			hasError:                true},
+			getToken1:    "TOKEN_STRING",
+			refresh1:     "REFRESHED_TOKEN_STRING",
+			cancelOrder2: errors.New("miss match api key error message"),
+			retry:        true,
+			arg2:         &kabuspb.CancelOrderRequest{},
+			hasError:     true},
 		{name: "エラーがなければ結果を返す",
 			arg2:         &kabuspb.CancelOrderRequest{},
 			getToken1:    "TOKEN_STRING",
@@ -1499,9 +2002,12 @@ func Test_server_CancelOrder(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{cancelOrder1: test.cancelOrder1, cancelOrder2: test.cancelOrder2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				virtual:      &testVirtualSecurity{cancelOrder1: test.virtualCancelOrder1, cancelOrder2: test.virtualCancelOrder2},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{cancelOrder1: test.cancelOrder1, cancelOrder2: test.cancelOrder2},
+				virtual:         &testVirtualSecurity{cancelOrder1: test.virtualCancelOrder1, cancelOrder2: test.virtualCancelOrder2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.CancelOrder(context.Background(), test.arg2)
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1513,16 +2019,16 @@ func Test_server_CancelOrder(t *testing.T) {
 func Test_server_GetStockWallet(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		getStockWallet1         *kabuspb.StockWallet
-		getStockWallet2         error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.StockWallet
-		hasError                bool
+		name            string
+		getToken1       string
+		getToken2       error
+		refresh1        string
+		refresh2        error
+		getStockWallet1 *kabuspb.StockWallet
+		getStockWallet2 error
+		retry           bool
+		want            *kabuspb.StockWallet
+		hasError        bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1532,17 +2038,17 @@ func Test_server_GetStockWallet(t *testing.T) {
 			getStockWallet2: errors.New("register error message"),
 			hasError:        true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			getStockWallet2:         errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:       "TOKEN_STRING",
+			refresh2:        errors.New("refresh error message"),
+			getStockWallet2: errors.New("miss match api key error message"),
+			retry:           true,
+			hasError:        true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			getStockWallet2:         errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:       "TOKEN_STRING",
+			refresh1:        "REFRESHED_TOKEN_STRING",
+			getStockWallet2: errors.New("miss match api key error message"),
+			retry:           true,
+			hasError:        true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:       "TOKEN_STRING",
 			getStockWallet1: &kabuspb.StockWallet{StockAccountWallet: 300000},
@@ -1554,8 +2060,11 @@ func Test_server_GetStockWallet(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{getStockWallet1: test.getStockWallet1, getStockWallet2: test.getStockWallet2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{getStockWallet1: test.getStockWallet1, getStockWallet2: test.getStockWallet2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetStockWallet(context.Background(), &kabuspb.GetStockWalletRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1567,16 +2076,16 @@ func Test_server_GetStockWallet(t *testing.T) {
 func Test_server_GetMarginWallet(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		getMarginWallet1        *kabuspb.MarginWallet
-		getMarginWallet2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.MarginWallet
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		getMarginWallet1 *kabuspb.MarginWallet
+		getMarginWallet2 error
+		retry            bool
+		want             *kabuspb.MarginWallet
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1586,17 +2095,17 @@ func Test_server_GetMarginWallet(t *testing.T) {
 			getMarginWallet2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			getMarginWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			getMarginWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			getMarginWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			getMarginWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			getMarginWallet1: &kabuspb.MarginWallet{MarginAccountWallet: 300000},
@@ -1608,8 +2117,11 @@ func Test_server_GetMarginWallet(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{getMarginWallet1: test.getMarginWallet1, getMarginWallet2: test.getMarginWallet2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{getMarginWallet1: test.getMarginWallet1, getMarginWallet2: test.getMarginWallet2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetMarginWallet(context.Background(), &kabuspb.GetMarginWalletRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1621,16 +2133,16 @@ func Test_server_GetMarginWallet(t *testing.T) {
 func Test_server_GetFutureWallet(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		getFutureWallet1        *kabuspb.FutureWallet
-		getFutureWallet2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.FutureWallet
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		getFutureWallet1 *kabuspb.FutureWallet
+		getFutureWallet2 error
+		retry            bool
+		want             *kabuspb.FutureWallet
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1640,17 +2152,17 @@ func Test_server_GetFutureWallet(t *testing.T) {
 			getFutureWallet2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			getFutureWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			getFutureWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			getFutureWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			getFutureWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			getFutureWallet1: &kabuspb.FutureWallet{FutureTradeLimit: 300000, MarginRequirement: 0},
@@ -1662,8 +2174,11 @@ func Test_server_GetFutureWallet(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{getFutureWallet1: test.getFutureWallet1, getFutureWallet2: test.getFutureWallet2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{getFutureWallet1: test.getFutureWallet1, getFutureWallet2: test.getFutureWallet2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetFutureWallet(context.Background(), &kabuspb.GetFutureWalletRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1675,16 +2190,16 @@ func Test_server_GetFutureWallet(t *testing.T) {
 func Test_server_GetOptionWallet(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		getOptionWallet1        *kabuspb.OptionWallet
-		getOptionWallet2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.OptionWallet
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		getOptionWallet1 *kabuspb.OptionWallet
+		getOptionWallet2 error
+		retry            bool
+		want             *kabuspb.OptionWallet
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1694,17 +2209,17 @@ func Test_server_GetOptionWallet(t *testing.T) {
 			getOptionWallet2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			getOptionWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			getOptionWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			getOptionWallet2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			getOptionWallet2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1:        "TOKEN_STRING",
 			getOptionWallet1: &kabuspb.OptionWallet{OptionBuyTradeLimit: 300000, OptionSellTradeLimit: 300000, MarginRequirement: 0},
@@ -1716,8 +2231,11 @@ func Test_server_GetOptionWallet(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{getOptionWallet1: test.getOptionWallet1, getOptionWallet2: test.getOptionWallet2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{getOptionWallet1: test.getOptionWallet1, getOptionWallet2: test.getOptionWallet2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetOptionWallet(context.Background(), &kabuspb.GetOptionWalletRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1729,16 +2247,16 @@ func Test_server_GetOptionWallet(t *testing.T) {
 func Test_server_GetExchange(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		exchange1               *kabuspb.ExchangeInfo
-		exchange2               error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.ExchangeInfo
-		hasError                bool
+		name      string
+		getToken1 string
+		getToken2 error
+		refresh1  string
+		refresh2  error
+		exchange1 *kabuspb.ExchangeInfo
+		exchange2 error
+		retry     bool
+		want      *kabuspb.ExchangeInfo
+		hasError  bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1748,17 +2266,17 @@ func Test_server_GetExchange(t *testing.T) {
 			exchange2: errors.New("register error message"),
 			hasError:  true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			exchange2:               errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh2:  errors.New("refresh error message"),
+			exchange2: errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			exchange2:               errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1: "TOKEN_STRING",
+			refresh1:  "REFRESHED_TOKEN_STRING",
+			exchange2: errors.New("miss match api key error message"),
+			retry:     true,
+			hasError:  true},
 		{name: "エラーがなければ結果を返す",
 			getToken1: "TOKEN_STRING",
 			exchange1: &kabuspb.ExchangeInfo{
@@ -1784,8 +2302,11 @@ func Test_server_GetExchange(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{exchange1: test.exchange1, exchange2: test.exchange2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{exchange1: test.exchange1, exchange2: test.exchange2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetExchange(context.Background(), &kabuspb.GetExchangeRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1797,16 +2318,16 @@ func Test_server_GetExchange(t *testing.T) {
 func Test_server_GetRegulation(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		regulation1             *kabuspb.Regulation
-		regulation2             error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.Regulation
-		hasError                bool
+		name        string
+		getToken1   string
+		getToken2   error
+		refresh1    string
+		refresh2    error
+		regulation1 *kabuspb.Regulation
+		regulation2 error
+		retry       bool
+		want        *kabuspb.Regulation
+		hasError    bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1816,17 +2337,17 @@ func Test_server_GetRegulation(t *testing.T) {
 			regulation2: errors.New("register error message"),
 			hasError:    true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			regulation2:             errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:   "TOKEN_STRING",
+			refresh2:    errors.New("refresh error message"),
+			regulation2: errors.New("miss match api key error message"),
+			retry:       true,
+			hasError:    true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			regulation2:             errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:   "TOKEN_STRING",
+			refresh1:    "REFRESHED_TOKEN_STRING",
+			regulation2: errors.New("miss match api key error message"),
+			retry:       true,
+			hasError:    true},
 		{name: "エラーがなければ結果を返す",
 			getToken1: "TOKEN_STRING",
 			regulation1: &kabuspb.Regulation{
@@ -1880,8 +2401,11 @@ func Test_server_GetRegulation(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{regulation1: test.regulation1, regulation2: test.regulation2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{regulation1: test.regulation1, regulation2: test.regulation2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetRegulation(context.Background(), &kabuspb.GetRegulationRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1893,16 +2417,16 @@ func Test_server_GetRegulation(t *testing.T) {
 func Test_server_GetPrimaryExchange(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		primaryExchange1        *kabuspb.PrimaryExchange
-		primaryExchange2        error
-		isMissMatchApiKeyError1 bool
-		want                    *kabuspb.PrimaryExchange
-		hasError                bool
+		name             string
+		getToken1        string
+		getToken2        error
+		refresh1         string
+		refresh2         error
+		primaryExchange1 *kabuspb.PrimaryExchange
+		primaryExchange2 error
+		retry            bool
+		want             *kabuspb.PrimaryExchange
+		hasError         bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1912,17 +2436,17 @@ func Test_server_GetPrimaryExchange(t *testing.T) {
 			primaryExchange2: errors.New("register error message"),
 			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			primaryExchange2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh2:         errors.New("refresh error message"),
+			primaryExchange2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			primaryExchange2:        errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:        "TOKEN_STRING",
+			refresh1:         "REFRESHED_TOKEN_STRING",
+			primaryExchange2: errors.New("miss match api key error message"),
+			retry:            true,
+			hasError:         true},
 		{name: "エラーがなければ結果を返す",
 			getToken1: "TOKEN_STRING",
 			primaryExchange1: &kabuspb.PrimaryExchange{
@@ -1940,8 +2464,11 @@ func Test_server_GetPrimaryExchange(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{primaryExchange1: test.primaryExchange1, primaryExchange2: test.primaryExchange2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{primaryExchange1: test.primaryExchange1, primaryExchange2: test.primaryExchange2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetPrimaryExchange(context.Background(), &kabuspb.GetPrimaryExchangeRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -1953,16 +2480,16 @@ func Test_server_GetPrimaryExchange(t *testing.T) {
 func Test_server_GetSoftLimit(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		isMissMatchApiKeyError1 bool
-		softLimit1              *kabuspb.SoftLimit
-		softLimit2              error
-		want                    *kabuspb.SoftLimit
-		hasError                bool
+		name       string
+		getToken1  string
+		getToken2  error
+		refresh1   string
+		refresh2   error
+		retry      bool
+		softLimit1 *kabuspb.SoftLimit
+		softLimit2 error
+		want       *kabuspb.SoftLimit
+		hasError   bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -1972,17 +2499,17 @@ func Test_server_GetSoftLimit(t *testing.T) {
 			softLimit2: errors.New("register error message"),
 			hasError:   true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			softLimit2:              errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:  "TOKEN_STRING",
+			refresh2:   errors.New("refresh error message"),
+			softLimit2: errors.New("miss match api key error message"),
+			retry:      true,
+			hasError:   true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			softLimit2:              errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:  "TOKEN_STRING",
+			refresh1:   "REFRESHED_TOKEN_STRING",
+			softLimit2: errors.New("miss match api key error message"),
+			retry:      true,
+			hasError:   true},
 		{name: "エラーがなければ結果を返す",
 			getToken1: "TOKEN_STRING",
 			softLimit1: &kabuspb.SoftLimit{
@@ -2008,8 +2535,11 @@ func Test_server_GetSoftLimit(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{softLimit1: test.softLimit1, softLimit2: test.softLimit2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{softLimit1: test.softLimit1, softLimit2: test.softLimit2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetSoftLimit(context.Background(), &kabuspb.GetSoftLimitRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -2042,19 +2572,105 @@ func Test_server_GetBoardsStreaming(t *testing.T) {
 	}
 }
 
+func Test_server_GetOrderEventsStreaming(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		connect  error
+		hasError bool
+	}{
+		{name: "connectがerrorならerrorを返す", connect: errors.New("error message"), hasError: true},
+		{name: "connectがnilを返したらnilを返す", connect: nil, hasError: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{orderEventService: &testOrderEventService{connect: test.connect}}
+			got := server.GetOrderEventsStreaming(nil, nil)
+			if (got != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.hasError, got)
+			}
+		})
+	}
+}
+
+func Test_server_GetPositions_maxAge(t *testing.T) {
+	t.Parallel()
+	positionService := &testPositionService{cached2: true}
+	server := &server{positionService: positionService}
+	_, _ = server.GetPositions(context.Background(), &kabuspb.GetPositionsRequest{MaxAgeMs: 1500})
+	if positionService.lastMaxAge != 1500*time.Millisecond {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), 1500*time.Millisecond, positionService.lastMaxAge)
+	}
+}
+
+func Test_server_GetPositionEventsStreaming(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		connect  error
+		hasError bool
+	}{
+		{name: "connectがerrorならerrorを返す", connect: errors.New("error message"), hasError: true},
+		{name: "connectがnilを返したらnilを返す", connect: nil, hasError: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{positionService: &testPositionService{connect: test.connect}}
+			got := server.GetPositionEventsStreaming(nil, nil)
+			if (got != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.hasError, got)
+			}
+		})
+	}
+}
+
+func Test_server_GetAuditLogs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		find1    *kabuspb.AuditLogs
+		find2    error
+		want     *kabuspb.AuditLogs
+		hasError bool
+	}{
+		{name: "監査ログが読めなければエラーを返す", find2: errors.New("error message"), hasError: true},
+		{name: "監査ログを返す",
+			find1: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{{Method: "/kabuspb.KabusService/SendStockOrder"}}},
+			want:  &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{{Method: "/kabuspb.KabusService/SendStockOrder"}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{auditService: &testAuditService{find1: test.find1, find2: test.find2}}
+			got1, got2 := server.GetAuditLogs(context.Background(), &kabuspb.GetAuditLogsRequest{})
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
 func Test_server_MarginPremium(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name                    string
-		getToken1               string
-		getToken2               error
-		refresh1                string
-		refresh2                error
-		isMissMatchApiKeyError1 bool
-		marginPremium1          *kabuspb.MarginPremium
-		marginPremium2          error
-		want                    *kabuspb.MarginPremium
-		hasError                bool
+		name           string
+		getToken1      string
+		getToken2      error
+		refresh1       string
+		refresh2       error
+		retry          bool
+		marginPremium1 *kabuspb.MarginPremium
+		marginPremium2 error
+		want           *kabuspb.MarginPremium
+		hasError       bool
 	}{
 		{name: "token取得でエラーがあればエラーを返す",
 			getToken2: errors.New("get token error message"),
@@ -2064,17 +2680,17 @@ func Test_server_MarginPremium(t *testing.T) {
 			marginPremium2: errors.New("register error message"),
 			hasError:       true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき、再発行でエラーがあればエラーを返す",
-			getToken1:               "TOKEN_STRING",
-			refresh2:                errors.New("refresh error message"),
-			marginPremium2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh2:       errors.New("refresh error message"),
+			marginPremium2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "エラーがAPIキー不一致なら再発行をたたき再発行に成功すれば再度リクエストを送る",
-			getToken1:               "TOKEN_STRING",
-			refresh1:                "REFRESHED_TOKEN_STRING",
-			marginPremium2:          errors.New("miss match api key error message"),
-			isMissMatchApiKeyError1: true,
-			hasError:                true},
+			getToken1:      "TOKEN_STRING",
+			refresh1:       "REFRESHED_TOKEN_STRING",
+			marginPremium2: errors.New("miss match api key error message"),
+			retry:          true,
+			hasError:       true},
 		{name: "エラーがなければ結果を返す",
 			getToken1: "TOKEN_STRING",
 			marginPremium1: &kabuspb.MarginPremium{
@@ -2118,8 +2734,11 @@ func Test_server_MarginPremium(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			server := &server{
-				security:     &testSecurity{marginPremium1: test.marginPremium1, marginPremium2: test.marginPremium2, isMissMatchApiKeyError1: test.isMissMatchApiKeyError1},
-				tokenService: &testTokenService{getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
+				stationService:  &testStationService{},
+				throttleService: &testThrottleService{},
+				quotaService:    &testQuotaService{},
+				security:        &testSecurity{marginPremium1: test.marginPremium1, marginPremium2: test.marginPremium2},
+				tokenService:    &testTokenService{retry: test.retry, getToken1: test.getToken1, getToken2: test.getToken2, refresh1: test.refresh1, refresh2: test.refresh2}}
 			got1, got2 := server.GetMarginPremium(context.Background(), &kabuspb.GetMarginPremiumRequest{})
 			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
 				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
@@ -2127,3 +2746,409 @@ func Test_server_MarginPremium(t *testing.T) {
 		})
 	}
 }
+
+func Test_server_GetThrottleStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status *kabuspb.ThrottleStatus
+		want   *kabuspb.ThrottleStatus
+	}{
+		{name: "throttleServiceの結果が返される",
+			status: &kabuspb.ThrottleStatus{Lanes: []*kabuspb.ThrottleLaneStatus{{Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, QueueDepth: 3, IntervalMs: 200}}},
+			want:   &kabuspb.ThrottleStatus{Lanes: []*kabuspb.ThrottleLaneStatus{{Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, QueueDepth: 3, IntervalMs: 200}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{stationService: &testStationService{}, throttleService: &testThrottleService{status: test.status}}
+			got1, got2 := server.GetThrottleStatus(context.Background(), &kabuspb.GetThrottleStatusRequest{})
+			if !reflect.DeepEqual(test.want, got1) || got2 != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetBoardStreamStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		status *kabuspb.BoardStreamStatus
+		want   *kabuspb.BoardStreamStatus
+	}{
+		{name: "boardStreamServiceの結果が返される",
+			status: &kabuspb.BoardStreamStatus{Subscribers: []*kabuspb.BoardSubscriberStatus{{Id: 1, RequesterName: "foo", Queued: 3, Capacity: 256, Sent: 10, Dropped: 2}}, DroppedTotal: 5},
+			want:   &kabuspb.BoardStreamStatus{Subscribers: []*kabuspb.BoardSubscriberStatus{{Id: 1, RequesterName: "foo", Queued: 3, Capacity: 256, Sent: 10, Dropped: 2}}, DroppedTotal: 5}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{boardStreamService: &testBoardStreamService{status: test.status}}
+			got1, got2 := server.GetBoardStreamStatus(context.Background(), &kabuspb.GetBoardStreamStatusRequest{})
+			if !reflect.DeepEqual(test.want, got1) || got2 != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetBoardWebSocketStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		wsStatus *kabuspb.BoardWebSocketStatus
+		want     *kabuspb.BoardWebSocketStatus
+	}{
+		{name: "boardStreamServiceの結果が返される",
+			wsStatus: &kabuspb.BoardWebSocketStatus{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING, LastError: "error message", ReconnectCount: 2},
+			want:     &kabuspb.BoardWebSocketStatus{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING, LastError: "error message", ReconnectCount: 2}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{boardStreamService: &testBoardStreamService{wsStatus: test.wsStatus}}
+			got1, got2 := server.GetBoardWebSocketStatus(context.Background(), &kabuspb.GetBoardWebSocketStatusRequest{})
+			if !reflect.DeepEqual(test.want, got1) || got2 != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetUsage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		usage []*kabuspb.Usage
+		want  *kabuspb.Usages
+	}{
+		{name: "quotaServiceの結果が返される",
+			usage: []*kabuspb.Usage{{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, Calls: 10, Rejections: 2}},
+			want:  &kabuspb.Usages{Usages: []*kabuspb.Usage{{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, Calls: 10, Rejections: 2}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{quotaService: &testQuotaService{usage: test.usage}}
+			got1, got2 := server.GetUsage(context.Background(), &kabuspb.GetUsageRequest{})
+			if !reflect.DeepEqual(test.want, got1) || got2 != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		tokenService *testTokenService
+		want         *kabuspb.Token
+		hasError     bool
+	}{
+		{name: "token取得でエラーがあればエラーを返す",
+			tokenService: &testTokenService{getToken2: errors.New("get token error message")},
+			hasError:     true},
+		{name: "token取得に成功したらトークンは返さずに有効期限を返す",
+			tokenService: &testTokenService{getToken1: "TOKEN_STRING", getExpiredAt: time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local)},
+			want:         &kabuspb.Token{ExpiredAt: timestamppb.New(time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local))}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{tokenService: test.tokenService}
+			got1, got2 := server.GetToken(context.Background(), &kabuspb.GetTokenRequest{})
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_RefreshToken(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		tokenService *testTokenService
+		want         *kabuspb.Token
+		hasError     bool
+	}{
+		{name: "token再取得でエラーがあればエラーを返す",
+			tokenService: &testTokenService{refresh2: errors.New("refresh error message")},
+			hasError:     true},
+		{name: "token再取得に成功したらトークンは返さずに新しい有効期限を返す",
+			tokenService: &testTokenService{refresh1: "REFRESHED_TOKEN_STRING", getExpiredAt: time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local)},
+			want:         &kabuspb.Token{ExpiredAt: timestamppb.New(time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local))}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{tokenService: test.tokenService}
+			got1, got2 := server.RefreshToken(context.Background(), &kabuspb.RefreshTokenRequest{})
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetStationStatus(t *testing.T) {
+	t.Parallel()
+	want := &kabuspb.StationStatus{Available: false, LastError: "token error message"}
+	server := &server{stationService: &testStationService{status: want}}
+	got1, got2 := server.GetStationStatus(context.Background(), &kabuspb.GetStationStatusRequest{})
+	if !reflect.DeepEqual(want, got1) || got2 != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, got1, got2)
+	}
+}
+
+func Test_server_ActivateKillSwitch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		killSwitchService *testKillSwitchService
+		want              *kabuspb.KillSwitchStatus
+		hasError          bool
+	}{
+		{name: "エラーがあればエラーを返す", killSwitchService: &testKillSwitchService{activate2: status.Error(codes.Internal, "save error")}, hasError: true},
+		{name: "停止できたら状態を返す",
+			killSwitchService: &testKillSwitchService{activate1: &kabuspb.KillSwitchStatus{Active: true, Reason: "emergency"}},
+			want:              &kabuspb.KillSwitchStatus{Active: true, Reason: "emergency"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{killSwitchService: test.killSwitchService}
+			req := &kabuspb.ActivateKillSwitchRequest{Reason: "emergency", CancelOrders: true}
+			got1, got2 := server.ActivateKillSwitch(context.Background(), req)
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError || test.killSwitchService.lastActivate != req {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.want, test.hasError, req, got1, got2, test.killSwitchService.lastActivate)
+			}
+		})
+	}
+}
+
+func Test_server_DeactivateKillSwitch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		killSwitchService *testKillSwitchService
+		want              *kabuspb.KillSwitchStatus
+		hasError          bool
+	}{
+		{name: "エラーがあればエラーを返す", killSwitchService: &testKillSwitchService{deactivate2: status.Error(codes.Internal, "save error")}, hasError: true},
+		{name: "解除できたら状態を返す", killSwitchService: &testKillSwitchService{deactivate1: &kabuspb.KillSwitchStatus{}}, want: &kabuspb.KillSwitchStatus{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{killSwitchService: test.killSwitchService}
+			got1, got2 := server.DeactivateKillSwitch(context.Background(), &kabuspb.DeactivateKillSwitchRequest{})
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_server_GetKillSwitchStatus(t *testing.T) {
+	t.Parallel()
+	want := &kabuspb.KillSwitchStatus{Active: true, VirtualOnly: true, Reason: "emergency"}
+	server := &server{killSwitchService: &testKillSwitchService{status: want}}
+	got1, got2 := server.GetKillSwitchStatus(context.Background(), &kabuspb.GetKillSwitchStatusRequest{})
+	if !reflect.DeepEqual(want, got1) || got2 != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, got1, got2)
+	}
+}
+
+func Test_server_CloseStreams(t *testing.T) {
+	t.Parallel()
+	boardStreamService := &testBoardStreamService{}
+	orderEventService := &testOrderEventService{}
+	positionService := &testPositionService{}
+	server := &server{boardStreamService: boardStreamService, orderEventService: orderEventService, positionService: positionService}
+	server.CloseStreams()
+	if boardStreamService.closeCount != 1 || orderEventService.closeCount != 1 || positionService.closeCount != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), 1, 1, 1, boardStreamService.closeCount, orderEventService.closeCount, positionService.closeCount)
+	}
+}
+
+func Test_server_Shutdown(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		getAll      []*kabuspb.RegisterSymbol
+		getToken2   error
+		unregister2 error
+		want        *kabuspb.UnregisterSymbolsRequest
+		hasError    bool
+	}{
+		{name: "登録した銘柄がなければkabusapiを叩かない", getAll: []*kabuspb.RegisterSymbol{}},
+		{name: "token取得でエラーがあればエラーを返す",
+			getAll:    []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}},
+			getToken2: errors.New("get token error message"),
+			hasError:  true},
+		{name: "登録解除でエラーがあればエラーを返す",
+			getAll:      []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}},
+			unregister2: errors.New("unregister error message"),
+			want:        &kabuspb.UnregisterSymbolsRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}},
+			hasError:    true},
+		{name: "登録した全ての銘柄を登録解除する",
+			getAll: []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}, {SymbolCode: "2345", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}},
+			want:   &kabuspb.UnregisterSymbolsRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1234", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}, {SymbolCode: "2345", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			security := &testSecurity{unregister2: test.unregister2}
+			server := &server{
+				security:              security,
+				tokenService:          &testTokenService{getToken1: "TOKEN_STRING", getToken2: test.getToken2},
+				registerSymbolService: &testRegisterSymbolService{getAll: test.getAll},
+			}
+			got := server.Shutdown(context.Background())
+			if !reflect.DeepEqual(test.want, security.lastUnregister) || (got != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, security.lastUnregister, got)
+			}
+		})
+	}
+}
+
+func Test_server_sendOrder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name              string
+		killSwitchService *testKillSwitchService
+		station           error
+		quota             error
+		riskService       *testRiskService
+		throttleService   *testThrottleService
+		security          *testSecurity
+		send2             error
+		want              *kabuspb.OrderResponse
+		hasError          bool
+		wantCheckCount    int
+		wantSendCount     int
+		wantReleaseCount  int
+		wantUnknown       bool
+	}{
+		{name: "緊急停止中なら発注しない",
+			killSwitchService: &testKillSwitchService{check: status.Error(codes.FailedPrecondition, "kill switch is active")},
+			riskService:       &testRiskService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			hasError:          true},
+		{name: "接続先が使えなければリスクチェックも発注もしない",
+			killSwitchService: &testKillSwitchService{},
+			station:           status.Error(codes.Unavailable, "station is down"),
+			riskService:       &testRiskService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			hasError:          true},
+		{name: "クォータを使い切っていたらリスクチェックも発注もしない",
+			killSwitchService: &testKillSwitchService{},
+			quota:             status.Error(codes.ResourceExhausted, "quota exceeded"),
+			riskService:       &testRiskService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			hasError:          true},
+		{name: "リスクチェックで拒否されたら発注しない",
+			killSwitchService: &testKillSwitchService{},
+			riskService:       &testRiskService{check: status.Error(codes.FailedPrecondition, "rejected")},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			hasError:          true,
+			wantCheckCount:    1},
+		{name: "順番待ちでエラーがあれば予約を戻す",
+			riskService:       &testRiskService{},
+			killSwitchService: &testKillSwitchService{},
+			throttleService:   &testThrottleService{wait: context.DeadlineExceeded},
+			security:          &testSecurity{},
+			hasError:          true,
+			wantCheckCount:    1,
+			wantReleaseCount:  1},
+		{name: "順番待ちの間に緊急停止されたら発注せずに予約を戻す",
+			riskService:       &testRiskService{},
+			killSwitchService: &testKillSwitchService{checks: []error{nil}, check: status.Error(codes.FailedPrecondition, "kill switch is active")},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			hasError:          true,
+			wantCheckCount:    1,
+			wantReleaseCount:  1},
+		{name: "kabusapiのエラーなら予約を戻す",
+			riskService:       &testRiskService{},
+			killSwitchService: &testKillSwitchService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{errorCode: 8},
+			send2:             errors.New("order error message"),
+			hasError:          true,
+			wantCheckCount:    1,
+			wantSendCount:     1,
+			wantReleaseCount:  1},
+		{name: "kabusapiのエラーでなければ注文が届いているかもしれないので予約を戻さず、届いたかわからないエラーにする",
+			riskService:       &testRiskService{},
+			killSwitchService: &testKillSwitchService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			send2:             errors.New("connection error message"),
+			hasError:          true,
+			wantCheckCount:    1,
+			wantSendCount:     1,
+			wantUnknown:       true},
+		{name: "発注できたら結果を返す",
+			riskService:       &testRiskService{},
+			killSwitchService: &testKillSwitchService{},
+			throttleService:   &testThrottleService{},
+			security:          &testSecurity{},
+			want:              &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
+			wantCheckCount:    1,
+			wantSendCount:     1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			server := &server{
+				stationService:    &testStationService{check: test.station},
+				quotaService:      &testQuotaService{use: test.quota},
+				riskService:       test.riskService,
+				killSwitchService: test.killSwitchService,
+				throttleService:   test.throttleService,
+				security:          test.security,
+				tokenService:      &testTokenService{getToken1: "TOKEN_STRING"},
+			}
+			var sendCount int
+			got1, got2 := server.sendOrder(context.Background(), &services.RiskOrder{SymbolCode: "1320"}, func(string) (*kabuspb.OrderResponse, error) {
+				sendCount++
+				return test.want, test.send2
+			})
+			gotUnknown := errors.Unwrap(got2) != nil
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError || test.wantSendCount != sendCount || test.wantReleaseCount != test.riskService.releaseCount ||
+				test.wantUnknown != gotUnknown || test.wantCheckCount != test.riskService.checkCount {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v, %+v, %+v\n", t.Name(),
+					test.want, test.hasError, test.wantSendCount, test.wantReleaseCount, test.wantUnknown, test.wantCheckCount,
+					got1, got2, sendCount, test.riskService.releaseCount, gotUnknown, test.riskService.checkCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewAuditService(store repositories.AuditLogStore, clock repositories.Clock) AuditService {
+	return &audit{store: store, clock: clock}
+}
+
+// AuditService - 発注・取消の呼出しを監査ログに記録し、条件で絞り込んで返す
+type AuditService interface {
+	Begin(method string, clientName string, requester string, req proto.Message) func(res proto.Message, err error)
+	Reject(method string, clientName string, requester string, req proto.Message, err error)
+	Find(req *kabuspb.GetAuditLogsRequest) (*kabuspb.AuditLogs, error)
+}
+
+type audit struct {
+	store repositories.AuditLogStore
+	clock repositories.Clock
+}
+
+// Begin - ハンドラを呼ぶ前にリクエストを監査ログに追記して、結果を渡すと結果を追記する関数を返す、記録に失敗しても発注は取り消せないので呼出しの結果は変えない
+func (s *audit) Begin(method string, clientName string, requester string, req proto.Message) func(res proto.Message, err error) {
+	requestedAt := s.clock.Now()
+	// 結果を受け取る前にサーバーが落ちても、発注しようとしたことは残るようにする
+	s.append(newAuditLog(kabuspb.AuditLogType_AUDIT_LOG_TYPE_REQUESTED, method, clientName, requester, req, requestedAt))
+
+	return func(res proto.Message, err error) {
+		entry := newAuditLog(kabuspb.AuditLogType_AUDIT_LOG_TYPE_RESPONDED, method, clientName, requester, req, requestedAt)
+		entry.Response = redactedJSON(res)
+		entry.RespondedAt = timestamppb.New(s.clock.Now())
+		if orderID := stringField(res, "order_id"); orderID != "" {
+			entry.OrderId = orderID
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		s.append(entry)
+	}
+}
+
+// Reject - 認証か権限の確認で拒否した呼出しを監査ログに追記する
+func (s *audit) Reject(method string, clientName string, requester string, req proto.Message, err error) {
+	now := s.clock.Now()
+	entry := newAuditLog(kabuspb.AuditLogType_AUDIT_LOG_TYPE_REJECTED, method, clientName, requester, req, now)
+	entry.RespondedAt = timestamppb.New(now)
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	s.append(entry)
+}
+
+func (s *audit) append(entry *kabuspb.AuditLog) {
+	if err := s.store.Append(entry); err != nil {
+		log.Println(err) // 発注の結果は返したいので、記録の失敗はログに残すだけにする
+	}
+}
+
+// newAuditLog - リクエストから監査ログを作る
+func newAuditLog(logType kabuspb.AuditLogType, method string, clientName string, requester string, req proto.Message, requestedAt time.Time) *kabuspb.AuditLog {
+	return &kabuspb.AuditLog{
+		Method:      method,
+		ClientName:  clientName,
+		Requester:   requester,
+		SymbolCode:  stringField(req, "symbol_code"),
+		OrderId:     stringField(req, "order_id"),
+		IsVirtual:   boolField(req, "is_virtual"),
+		Request:     redactedJSON(req),
+		RequestedAt: timestamppb.New(requestedAt),
+		LogType:     logType,
+	}
+}
+
+// Find - 監査ログから、呼び出した日時・銘柄コード・ツールの条件を全て満たすものを返す
+func (s *audit) Find(req *kabuspb.GetAuditLogsRequest) (*kabuspb.AuditLogs, error) {
+	logs, err := s.store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*kabuspb.AuditLog, 0)
+	for _, l := range logs {
+		if auditLogMatches(req, l) {
+			res = append(res, l)
+		}
+	}
+	return &kabuspb.AuditLogs{Logs: res}, nil
+}
+
+// auditLogMatches - 監査ログがリクエストの条件を全て満たしているか、条件がゼロ値なら制限しない
+func auditLogMatches(req *kabuspb.GetAuditLogsRequest, l *kabuspb.AuditLog) bool {
+	requestedAt := l.RequestedAt.AsTime()
+	if req.From != nil && !req.From.AsTime().IsZero() && requestedAt.Before(req.From.AsTime()) {
+		return false
+	}
+	if req.To != nil && !req.To.AsTime().IsZero() && !requestedAt.Before(req.To.AsTime()) {
+		return false
+	}
+	if req.SymbolCode != "" && req.SymbolCode != l.SymbolCode {
+		return false
+	}
+	if req.Requester != "" && req.Requester != l.ClientName && req.Requester != l.Requester {
+		return false
+	}
+	return true
+}
+
+// redactedJSON - パスワードを消したメッセージをJSONにする、メッセージがなければ空文字を返す
+func redactedJSON(m proto.Message) string {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return ""
+	}
+
+	m = proto.Clone(m)
+	if fd := m.ProtoReflect().Descriptor().Fields().ByName("password"); fd != nil {
+		m.ProtoReflect().Clear(fd)
+	}
+	b, err := protojson.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// stringField - メッセージに指定した名前の文字列のフィールドがあれば値を返す、なければ空文字を返す
+func stringField(m proto.Message, name protoreflect.Name) string {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return ""
+	}
+	fd := m.ProtoReflect().Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return m.ProtoReflect().Get(fd).String()
+}
+
+// boolField - メッセージに指定した名前の真偽値のフィールドがあれば値を返す、なければfalseを返す
+func boolField(m proto.Message, name protoreflect.Name) bool {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return false
+	}
+	fd := m.ProtoReflect().Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.BoolKind {
+		return false
+	}
+	return m.ProtoReflect().Get(fd).Bool()
+}
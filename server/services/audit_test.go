@@ -0,0 +1,172 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewAuditService(t *testing.T) {
+	store := &testAuditLogStore{}
+	clock := &testClock{}
+	want := &audit{store: store, clock: clock}
+	got := NewAuditService(store, clock)
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_audit_Begin(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		req       proto.Message
+		res       proto.Message
+		err       error
+		appendErr error
+		want      []*kabuspb.AuditLog
+	}{
+		{name: "発注はパスワードを消して記録し、結果の注文番号と一緒にもう一度記録する",
+			req: &kabuspb.SendStockOrderRequest{Password: "Password1234", SymbolCode: "1320", IsVirtual: true},
+			res: &kabuspb.OrderResponse{ResultCode: 0, OrderId: "ORDER-ID"},
+			want: []*kabuspb.AuditLog{
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					SymbolCode: "1320", IsVirtual: true, Request: `{"symbolCode":"1320","isVirtual":true}`,
+					RequestedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_REQUESTED},
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					SymbolCode: "1320", OrderId: "ORDER-ID", IsVirtual: true,
+					Request: `{"symbolCode":"1320","isVirtual":true}`, Response: `{"orderId":"ORDER-ID"}`,
+					RequestedAt: timestamppb.New(now), RespondedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_RESPONDED}}},
+		{name: "取消のエラーはリクエストの注文番号と一緒に記録する",
+			req: &kabuspb.CancelOrderRequest{Password: "Password1234", OrderId: "ORDER-ID"},
+			res: (*kabuspb.OrderResponse)(nil),
+			err: errors.New("error message"),
+			want: []*kabuspb.AuditLog{
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					OrderId: "ORDER-ID", Request: `{"orderId":"ORDER-ID"}`,
+					RequestedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_REQUESTED},
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					OrderId: "ORDER-ID", Request: `{"orderId":"ORDER-ID"}`, Error: "error message",
+					RequestedAt: timestamppb.New(now), RespondedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_RESPONDED}}},
+		{name: "記録に失敗しても止まらない",
+			req:       &kabuspb.CancelOrderRequest{OrderId: "ORDER-ID"},
+			appendErr: errors.New("disk full"),
+			want: []*kabuspb.AuditLog{
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					OrderId: "ORDER-ID", Request: `{"orderId":"ORDER-ID"}`,
+					RequestedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_REQUESTED},
+				{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "trader", Requester: "tool",
+					OrderId: "ORDER-ID", Request: `{"orderId":"ORDER-ID"}`,
+					RequestedAt: timestamppb.New(now), RespondedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_RESPONDED}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &testAuditLogStore{appendErr: test.appendErr}
+			service := &audit{store: store, clock: &testClock{now: now}}
+			done := service.Begin("/kabuspb.KabusService/SendStockOrder", "trader", "tool", test.req)
+			// ハンドラを呼ぶ前に、リクエストだけを記録している
+			if len(store.appended) != 1 {
+				t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), 1, len(store.appended))
+			}
+			done(test.res, test.err)
+			if len(store.appended) != len(test.want) {
+				t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), len(test.want), len(store.appended))
+			}
+			for i, got := range store.appended {
+				got.Request = compactJSON(got.Request)
+				got.Response = compactJSON(got.Response)
+				if !proto.Equal(test.want[i], got) {
+					t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want[i], got)
+				}
+			}
+		})
+	}
+}
+
+func Test_audit_Reject(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.UTC)
+	store := &testAuditLogStore{}
+	service := &audit{store: store, clock: &testClock{now: now}}
+	service.Reject("/kabuspb.KabusService/SendStockOrder", "screener", "tool",
+		&kabuspb.SendStockOrderRequest{Password: "Password1234", SymbolCode: "1320"}, errors.New("permission denied"))
+
+	want := &kabuspb.AuditLog{Method: "/kabuspb.KabusService/SendStockOrder", ClientName: "screener", Requester: "tool",
+		SymbolCode: "1320", Request: `{"symbolCode":"1320"}`, Error: "permission denied",
+		RequestedAt: timestamppb.New(now), RespondedAt: timestamppb.New(now), LogType: kabuspb.AuditLogType_AUDIT_LOG_TYPE_REJECTED}
+	if len(store.appended) != 1 {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), 1, len(store.appended))
+	}
+	got := store.appended[0]
+	got.Request = compactJSON(got.Request)
+	if !proto.Equal(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_audit_Find(t *testing.T) {
+	t.Parallel()
+	at := func(hour int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2021, 9, 13, hour, 0, 0, 0, time.UTC))
+	}
+	log1 := &kabuspb.AuditLog{SymbolCode: "1320", ClientName: "trader", Requester: "tool-a", RequestedAt: at(9)}
+	log2 := &kabuspb.AuditLog{SymbolCode: "7203", ClientName: "trader", Requester: "tool-b", RequestedAt: at(10)}
+	log3 := &kabuspb.AuditLog{ClientName: "admin", RequestedAt: at(11)}
+
+	tests := []struct {
+		name     string
+		all1     []*kabuspb.AuditLog
+		all2     error
+		req      *kabuspb.GetAuditLogsRequest
+		want     *kabuspb.AuditLogs
+		hasError bool
+	}{
+		{name: "読めなければエラーを返す", all2: errors.New("error message"), req: &kabuspb.GetAuditLogsRequest{}, hasError: true},
+		{name: "条件がなければ全て返す", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log1, log2, log3}}},
+		{name: "日時の下限は含み上限は含まない", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{From: at(10), To: at(11)},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log2}}},
+		{name: "ゼロ値の日時は制限しない", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{From: timestamppb.New(time.Time{}), To: at(10)},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log1}}},
+		{name: "銘柄コードで絞り込む", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{SymbolCode: "7203"},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log2}}},
+		{name: "認証したツール名で絞り込む", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{Requester: "trader"},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log1, log2}}},
+		{name: "メタデータのツール名で絞り込む", all1: []*kabuspb.AuditLog{log1, log2, log3}, req: &kabuspb.GetAuditLogsRequest{Requester: "tool-a"},
+			want: &kabuspb.AuditLogs{Logs: []*kabuspb.AuditLog{log1}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &audit{store: &testAuditLogStore{all1: test.all1, all2: test.all2}}
+			got1, got2 := service.Find(test.req)
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
+
+// compactJSON - protojsonが不定に入れる空白を消して比べられるようにする
+func compactJSON(s string) string {
+	res := make([]rune, 0, len(s))
+	for _, r := range s {
+		if r != ' ' {
+			res = append(res, r)
+		}
+	}
+	return string(res)
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewAuthService(setting repositories.Setting) AuthService {
+	return &auth{setting: setting}
+}
+
+// AuthService - ツールの認証と、呼出しの権限の確認
+type AuthService interface {
+	Authenticate(apiKey string, tlsSubject string) (*AuthClient, error)
+	Authorize(client *AuthClient, method string, permissions []kabuspb.Permission) error
+	Priority(client *AuthClient, requested kabuspb.RequestPriority) kabuspb.RequestPriority
+}
+
+// AuthClient - 認証されたツール
+type AuthClient struct {
+	Name        string
+	Permissions []kabuspb.Permission
+}
+
+// anonymousClient - 認証が不要な設定のときのツール、全ての呼出しができる
+var anonymousClient = &AuthClient{Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_ALL}}
+
+type auth struct {
+	setting repositories.Setting
+}
+
+// Authenticate - APIキーかクライアント証明書のCommonNameでツールを特定する、どちらも一致しなければUnauthenticated
+func (s *auth) Authenticate(apiKey string, tlsSubject string) (*AuthClient, error) {
+	if !s.setting.IsAuthRequired() {
+		return anonymousClient, nil
+	}
+
+	if name, permissions, ok := s.setting.ClientByAPIKey(apiKey); ok {
+		return &AuthClient{Name: name, Permissions: permissions}, nil
+	}
+	if name, permissions, ok := s.setting.ClientByTLSSubject(tlsSubject); ok {
+		return &AuthClient{Name: name, Permissions: permissions}, nil
+	}
+	return nil, status.Error(codes.Unauthenticated, "unknown client")
+}
+
+// Authorize - 必要な権限のいずれかをツールが持っているかを確認する、持っていなければPermissionDenied、必要な権限が空なら認証されていれば呼び出せる
+func (s *auth) Authorize(client *AuthClient, method string, permissions []kabuspb.Permission) error {
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	for _, has := range client.Permissions {
+		if has == kabuspb.Permission_PERMISSION_ALL {
+			return nil
+		}
+		for _, p := range permissions {
+			if has == p {
+				return nil
+			}
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "client %s is not permitted to call %s", client.Name, method)
+}
+
+// Priority - 指定されたリクエスト優先度がツールに許された上限を超えていれば上限に抑える、認証が不要な設定なら抑えない
+func (s *auth) Priority(client *AuthClient, requested kabuspb.RequestPriority) kabuspb.RequestPriority {
+	if client.Name == "" {
+		return requested
+	}
+	if max := s.setting.ClientMaxPriority(client.Name); requested > max {
+		return max
+	}
+	return requested
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewAuthService(t *testing.T) {
+	setting := &testSetting{}
+	got := NewAuthService(setting)
+	want := &auth{setting: setting}
+
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_auth_Authenticate(t *testing.T) {
+	t.Parallel()
+	setting := &testSetting{
+		authRequired:   true,
+		apiKeyClients:  map[string]AuthClient{"API_KEY": {Name: "screener", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}}},
+		subjectClients: map[string]AuthClient{"trader": {Name: "trader", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}}},
+	}
+
+	tests := []struct {
+		name       string
+		setting    *testSetting
+		apiKey     string
+		tlsSubject string
+		want       *AuthClient
+		wantCode   codes.Code
+	}{
+		{name: "認証が不要なら全ての権限を持つツールを返す", setting: &testSetting{}, want: anonymousClient, wantCode: codes.OK},
+		{name: "APIキーが一致すればそのツールを返す", setting: setting, apiKey: "API_KEY", tlsSubject: "trader",
+			want:     &AuthClient{Name: "screener", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}},
+			wantCode: codes.OK},
+		{name: "APIキーが一致しなくてもクライアント証明書が一致すればそのツールを返す", setting: setting, apiKey: "UNKNOWN", tlsSubject: "trader",
+			want:     &AuthClient{Name: "trader", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING}},
+			wantCode: codes.OK},
+		{name: "どちらも一致しなければUnauthenticated", setting: setting, apiKey: "UNKNOWN", wantCode: codes.Unauthenticated},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &auth{setting: test.setting}
+			got, err := service.Authenticate(test.apiKey, test.tlsSubject)
+			if !reflect.DeepEqual(test.want, got) || status.Code(err) != test.wantCode {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantCode, got, err)
+			}
+		})
+	}
+}
+
+func Test_auth_Authorize(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		client      *AuthClient
+		permissions []kabuspb.Permission
+		wantCode    codes.Code
+	}{
+		{name: "必要な権限がなければ呼び出せる",
+			client:   &AuthClient{Name: "screener", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}},
+			wantCode: codes.OK},
+		{name: "必要な権限のいずれかを持っていれば呼び出せる",
+			client:      &AuthClient{Name: "trader", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA, kabuspb.Permission_PERMISSION_MARGIN_TRADING}},
+			permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING, kabuspb.Permission_PERMISSION_MARGIN_TRADING},
+			wantCode:    codes.OK},
+		{name: "全ての権限を持っていれば呼び出せる",
+			client:      anonymousClient,
+			permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_ADMIN},
+			wantCode:    codes.OK},
+		{name: "必要な権限を持っていなければPermissionDenied",
+			client:      &AuthClient{Name: "screener", Permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_MARKET_DATA}},
+			permissions: []kabuspb.Permission{kabuspb.Permission_PERMISSION_STOCK_TRADING},
+			wantCode:    codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &auth{}
+			got := service.Authorize(test.client, "/kabuspb.KabusService/SendStockOrder", test.permissions)
+			if status.Code(got) != test.wantCode {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantCode, got)
+			}
+		})
+	}
+}
+
+func Test_auth_Priority(t *testing.T) {
+	t.Parallel()
+	setting := &testSetting{maxPriorities: map[string]kabuspb.RequestPriority{"screener": kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL}}
+	tests := []struct {
+		name      string
+		client    *AuthClient
+		requested kabuspb.RequestPriority
+		want      kabuspb.RequestPriority
+	}{
+		{name: "認証が不要な設定なら抑えない", client: anonymousClient,
+			requested: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH, want: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH},
+		{name: "上限を超えていれば上限に抑える", client: &AuthClient{Name: "screener"},
+			requested: kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH, want: kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL},
+		{name: "上限以下ならそのまま返す", client: &AuthClient{Name: "screener"},
+			requested: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW, want: kabuspb.RequestPriority_REQUEST_PRIORITY_LOW},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &auth{setting: setting}
+			got := service.Priority(test.client, test.requested)
+			if test.want != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -2,57 +2,317 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
 )
 
-func NewBoardStreamService(streamStore repositories.BoardStreamStore, boardWS repositories.BoardWS, virtual repositories.VirtualSecurity) BoardStreamService {
+func NewBoardStreamService(streamStore repositories.BoardStreamStore, boardStore repositories.BoardStore, boardWS repositories.BoardWS, virtual repositories.VirtualSecurity, registerSymbolStore repositories.RegisterSymbolStore, setting repositories.Setting, clock repositories.Clock) BoardStreamService {
 	return &boardStream{
-		streamStore: streamStore,
-		boardWS:     boardWS,
-		virtual:     virtual,
+		streamStore:         streamStore,
+		boardStore:          boardStore,
+		boardWS:             boardWS,
+		virtual:             virtual,
+		registerSymbolStore: registerSymbolStore,
+		setting:             setting,
+		clock:               clock,
+		minBackoff:          time.Second,
+		maxBackoff:          time.Minute,
+		subscribers:         map[int]*boardSubscriber{},
+		done:                make(chan struct{}),
 	}
 }
 
+// errBoardStreamClosed - サーバーの終了でストリームを終了したときのエラー
+var errBoardStreamClosed = status.Error(codes.Unavailable, "server is shutting down")
+
+// errBoardStreamOverflow - 送り切れずにストリームを終了したときのエラー
+var errBoardStreamOverflow = status.Error(codes.ResourceExhausted, "board stream buffer is full")
+
 type BoardStreamService interface {
 	Start()
-	Connect(stream kabuspb.KabusService_GetBoardsStreamingServer) error
+	Connect(req *kabuspb.GetBoardsStreamingRequest, stream kabuspb.KabusService_GetBoardsStreamingServer) error
+	Cached(req *kabuspb.GetBoardRequest, maxAge time.Duration) (*kabuspb.Board, bool)
+	Close()
+	Status() *kabuspb.BoardStreamStatus
+	WebSocketStatus() *kabuspb.BoardWebSocketStatus
 }
 
 type boardStream struct {
-	streamStore repositories.BoardStreamStore
-	boardWS     repositories.BoardWS
-	virtual     repositories.VirtualSecurity
+	streamStore         repositories.BoardStreamStore
+	boardStore          repositories.BoardStore
+	boardWS             repositories.BoardWS
+	virtual             repositories.VirtualSecurity
+	registerSymbolStore repositories.RegisterSymbolStore
+	setting             repositories.Setting
+	clock               repositories.Clock
+	minBackoff          time.Duration            // 切断してから最初に再接続するまでの間隔
+	maxBackoff          time.Duration            // 再接続に失敗し続けたときの間隔の上限
+	subscribers         map[int]*boardSubscriber // ストリームの番号ごとの送るのを待っている板情報
+	droppedTotal        int64
+	disconnectedTotal   int64
+	wsState             kabuspb.BoardWebSocketState
+	connectedAt         time.Time // 最後に接続して板情報を受け取り始めた日時
+	disconnectedAt      time.Time // 最後に切断した日時、ゼロ値なら切断していない
+	nextRetryAt         time.Time
+	lastError           string
+	reconnectCount      int64
+	sessionID           string                      // 今のwebsocketの接続のID
+	sessionCount        int64                       // サーバーの起動から接続を試した回数
+	sequences           map[boardSequenceKey]uint64 // 銘柄ごとに最後に振った通し番号
+	started             bool
+	closed              bool
+	done                chan struct{}
+	mtx                 sync.Mutex
 }
 
+// Start - websocketの接続を見張るgoroutineを始める、既に始まっているか終了していたら何もしない
 func (s *boardStream) Start() {
-	if !s.boardWS.IsConnected() {
-		go func() {
-			err := s.boardWS.Connect(s.onNext)
-			for i := range s.streamStore.All() {
-				s.streamStore.Remove(i, err)
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.started || s.closed {
+		return
+	}
+	s.started = true
+
+	go s.supervise()
+}
+
+// supervise - websocketに接続し、切断されたら終了するまで間隔を倍にしながら再接続する、ストリームは切断しても開いたままにして切断と再接続を通知する
+func (s *boardStream) supervise() {
+	backoff := s.minBackoff
+	for {
+		s.mtx.Lock()
+		if s.closed {
+			s.mtx.Unlock()
+			return
+		}
+		s.wsState = kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTING
+		s.nextRetryAt = time.Time{}
+		s.sessionCount++
+		s.sessionID = fmt.Sprintf("%s-%d", s.clock.Now().Format("20060102150405.000"), s.sessionCount)
+		s.mtx.Unlock()
+
+		err := s.boardWS.Connect(s.onNext)
+		if err != nil {
+			log.Println(err) // デバッグのためにおいとく
+		}
+
+		s.mtx.Lock()
+		if s.closed {
+			s.mtx.Unlock()
+			return
+		}
+		now := s.clock.Now()
+		var notice *kabuspb.Board
+		if s.wsState == kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED {
+			backoff = s.minBackoff
+			s.disconnectedAt = now
+			notice = &kabuspb.Board{Notice: &kabuspb.BoardStreamNotice{
+				State:          kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING,
+				DisconnectedAt: timestamppb.New(now),
+			}}
+			if err != nil {
+				notice.Notice.Error = err.Error()
 			}
-		}()
+		}
+		s.lastError = ""
+		if err != nil {
+			s.lastError = err.Error()
+		}
+		s.wsState = kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING
+		s.nextRetryAt = now.Add(backoff)
+		s.mtx.Unlock()
+
+		if notice != nil {
+			s.publish(notice, false)
+		}
+
+		select {
+		case <-s.done:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+		s.mtx.Lock()
+		s.reconnectCount++
+		s.mtx.Unlock()
 	}
 }
 
-func (s *boardStream) Connect(stream kabuspb.KabusService_GetBoardsStreamingServer) error {
-	ch := make(chan error)
-	s.streamStore.Add(stream, ch)
+func (s *boardStream) Connect(req *kabuspb.GetBoardsStreamingRequest, stream kabuspb.KabusService_GetBoardsStreamingServer) error {
 	s.Start()
-	return <-ch
+
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return errBoardStreamClosed
+	}
+	size, policy := s.setting.BoardStreamBuffer()
+	subscriber := newBoardSubscriber(req.GetRequesterName(), size, policy)
+	// 待ち行列を登録する前に積むので、最新の板情報より後に届いた板情報を取りこぼさない
+	// 溢れたら切断する設定で最新の板情報だけで溢れたら、送り始める前に終了する
+	if req.GetSendSnapshot() {
+		for _, board := range s.boardStore.All() {
+			if !s.matches(req, board) {
+				continue
+			}
+			dropped, overflow := subscriber.push(board)
+			s.droppedTotal += int64(dropped)
+			if overflow {
+				s.disconnectedTotal++
+				s.mtx.Unlock()
+				return errBoardStreamOverflow
+			}
+		}
+	}
+	ch := make(chan error, 1) // Removeが受け取りを待たないようにする
+	seq := s.streamStore.Add(stream, req, ch)
+	s.subscribers[seq] = subscriber
+	s.mtx.Unlock()
+
+	go func() {
+		defer close(subscriber.exited)
+		if err := subscriber.run(stream.Send); err != nil {
+			log.Println(err) // デバッグのためにおいとく
+			s.streamStore.Remove(seq, err)
+		}
+	}()
+
+	err := <-ch
+
+	s.mtx.Lock()
+	delete(s.subscribers, seq)
+	s.mtx.Unlock()
+	subscriber.stop()
+	<-subscriber.exited // 戻った後にSendを呼ばないように、送っている途中なら終わるのを待つ
+	return err
 }
 
-func (s *boardStream) onNext(board *kabuspb.Board) error {
-	for i, stream := range s.streamStore.All() {
-		if err := stream.Send(board); err != nil {
+// Cached - 保持している板情報がmaxAge以内に届いたものであれば返す
+func (s *boardStream) Cached(req *kabuspb.GetBoardRequest, maxAge time.Duration) (*kabuspb.Board, bool) {
+	board, receivedAt, ok := s.boardStore.Get(req.SymbolCode, req.Exchange)
+	if !ok || s.clock.Now().Sub(receivedAt) > maxAge {
+		return nil, false
+	}
+	return board, true
+}
+
+// Close - 全てのストリームをUnavailableで終了してwebsocketを切断する、以降はStartしても接続しない
+func (s *boardStream) Close() {
+	s.mtx.Lock()
+	if !s.closed {
+		close(s.done)
+	}
+	s.closed = true
+	s.wsState = kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CLOSED
+	s.nextRetryAt = time.Time{}
+	seqs := make([]int, 0)
+	for i := range s.streamStore.All() {
+		seqs = append(seqs, i)
+	}
+	s.mtx.Unlock()
+
+	// 終了したストリームはロックを取って後始末をするので、ロックを外してから終了させる
+	for _, i := range seqs {
+		s.streamStore.Remove(i, errBoardStreamClosed)
+	}
+
+	if s.boardWS.IsConnected() {
+		if err := s.boardWS.Disconnect(); err != nil {
 			log.Println(err) // デバッグのためにおいとく
-			s.streamStore.Remove(i, err)
+		}
+	}
+}
+
+// Status - 接続しているストリームごとの配信状況と、起動してから捨てた板情報と切断したストリームの数を返す
+func (s *boardStream) Status() *kabuspb.BoardStreamStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	subscribers := make([]*kabuspb.BoardSubscriberStatus, 0, len(s.subscribers))
+	for i, subscriber := range s.subscribers {
+		status := subscriber.status()
+		status.Id = int32(i)
+		subscribers = append(subscribers, status)
+	}
+	sort.Slice(subscribers, func(i, j int) bool { return subscribers[i].Id < subscribers[j].Id })
+
+	return &kabuspb.BoardStreamStatus{
+		Subscribers:       subscribers,
+		DroppedTotal:      s.droppedTotal,
+		DisconnectedTotal: s.disconnectedTotal,
+	}
+}
+
+// WebSocketStatus - websocketの接続状態を返す
+func (s *boardStream) WebSocketStatus() *kabuspb.BoardWebSocketStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := &kabuspb.BoardWebSocketStatus{
+		State:          s.wsState,
+		ConnectedAt:    timestamppb.New(s.connectedAt),
+		DisconnectedAt: timestamppb.New(s.disconnectedAt),
+		LastError:      s.lastError,
+		ReconnectCount: s.reconnectCount,
+		SessionId:      s.sessionID,
+	}
+	if !s.nextRetryAt.IsZero() {
+		res.NextRetryAt = timestamppb.New(s.nextRetryAt)
+	}
+	return res
+}
+
+// onNext - 板情報をストリームごとの待ち行列に積むだけにして、遅いストリームでwebsocketの受信と仮想証券会社への送信を待たせない
+func (s *boardStream) onNext(board *kabuspb.Board) error {
+	// 接続して最初の板情報で接続済みにし、切断していたなら再接続を通知する
+	now := s.clock.Now()
+
+	s.mtx.Lock()
+	var notice *kabuspb.Board
+	if s.wsState == kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTING {
+		s.wsState = kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED
+		s.connectedAt = now
+		if !s.disconnectedAt.IsZero() {
+			notice = &kabuspb.Board{Notice: &kabuspb.BoardStreamNotice{
+				State:          kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED,
+				DisconnectedAt: timestamppb.New(s.disconnectedAt),
+				ReconnectedAt:  timestamppb.New(now),
+			}}
 		}
 	}
 
+	// 受け取った順に銘柄ごとの通し番号を振り、抜けと遅れをクライアントで確かめられるようにする
+	if s.sequences == nil {
+		s.sequences = map[boardSequenceKey]uint64{}
+	}
+	key := boardSequenceKey{symbolCode: board.SymbolCode, exchange: board.Exchange}
+	s.sequences[key]++
+	board.Sequence = s.sequences[key]
+	board.ReceivedAt = timestamppb.New(now)
+	board.SessionId = s.sessionID
+	s.mtx.Unlock()
+	if notice != nil {
+		s.publish(notice, false)
+	}
+
+	s.boardStore.Save(board, now)
+	s.publish(board, true)
+
 	// 仮想証券会社への価格情報送信
 	go func() {
 		_ = s.virtual.SendPrice(context.Background(), board)
@@ -67,3 +327,186 @@ func (s *boardStream) onNext(board *kabuspb.Board) error {
 	//}
 	return nil
 }
+
+// boardSequenceKey - 通し番号を振る単位の銘柄
+type boardSequenceKey struct {
+	symbolCode string
+	exchange   kabuspb.Exchange
+}
+
+// publish - 板情報をストリームごとの待ち行列に積む、filteredなら条件に合うストリームにだけ積む
+func (s *boardStream) publish(board *kabuspb.Board, filtered bool) {
+	for i := range s.streamStore.All() {
+		s.mtx.Lock()
+		subscriber, ok := s.subscribers[i]
+		s.mtx.Unlock()
+		if !ok || (filtered && !s.matches(s.streamStore.Request(i), board)) {
+			continue
+		}
+
+		dropped, overflow := subscriber.push(board)
+		s.mtx.Lock()
+		s.droppedTotal += int64(dropped)
+		if overflow {
+			s.disconnectedTotal++
+		}
+		s.mtx.Unlock()
+		if overflow {
+			s.streamStore.Remove(i, errBoardStreamOverflow)
+		}
+	}
+}
+
+// matches - 板情報がストリームを開いたときのリクエストの条件に合っているか、条件がなければ全ての板情報を配信する
+func (s *boardStream) matches(req *kabuspb.GetBoardsStreamingRequest, board *kabuspb.Board) bool {
+	if req == nil {
+		return true
+	}
+	if len(req.Symbols) > 0 && !boardInSymbols(req.Symbols, board) {
+		return false
+	}
+	if req.OnlyRegistered && !boardInSymbols(s.registerSymbolStore.GetByRequester(req.RequesterName), board) {
+		return false
+	}
+	return true
+}
+
+// boardInSymbols - 板情報が銘柄のリストのどれかに合っているか、市場が指定なしなら銘柄コードだけで比べる
+func boardInSymbols(symbols []*kabuspb.RegisterSymbol, board *kabuspb.Board) bool {
+	for _, symbol := range symbols {
+		if symbol.SymbolCode != board.GetSymbolCode() {
+			continue
+		}
+		if symbol.Exchange == kabuspb.Exchange_EXCHANGE_UNSPECIFIED || symbol.Exchange == board.GetExchange() {
+			return true
+		}
+	}
+	return false
+}
+
+func newBoardSubscriber(requesterName string, capacity int, policy kabuspb.BoardOverflowPolicy) *boardSubscriber {
+	return &boardSubscriber{
+		requesterName: requesterName,
+		capacity:      capacity,
+		policy:        policy,
+		queue:         make([]*kabuspb.Board, 0, capacity),
+		notify:        make(chan struct{}, 1),
+		done:          make(chan struct{}),
+		exited:        make(chan struct{}),
+	}
+}
+
+// boardSubscriber - ストリームごとの送るのを待っている板情報、ストリームごとのgoroutineで送る
+type boardSubscriber struct {
+	requesterName string
+	capacity      int
+	policy        kabuspb.BoardOverflowPolicy
+	queue         []*kabuspb.Board
+	sent          int64
+	dropped       int64
+	stopped       bool
+	notify        chan struct{} // 板情報を積んだことを送るgoroutineに知らせる
+	done          chan struct{}
+	exited        chan struct{} // 送るgoroutineが終わったら閉じる
+	doneOnce      sync.Once
+	mtx           sync.Mutex
+}
+
+// push - 板情報を積んで、捨てた板情報の数と、送り切れずにストリームを終了すべきかを返す
+func (s *boardSubscriber) push(board *kabuspb.Board) (int, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.stopped {
+		return 0, false
+	}
+
+	dropped := 0
+	// 切断・再接続の通知はまとめない
+	if s.policy == kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_CONFLATE && board.GetNotice() == nil {
+		for i, b := range s.queue {
+			if b.Notice == nil && b.SymbolCode == board.GetSymbolCode() && b.Exchange == board.GetExchange() {
+				s.queue[i] = board
+				s.dropped++
+				return 1, false
+			}
+		}
+	}
+
+	if len(s.queue) >= s.capacity {
+		if s.policy == kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT {
+			s.dropped++
+			s.stopped = true
+			return 1, true
+		}
+		s.queue = s.queue[1:]
+		s.dropped++
+		dropped++
+	}
+	s.queue = append(s.queue, board)
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return dropped, false
+}
+
+// pop - 一番古い板情報を取り出す、なければfalseを返す
+func (s *boardSubscriber) pop() (*kabuspb.Board, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if len(s.queue) == 0 {
+		return nil, false
+	}
+
+	board := s.queue[0]
+	s.queue[0] = nil
+	s.queue = s.queue[1:]
+	return board, true
+}
+
+// run - 止められるまで積まれた板情報を古い順に送る、送れなければエラーを返す
+func (s *boardSubscriber) run(send func(*kabuspb.Board) error) error {
+	for {
+		select {
+		case <-s.done:
+			return nil
+		case <-s.notify:
+		}
+
+		for {
+			board, ok := s.pop()
+			if !ok {
+				break
+			}
+			if err := send(board); err != nil {
+				return err
+			}
+
+			s.mtx.Lock()
+			s.sent++
+			s.mtx.Unlock()
+		}
+	}
+}
+
+// stop - 送るのを止めて、以降は積まない
+func (s *boardSubscriber) stop() {
+	s.mtx.Lock()
+	s.stopped = true
+	s.mtx.Unlock()
+
+	s.doneOnce.Do(func() { close(s.done) })
+}
+
+func (s *boardSubscriber) status() *kabuspb.BoardSubscriberStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &kabuspb.BoardSubscriberStatus{
+		RequesterName: s.requesterName,
+		Queued:        int32(len(s.queue)),
+		Capacity:      int32(s.capacity),
+		Sent:          s.sent,
+		Dropped:       s.dropped,
+	}
+}
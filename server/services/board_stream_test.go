@@ -3,52 +3,46 @@ package services
 import (
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 )
 
 func Test_NewBoardStreamService(t *testing.T) {
 	streamStore := &testBoardStreamStore{}
+	boardStore := &testBoardStore{}
 	boardWS := &testBoardWS{}
 	virtual := &testVirtualSecurity{}
-	got := NewBoardStreamService(streamStore, boardWS, virtual)
-	want := &boardStream{
-		streamStore: streamStore,
-		boardWS:     boardWS,
-		virtual:     virtual,
-	}
+	registerSymbolStore := &testRegisterSymbolStore{}
+	setting := &testSetting{}
+	clock := &testClock{}
+	got := NewBoardStreamService(streamStore, boardStore, boardWS, virtual, registerSymbolStore, setting, clock).(*boardStream)
 
 	t.Parallel()
-	if !reflect.DeepEqual(want, got) {
-		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	if got.streamStore != streamStore || got.boardStore != boardStore || got.boardWS != boardWS || got.virtual != virtual || got.registerSymbolStore != registerSymbolStore ||
+		got.setting != setting || got.clock != clock || got.minBackoff != time.Second || got.maxBackoff != time.Minute ||
+		got.subscribers == nil || got.done == nil {
+		t.Errorf("%s error\ngot: %+v\n", t.Name(), got)
 	}
 }
 
 func Test_boardStream_Connect(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name        string
-		isConnected bool
-		connect     error
-		all         map[int]kabuspb.KabusService_GetBoardsStreamingServer
-		addCount    int
-		removeCount int
+		name    string
+		connect error
+		all     map[int]kabuspb.KabusService_GetBoardsStreamingServer
 	}{
-		{name: "ws接続済みならAddするだけ", isConnected: true, addCount: 1},
-		{name: "ws未接続ならws接続し、connectがreturnすればstoreのstream分だけremoveを叩く",
-			isConnected: false,
-			all:         map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}, 2: &testGetBoardsStreamingServer{}},
-			connect:     errors.New("error message"),
-			addCount:    1,
-			removeCount: 3},
-		{name: "ws未接続ならws接続し、connectがreturnしたときにstoreのstreamがなければremoveは叩かれない",
-			isConnected: false,
-			all:         map[int]kabuspb.KabusService_GetBoardsStreamingServer{},
-			connect:     nil,
-			addCount:    1,
-			removeCount: 0},
+		{name: "Addしてwsに接続し、切断されてもstreamはremoveしない",
+			all:     map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}, 2: &testGetBoardsStreamingServer{}},
+			connect: errors.New("error message")},
+		{name: "Addしてwsに接続し、streamが終了したら待ち行列を消す",
+			all:     map[int]kabuspb.KabusService_GetBoardsStreamingServer{},
+			connect: nil},
 	}
 
 	for _, test := range tests {
@@ -56,12 +50,19 @@ func Test_boardStream_Connect(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			streamStore := &testBoardStreamStore{all: test.all}
-			boardWS := &testBoardWS{isConnected: test.isConnected, connect: test.connect}
-			service := &boardStream{streamStore: streamStore, boardWS: boardWS}
-			_ = service.Connect(nil)
-			time.Sleep(time.Second) // 非同期処理があるの少し待つ
-			if test.addCount != streamStore.addCount || test.removeCount != streamStore.removeCount {
-				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.addCount, test.removeCount, streamStore.addCount, streamStore.removeCount)
+			boardWS := &testBoardWS{connect: test.connect}
+			service := &boardStream{streamStore: streamStore, boardStore: &testBoardStore{}, boardWS: boardWS, setting: &testSetting{boardBufferSize: 1}, clock: &testClock{},
+				minBackoff: time.Hour, maxBackoff: time.Hour, subscribers: map[int]*boardSubscriber{}, done: make(chan struct{})}
+			_ = service.Connect(&kabuspb.GetBoardsStreamingRequest{}, &testGetBoardsStreamingServer{})
+			time.Sleep(100 * time.Millisecond) // 非同期処理があるの少し待つ
+
+			service.mtx.Lock()
+			defer service.mtx.Unlock()
+			boardWS.mtx.Lock()
+			defer boardWS.mtx.Unlock()
+			if streamStore.addCount != 1 || streamStore.removeCount != 0 || len(service.subscribers) != 0 || boardWS.connectCount != 1 {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					1, 0, 0, 1, streamStore.addCount, streamStore.removeCount, len(service.subscribers), boardWS.connectCount)
 			}
 		})
 	}
@@ -70,20 +71,47 @@ func Test_boardStream_Connect(t *testing.T) {
 func Test_boardStream_onNext(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name           string
-		all            map[int]kabuspb.KabusService_GetBoardsStreamingServer
-		hasStream      bool
-		removeCount    int
-		hasError       bool
-		sendPriceCount int
+		name              string
+		all               map[int]kabuspb.KabusService_GetBoardsStreamingServer
+		subscribers       map[int]*boardSubscriber
+		removeCount       int
+		lastRemoveErr     error
+		droppedTotal      int64
+		disconnectedTotal int64
+		sendPriceCount    int
 	}{
-		{name: "allで何もなければsendしない", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{}, hasStream: true, removeCount: 0, sendPriceCount: 1},
-		{name: "sendしたときにerrorがなければremoveされない", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{
-			0: &testGetBoardsStreamingServer{send: nil}, 1: &testGetBoardsStreamingServer{send: nil}, 2: &testGetBoardsStreamingServer{send: nil},
-		}, hasStream: true, removeCount: 0, sendPriceCount: 1},
-		{name: "sendでerrorがあればremoveする", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{
-			0: &testGetBoardsStreamingServer{send: errors.New("error message1")}, 1: &testGetBoardsStreamingServer{send: nil}, 2: &testGetBoardsStreamingServer{send: errors.New("error message1")},
-		}, hasStream: true, removeCount: 2, sendPriceCount: 1},
+		{name: "allで何もなければ積まない", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{}, subscribers: map[int]*boardSubscriber{}, sendPriceCount: 1},
+		{name: "待ち行列に空きがあれば積むだけでremoveしない",
+			all:            map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}},
+			subscribers:    map[int]*boardSubscriber{0: newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST), 1: newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT)},
+			sendPriceCount: 1},
+		{name: "待ち行列のないstreamには積まない",
+			all:            map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}},
+			subscribers:    map[int]*boardSubscriber{},
+			sendPriceCount: 1},
+		{name: "古いものを捨てたら捨てた数を数える",
+			all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}},
+			subscribers: map[int]*boardSubscriber{0: func() *boardSubscriber {
+				s := newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+				s.push(&kabuspb.Board{SymbolCode: "7203"})
+				return s
+			}()},
+			droppedTotal:   1,
+			sendPriceCount: 1},
+		{name: "送り切れずに切断するならResourceExhaustedでremoveする",
+			all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}},
+			subscribers: map[int]*boardSubscriber{
+				0: func() *boardSubscriber {
+					s := newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT)
+					s.push(&kabuspb.Board{SymbolCode: "7203"})
+					return s
+				}(),
+				1: newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT)},
+			removeCount:       1,
+			lastRemoveErr:     errBoardStreamOverflow,
+			droppedTotal:      1,
+			disconnectedTotal: 1,
+			sendPriceCount:    1},
 		// 仮想証券会社連携で切断する処理を停止したので、テストもコメントアウト
 		//{name: "hasStreamがfalseならDisconnectが叩かれる", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{}, hasStream: false, removeCount: 0, disconnectCount: 1},
 		//{name: "hasStreamがfalseならDisconnectでエラーが出てもエラーは返されない", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{}, hasStream: false, disconnect: errors.New("error message"), removeCount: 0, disconnectCount: 1},
@@ -93,16 +121,80 @@ func Test_boardStream_onNext(t *testing.T) {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			streamStore := &testBoardStreamStore{all: test.all, hasStream: test.hasStream}
+			streamStore := &testBoardStreamStore{all: test.all, hasStream: true}
 			boardWS := &testBoardWS{}
 			virtual := &testVirtualSecurity{}
-			service := &boardStream{streamStore: streamStore, boardWS: boardWS, virtual: virtual}
-			got := service.onNext(nil)
+			service := &boardStream{streamStore: streamStore, boardStore: &testBoardStore{}, boardWS: boardWS, virtual: virtual, clock: &testClock{}, subscribers: test.subscribers}
+			got := service.onNext(&kabuspb.Board{SymbolCode: "1320"})
 			time.Sleep(time.Second) // 非同期処理があるの少し待つ
-			if (got != nil) != test.hasError || test.removeCount != streamStore.removeCount || test.sendPriceCount != virtual.sendPriceCount {
-				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
-					test.hasError, test.removeCount, test.sendPriceCount,
-					got, streamStore.removeCount, virtual.sendPriceCount)
+			virtual.mtx.Lock()
+			sendPriceCount := virtual.sendPriceCount
+			virtual.mtx.Unlock()
+			if got != nil || test.removeCount != streamStore.removeCount || !errors.Is(streamStore.lastRemoveErr, test.lastRemoveErr) ||
+				test.droppedTotal != service.droppedTotal || test.disconnectedTotal != service.disconnectedTotal || test.sendPriceCount != sendPriceCount {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v, %+v, %+v\n", t.Name(),
+					test.removeCount, test.lastRemoveErr, test.droppedTotal, test.disconnectedTotal, test.sendPriceCount,
+					got, streamStore.removeCount, streamStore.lastRemoveErr, service.droppedTotal, service.disconnectedTotal, sendPriceCount)
+			}
+		})
+	}
+}
+
+func Test_boardStream_onNext_filter(t *testing.T) {
+	t.Parallel()
+	board := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	tests := []struct {
+		name           string
+		req            *kabuspb.GetBoardsStreamingRequest
+		getByRequester []*kabuspb.RegisterSymbol
+		want           int
+	}{
+		{name: "条件がなければ送る", req: &kabuspb.GetBoardsStreamingRequest{}, want: 1},
+		{name: "リクエストがなければ送る", req: nil, want: 1},
+		{name: "銘柄コードと市場が合えば送る",
+			req:  &kabuspb.GetBoardsStreamingRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}, {SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}},
+			want: 1},
+		{name: "市場が違えば送らない",
+			req:  &kabuspb.GetBoardsStreamingRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_MEISHOU}}},
+			want: 0},
+		{name: "市場が指定なしなら銘柄コードだけで比べる",
+			req:  &kabuspb.GetBoardsStreamingRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1320"}}},
+			want: 1},
+		{name: "ツールが登録した銘柄なら送る",
+			req:            &kabuspb.GetBoardsStreamingRequest{RequesterName: "foo", OnlyRegistered: true},
+			getByRequester: []*kabuspb.RegisterSymbol{{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}},
+			want:           1},
+		{name: "ツールが登録していない銘柄なら送らない",
+			req:            &kabuspb.GetBoardsStreamingRequest{RequesterName: "foo", OnlyRegistered: true},
+			getByRequester: []*kabuspb.RegisterSymbol{{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}},
+			want:           0},
+		{name: "銘柄のリストとツールの登録の両方に合わなければ送らない",
+			req:            &kabuspb.GetBoardsStreamingRequest{Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1320"}}, RequesterName: "foo", OnlyRegistered: true},
+			getByRequester: []*kabuspb.RegisterSymbol{},
+			want:           0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			subscriber := newBoardSubscriber("", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+			streamStore := &testBoardStreamStore{
+				all:      map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}},
+				requests: map[int]*kabuspb.GetBoardsStreamingRequest{0: test.req},
+			}
+			service := &boardStream{
+				streamStore:         streamStore,
+				boardStore:          &testBoardStore{},
+				boardWS:             &testBoardWS{},
+				clock:               &testClock{},
+				virtual:             &testVirtualSecurity{},
+				registerSymbolStore: &testRegisterSymbolStore{getByRequester: test.getByRequester},
+				subscribers:         map[int]*boardSubscriber{0: subscriber},
+			}
+			_ = service.onNext(board)
+			if test.want != len(subscriber.queue) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, len(subscriber.queue))
 			}
 		})
 	}
@@ -111,36 +203,497 @@ func Test_boardStream_onNext(t *testing.T) {
 func Test_boardStream_Start(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name        string
-		isConnected bool
-		connect     error
-		all         map[int]kabuspb.KabusService_GetBoardsStreamingServer
-		removeCount int
+		name             string
+		started          bool
+		closed           bool
+		wantConnectCount int
 	}{
-		{name: "ws接続済みならなにもしない", isConnected: true},
-		{name: "ws未接続ならws接続し、connectがreturnすればstoreのstream分だけremoveを叩く",
-			isConnected: false,
-			all:         map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}, 2: &testGetBoardsStreamingServer{}},
-			connect:     errors.New("error message"),
-			removeCount: 3},
-		{name: "ws未接続ならws接続し、connectがreturnしたときにstoreのstreamがなければremoveは叩かれない",
-			isConnected: false,
-			all:         map[int]kabuspb.KabusService_GetBoardsStreamingServer{},
-			connect:     nil,
-			removeCount: 0},
+		{name: "始まっていなければwsに接続する", wantConnectCount: 1},
+		{name: "既に始まっていれば何もしない", started: true, wantConnectCount: 0},
+		{name: "終了していれば何もしない", closed: true, wantConnectCount: 0},
 	}
 
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
-			streamStore := &testBoardStreamStore{all: test.all}
-			boardWS := &testBoardWS{isConnected: test.isConnected, connect: test.connect}
-			service := &boardStream{streamStore: streamStore, boardWS: boardWS}
+			boardWS := &testBoardWS{connect: errors.New("error message")}
+			service := &boardStream{streamStore: &testBoardStreamStore{}, boardStore: &testBoardStore{}, boardWS: boardWS, clock: &testClock{},
+				minBackoff: time.Hour, maxBackoff: time.Hour, started: test.started, closed: test.closed, done: make(chan struct{})}
 			service.Start()
-			time.Sleep(time.Second) // 非同期処理があるの少し待つ
-			if test.removeCount != streamStore.removeCount {
-				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.removeCount, streamStore.removeCount)
+			service.Start()
+			time.Sleep(100 * time.Millisecond) // 非同期処理があるの少し待つ
+
+			boardWS.mtx.Lock()
+			defer boardWS.mtx.Unlock()
+			if test.wantConnectCount != boardWS.connectCount {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantConnectCount, boardWS.connectCount)
+			}
+		})
+	}
+}
+
+func Test_boardStream_supervise_reconnect(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	board := &kabuspb.Board{SymbolCode: "1320"}
+	subscriber := newBoardSubscriber("", 100, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+	streamStore := &testBoardStreamStore{all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}}}
+	service := &boardStream{
+		streamStore: streamStore,
+		boardStore:  &testBoardStore{},
+		boardWS:     &testBoardWS{connect: errors.New("error message"), boards: []*kabuspb.Board{board}},
+		virtual:     &testVirtualSecurity{},
+		clock:       &testClock{now: now},
+		minBackoff:  10 * time.Millisecond,
+		maxBackoff:  40 * time.Millisecond,
+		subscribers: map[int]*boardSubscriber{0: subscriber},
+		done:        make(chan struct{}),
+	}
+	service.Start()
+	time.Sleep(100 * time.Millisecond)
+	service.mtx.Lock()
+	service.subscribers = map[int]*boardSubscriber{} // Closeで止まる前に積まれたものだけを見る
+	service.mtx.Unlock()
+	service.Close()
+
+	want := []*kabuspb.Board{
+		board,
+		{Notice: &kabuspb.BoardStreamNotice{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING, DisconnectedAt: timestamppb.New(now), Error: "error message"}},
+		{Notice: &kabuspb.BoardStreamNotice{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED, DisconnectedAt: timestamppb.New(now), ReconnectedAt: timestamppb.New(now)}},
+		board,
+	}
+	subscriber.mtx.Lock()
+	defer subscriber.mtx.Unlock()
+	if len(subscriber.queue) < len(want) || !reflect.DeepEqual(want, subscriber.queue[:len(want)]) || streamStore.removeCount != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, 1, subscriber.queue, streamStore.removeCount)
+	}
+}
+
+func Test_boardStream_supervise_backoff(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	subscriber := newBoardSubscriber("", 100, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+	service := &boardStream{
+		streamStore: &testBoardStreamStore{all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}}},
+		boardStore:  &testBoardStore{},
+		boardWS:     &testBoardWS{connect: errors.New("error message")},
+		clock:       &testClock{now: now},
+		minBackoff:  10 * time.Millisecond,
+		maxBackoff:  40 * time.Millisecond,
+		subscribers: map[int]*boardSubscriber{0: subscriber},
+		done:        make(chan struct{}),
+	}
+	service.Start()
+	time.Sleep(200 * time.Millisecond)
+
+	got := service.WebSocketStatus()
+	service.Close()
+	if got.State != kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING || got.LastError != "error message" || got.ReconnectCount < 3 ||
+		!strings.HasPrefix(got.SessionId, "20210913090000.000-") ||
+		!reflect.DeepEqual(timestamppb.New(now.Add(40*time.Millisecond)), got.NextRetryAt) || len(subscriber.queue) != 0 {
+		t.Errorf("%s error\ngot: %+v, %+v\n", t.Name(), got, subscriber.queue)
+	}
+}
+
+func Test_boardStream_WebSocketStatus(t *testing.T) {
+	t.Parallel()
+	connectedAt := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	disconnectedAt := time.Date(2021, 9, 13, 10, 0, 0, 0, time.Local)
+	tests := []struct {
+		name    string
+		service *boardStream
+		want    *kabuspb.BoardWebSocketStatus
+	}{
+		{name: "接続済みなら次に再接続する日時を返さない",
+			service: &boardStream{wsState: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED, connectedAt: connectedAt, sessionID: "20210913090000.000-1"},
+			want: &kabuspb.BoardWebSocketStatus{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CONNECTED,
+				ConnectedAt: timestamppb.New(connectedAt), DisconnectedAt: timestamppb.New(time.Time{}), SessionId: "20210913090000.000-1"}},
+		{name: "再接続を待っていれば次に再接続する日時を返す",
+			service: &boardStream{wsState: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING, connectedAt: connectedAt, disconnectedAt: disconnectedAt,
+				nextRetryAt: disconnectedAt.Add(time.Second), lastError: "error message", reconnectCount: 1},
+			want: &kabuspb.BoardWebSocketStatus{State: kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_RECONNECTING,
+				ConnectedAt: timestamppb.New(connectedAt), DisconnectedAt: timestamppb.New(disconnectedAt), NextRetryAt: timestamppb.New(disconnectedAt.Add(time.Second)),
+				LastError: "error message", ReconnectCount: 1}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.service.WebSocketStatus()
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_boardStream_Close(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		isConnected     bool
+		disconnect      error
+		all             map[int]kabuspb.KabusService_GetBoardsStreamingServer
+		removeCount     int
+		lastRemoveErr   error
+		disconnectCount int
+	}{
+		{name: "streamがなくws未接続ならなにもしない", all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{}},
+		{name: "storeのstream分だけUnavailableでremoveし、ws接続済みなら切断する",
+			isConnected:     true,
+			all:             map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}, 1: &testGetBoardsStreamingServer{}},
+			removeCount:     2,
+			lastRemoveErr:   errBoardStreamClosed,
+			disconnectCount: 1},
+		{name: "切断でエラーが出ても終了する",
+			isConnected:     true,
+			disconnect:      errors.New("error message"),
+			all:             map[int]kabuspb.KabusService_GetBoardsStreamingServer{},
+			disconnectCount: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			streamStore := &testBoardStreamStore{all: test.all}
+			boardWS := &testBoardWS{isConnected: test.isConnected, disconnect: test.disconnect}
+			service := &boardStream{streamStore: streamStore, boardWS: boardWS, done: make(chan struct{})}
+			service.Close()
+			if !service.closed || service.wsState != kabuspb.BoardWebSocketState_BOARD_WEB_SOCKET_STATE_CLOSED || test.removeCount != streamStore.removeCount || !errors.Is(streamStore.lastRemoveErr, test.lastRemoveErr) || test.disconnectCount != boardWS.disconnectCount {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					true, test.removeCount, test.lastRemoveErr, test.disconnectCount,
+					service.closed, streamStore.removeCount, streamStore.lastRemoveErr, boardWS.disconnectCount)
+			}
+		})
+	}
+}
+
+func Test_boardStream_Close_unlocked(t *testing.T) {
+	t.Parallel()
+	streamStore := &testBoardStreamStore{all: map[int]kabuspb.KabusService_GetBoardsStreamingServer{0: &testGetBoardsStreamingServer{}}}
+	service := &boardStream{streamStore: streamStore, boardWS: &testBoardWS{}, done: make(chan struct{})}
+	// 終了を待っている接続がロックを取れなければ、ストリームを終了できずに止まる
+	streamStore.onRemove = func() {
+		service.mtx.Lock()
+		defer service.mtx.Unlock()
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		service.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "closed", "deadlock")
+	}
+}
+
+func Test_boardStream_Connect_closed(t *testing.T) {
+	t.Parallel()
+	streamStore := &testBoardStreamStore{}
+	boardWS := &testBoardWS{}
+	service := &boardStream{streamStore: streamStore, boardWS: boardWS, closed: true}
+	got := service.Connect(nil, nil)
+	if !errors.Is(got, errBoardStreamClosed) || streamStore.addCount != 0 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), errBoardStreamClosed, 0, got, streamStore.addCount)
+	}
+}
+
+func Test_boardStream_Connect_snapshot(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		req  *kabuspb.GetBoardsStreamingRequest
+		want int
+	}{
+		{name: "send_snapshotがfalseなら保持している板情報を送らない",
+			req:  &kabuspb.GetBoardsStreamingRequest{},
+			want: 0},
+		{name: "send_snapshotがtrueなら条件に合う保持している板情報を送る",
+			req:  &kabuspb.GetBoardsStreamingRequest{SendSnapshot: true, Symbols: []*kabuspb.RegisterSymbol{{SymbolCode: "1320"}}},
+			want: 1},
+		{name: "send_snapshotがtrueで条件がなければ保持している板情報を全て送る",
+			req:  &kabuspb.GetBoardsStreamingRequest{SendSnapshot: true},
+			want: 2},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			boardStore := &testBoardStore{all: []*kabuspb.Board{
+				{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+				{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+			}}
+			stream := &testGetBoardsStreamingServer{}
+			service := &boardStream{streamStore: &testBoardStreamStore{}, boardStore: boardStore, boardWS: &testBoardWS{connect: errors.New("error message")},
+				setting: &testSetting{boardBufferSize: 10}, clock: &testClock{}, registerSymbolStore: &testRegisterSymbolStore{},
+				minBackoff: time.Hour, maxBackoff: time.Hour, subscribers: map[int]*boardSubscriber{}, done: make(chan struct{})}
+			_ = service.Connect(test.req, stream)
+			service.Close()
+			if test.want != stream.sendCount {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, stream.sendCount)
+			}
+		})
+	}
+}
+
+func Test_boardStream_Connect_waitSend(t *testing.T) {
+	t.Parallel()
+	boardStore := &testBoardStore{all: []*kabuspb.Board{{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}}}
+	stream := &testGetBoardsStreamingServer{sending: make(chan struct{}, 1), block: make(chan struct{})}
+	service := &boardStream{streamStore: &testBoardStreamStore{}, boardStore: boardStore, boardWS: &testBoardWS{connect: errors.New("error message")},
+		setting: &testSetting{boardBufferSize: 10}, clock: &testClock{}, registerSymbolStore: &testRegisterSymbolStore{},
+		minBackoff: time.Hour, maxBackoff: time.Hour, subscribers: map[int]*boardSubscriber{}, done: make(chan struct{})}
+	defer service.Close()
+
+	done := make(chan struct{})
+	go func() {
+		_ = service.Connect(&kabuspb.GetBoardsStreamingRequest{SendSnapshot: true}, stream)
+		close(done)
+	}()
+	<-stream.sending
+
+	// ストリームが終了しても、送っている途中なら終わるまで戻らない
+	select {
+	case <-done:
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "wait send", "returned")
+	case <-time.After(1500 * time.Millisecond):
+	}
+
+	close(stream.block)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "returned", "timeout")
+	}
+}
+
+func Test_boardStream_Connect_snapshotOverflow(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		policy           kabuspb.BoardOverflowPolicy
+		want             error
+		wantAdd          int
+		wantDropped      int64
+		wantDisconnected int64
+	}{
+		{name: "溢れたら切断する設定なら最新の板情報で溢れたらストリームを登録せずにエラーを返す",
+			policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT, want: errBoardStreamOverflow, wantDropped: 1, wantDisconnected: 1},
+		{name: "古いものから捨てる設定なら捨てた数を数えて接続する",
+			policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST, want: nil, wantAdd: 1, wantDropped: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			boardStore := &testBoardStore{all: []*kabuspb.Board{
+				{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+				{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+			}}
+			streamStore := &testBoardStreamStore{}
+			service := &boardStream{streamStore: streamStore, boardStore: boardStore, boardWS: &testBoardWS{connect: errors.New("error message")},
+				setting: &testSetting{boardBufferSize: 1, boardOverflow: test.policy}, clock: &testClock{}, registerSymbolStore: &testRegisterSymbolStore{},
+				minBackoff: time.Hour, maxBackoff: time.Hour, subscribers: map[int]*boardSubscriber{}, done: make(chan struct{})}
+			got := service.Connect(&kabuspb.GetBoardsStreamingRequest{SendSnapshot: true}, &testGetBoardsStreamingServer{})
+			service.Close()
+			status := service.Status()
+			if !errors.Is(got, test.want) || test.wantAdd != streamStore.addCount || test.wantDropped != status.DroppedTotal || test.wantDisconnected != status.DisconnectedTotal {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.want, test.wantAdd, test.wantDropped, test.wantDisconnected,
+					got, streamStore.addCount, status.DroppedTotal, status.DisconnectedTotal)
+			}
+		})
+	}
+}
+
+func Test_boardStream_Cached(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	board := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	tests := []struct {
+		name   string
+		store  *testBoardStore
+		maxAge time.Duration
+		want1  *kabuspb.Board
+		want2  bool
+	}{
+		{name: "保持していなければfalseを返す", store: &testBoardStore{}, maxAge: time.Second, want1: nil, want2: false},
+		{name: "maxAge以内に届いた板情報なら返す", store: &testBoardStore{get1: board, get2: now.Add(-time.Second), get3: true}, maxAge: time.Second, want1: board, want2: true},
+		{name: "maxAgeより前に届いた板情報なら返さない", store: &testBoardStore{get1: board, get2: now.Add(-time.Second - 1), get3: true}, maxAge: time.Second, want1: nil, want2: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &boardStream{boardStore: test.store, clock: &testClock{now: now}}
+			got1, got2 := service.Cached(&kabuspb.GetBoardRequest{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}, test.maxAge)
+			if !reflect.DeepEqual(test.want1, got1) || test.want2 != got2 {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want1, test.want2, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_boardStream_onNext_save(t *testing.T) {
+	t.Parallel()
+	board := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}
+	boardStore := &testBoardStore{}
+	service := &boardStream{streamStore: &testBoardStreamStore{}, boardStore: boardStore, virtual: &testVirtualSecurity{}, clock: &testClock{},
+		subscribers: map[int]*boardSubscriber{}}
+	_ = service.onNext(board)
+	if !reflect.DeepEqual([]*kabuspb.Board{board}, boardStore.saved) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), []*kabuspb.Board{board}, boardStore.saved)
+	}
+}
+
+func Test_boardStream_onNext_sequence(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	service := &boardStream{streamStore: &testBoardStreamStore{}, boardStore: &testBoardStore{}, virtual: &testVirtualSecurity{}, clock: &testClock{now: now},
+		sessionID: "20210913090000.000-1", sequences: map[boardSequenceKey]uint64{{symbolCode: "1320", exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU}: 10},
+		subscribers: map[int]*boardSubscriber{}}
+	boards := []*kabuspb.Board{
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+		{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU},
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_MEISHOU},
+	}
+	for _, board := range boards {
+		_ = service.onNext(board)
+	}
+
+	want := []*kabuspb.Board{
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, Sequence: 11, ReceivedAt: timestamppb.New(now), SessionId: "20210913090000.000-1"},
+		{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, Sequence: 1, ReceivedAt: timestamppb.New(now), SessionId: "20210913090000.000-1"},
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, Sequence: 12, ReceivedAt: timestamppb.New(now), SessionId: "20210913090000.000-1"},
+		{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_MEISHOU, Sequence: 1, ReceivedAt: timestamppb.New(now), SessionId: "20210913090000.000-1"},
+	}
+	if !reflect.DeepEqual(want, boards) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, boards)
+	}
+}
+
+func Test_boardStream_Status(t *testing.T) {
+	t.Parallel()
+	subscriber := newBoardSubscriber("foo", 2, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+	subscriber.push(&kabuspb.Board{SymbolCode: "1320"})
+	subscriber.sent = 3
+	subscriber.dropped = 1
+	service := &boardStream{
+		subscribers:       map[int]*boardSubscriber{2: subscriber, 0: newBoardSubscriber("bar", 1, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_CONFLATE)},
+		droppedTotal:      5,
+		disconnectedTotal: 1,
+	}
+	want := &kabuspb.BoardStreamStatus{
+		Subscribers: []*kabuspb.BoardSubscriberStatus{
+			{Id: 0, RequesterName: "bar", Capacity: 1},
+			{Id: 2, RequesterName: "foo", Queued: 1, Capacity: 2, Sent: 3, Dropped: 1},
+		},
+		DroppedTotal:      5,
+		DisconnectedTotal: 1,
+	}
+	got := service.Status()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_boardSubscriber_push(t *testing.T) {
+	t.Parallel()
+	b1 := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 100}
+	b2 := &kabuspb.Board{SymbolCode: "7203", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 200}
+	b3 := &kabuspb.Board{SymbolCode: "1320", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 101}
+	b4 := &kabuspb.Board{SymbolCode: "9433", Exchange: kabuspb.Exchange_EXCHANGE_TOUSHOU, CurrentPrice: 300}
+
+	tests := []struct {
+		name        string
+		policy      kabuspb.BoardOverflowPolicy
+		stopped     bool
+		queue       []*kabuspb.Board
+		arg         *kabuspb.Board
+		want1       int
+		want2       bool
+		wantQueue   []*kabuspb.Board
+		wantDropped int64
+	}{
+		{name: "空きがあれば積む", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST,
+			queue: []*kabuspb.Board{b1}, arg: b2, wantQueue: []*kabuspb.Board{b1, b2}},
+		{name: "一番古いものを捨てるなら、いっぱいのとき一番古いものを捨てて積む", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST,
+			queue: []*kabuspb.Board{b1, b2}, arg: b3, want1: 1, wantQueue: []*kabuspb.Board{b2, b3}, wantDropped: 1},
+		{name: "まとめるなら、空きがあっても同じ銘柄を置き換える", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_CONFLATE,
+			queue: []*kabuspb.Board{b1}, arg: b3, want1: 1, wantQueue: []*kabuspb.Board{b3}, wantDropped: 1},
+		{name: "まとめるなら、同じ銘柄がなくいっぱいのとき一番古いものを捨てて積む", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_CONFLATE,
+			queue: []*kabuspb.Board{b1, b2}, arg: b4, want1: 1, wantQueue: []*kabuspb.Board{b2, b4}, wantDropped: 1},
+		{name: "切断するなら、いっぱいのとき積まずに切断を求める", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT,
+			queue: []*kabuspb.Board{b1, b2}, arg: b4, want1: 1, want2: true, wantQueue: []*kabuspb.Board{b1, b2}, wantDropped: 1},
+		{name: "止まっていれば積まない", policy: kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DISCONNECT, stopped: true,
+			queue: []*kabuspb.Board{b1, b2}, arg: b4, wantQueue: []*kabuspb.Board{b1, b2}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			subscriber := newBoardSubscriber("", 2, test.policy)
+			subscriber.queue = append(subscriber.queue, test.queue...)
+			subscriber.stopped = test.stopped
+			got1, got2 := subscriber.push(test.arg)
+			if test.want1 != got1 || test.want2 != got2 || !reflect.DeepEqual(test.wantQueue, subscriber.queue) || test.wantDropped != subscriber.dropped {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.want1, test.want2, test.wantQueue, test.wantDropped, got1, got2, subscriber.queue, subscriber.dropped)
+			}
+		})
+	}
+}
+
+func Test_boardSubscriber_run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		send     error
+		want     int64
+		hasError bool
+	}{
+		{name: "積まれた板情報を古い順に送り、止められたらnilを返す", want: 2},
+		{name: "送れなければエラーを返す", send: errors.New("error message"), want: 0, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			subscriber := newBoardSubscriber("", 2, kabuspb.BoardOverflowPolicy_BOARD_OVERFLOW_POLICY_DROP_OLDEST)
+			subscriber.push(&kabuspb.Board{SymbolCode: "1320"})
+			subscriber.push(&kabuspb.Board{SymbolCode: "7203"})
+
+			sent := make([]string, 0)
+			errCh := make(chan error)
+			go func() {
+				errCh <- subscriber.run(func(board *kabuspb.Board) error {
+					if test.send != nil {
+						return test.send
+					}
+					sent = append(sent, board.SymbolCode)
+					return nil
+				})
+			}()
+			time.Sleep(100 * time.Millisecond)
+			subscriber.stop()
+			got := <-errCh
+
+			subscriber.mtx.Lock()
+			defer subscriber.mtx.Unlock()
+			if test.want != subscriber.sent || (got != nil) != test.hasError || (test.send == nil && !reflect.DeepEqual([]string{"1320", "7203"}, sent)) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.want, test.hasError, subscriber.sent, got, sent)
 			}
 		})
 	}
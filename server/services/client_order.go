@@ -0,0 +1,128 @@
+package services
+
+import (
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewClientOrderService(clientOrderStore repositories.ClientOrderStore) ClientOrderService {
+	return &clientOrder{
+		clientOrderStore: clientOrderStore,
+		sending:          map[clientOrderKey]bool{},
+		unknown:          map[clientOrderKey]bool{},
+	}
+}
+
+// orderResultUnknownError - 通信エラーなどで、kabusapiが注文を受け付けたかわからないエラー
+type orderResultUnknownError struct {
+	err error
+}
+
+func (e *orderResultUnknownError) Error() string { return e.err.Error() }
+func (e *orderResultUnknownError) Unwrap() error { return e.err }
+
+// OrderResultUnknown - kabusapiが注文を受け付けたかわからないエラーとして包む、Sendは包みを外して返し、同じクライアント注文IDでの再送を止める
+func OrderResultUnknown(err error) error {
+	return &orderResultUnknownError{err: err}
+}
+
+// ClientOrderService - クライアント注文IDで発注を冪等にする、クライアント注文IDはツールごとに別々に扱う
+type ClientOrderService interface {
+	Send(owner string, clientOrderID string, send func() (*kabuspb.OrderResponse, error)) (*kabuspb.OrderResponse, error)
+	Attach(owner string, clientOrderID string, orders []*kabuspb.Order) []*kabuspb.Order
+	AttachAll(orders []*kabuspb.Order) []*kabuspb.Order
+}
+
+// clientOrderKey - 発注したツールとクライアント注文ID
+type clientOrderKey struct {
+	owner         string
+	clientOrderID string
+}
+
+type clientOrder struct {
+	clientOrderStore repositories.ClientOrderStore
+	sending          map[clientOrderKey]bool // 発注中のクライアント注文ID
+	unknown          map[clientOrderKey]bool // 注文が届いたかわからないクライアント注文ID
+	mtx              sync.Mutex
+}
+
+// Send - ツールがクライアント注文IDで発注済みなら最初の発注の結果を返し、まだなら発注して結果を保存する
+//
+//	同じツールが同じクライアント注文IDで発注中ならAbortedを返す
+//	kabusapiのエラーで注文が届いていなければ保存しないので、同じクライアント注文IDで再送できる
+//	注文が届いたかわからないエラーなら、二重に発注しないように同じクライアント注文IDでの再送にAbortedを返し続ける
+func (s *clientOrder) Send(owner string, clientOrderID string, send func() (*kabuspb.OrderResponse, error)) (*kabuspb.OrderResponse, error) {
+	if clientOrderID == "" {
+		res, err := send()
+		return res, unwrapOrderResultUnknown(err)
+	}
+
+	key := clientOrderKey{owner: owner, clientOrderID: clientOrderID}
+	s.mtx.Lock()
+	if res, ok := s.clientOrderStore.Get(owner, clientOrderID); ok {
+		s.mtx.Unlock()
+		return res, nil
+	}
+	if s.sending[key] {
+		s.mtx.Unlock()
+		return nil, status.Errorf(codes.Aborted, "order with client_order_id %s is in progress", clientOrderID)
+	}
+	if s.unknown[key] {
+		s.mtx.Unlock()
+		return nil, status.Errorf(codes.Aborted, "result of order with client_order_id %s is unknown, check GetOrders before sending it with a new client_order_id", clientOrderID)
+	}
+	s.sending[key] = true
+	s.mtx.Unlock()
+
+	res, err := send()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.sending, key)
+	var unknown *orderResultUnknownError
+	if errors.As(err, &unknown) {
+		s.unknown[key] = true
+	}
+	if err == nil && res != nil {
+		s.clientOrderStore.Save(owner, clientOrderID, res)
+	}
+	return res, unwrapOrderResultUnknown(err)
+}
+
+// unwrapOrderResultUnknown - 注文が届いたかわからないエラーなら包みを外して元のエラーを返す
+func unwrapOrderResultUnknown(err error) error {
+	var unknown *orderResultUnknownError
+	if errors.As(err, &unknown) {
+		return unknown.err
+	}
+	return err
+}
+
+// Attach - ツールが発注した注文にだけクライアント注文IDを入れて、クライアント注文IDが一致する注文だけを返す、クライアント注文IDが空なら全ての注文を返す
+func (s *clientOrder) Attach(owner string, clientOrderID string, orders []*kabuspb.Order) []*kabuspb.Order {
+	res := make([]*kabuspb.Order, 0, len(orders))
+	for _, o := range orders {
+		o.ClientOrderId = ""
+		if sentBy, id, ok := s.clientOrderStore.GetClientOrderID(o.Id); ok && sentBy == owner {
+			o.ClientOrderId = id
+		}
+		if clientOrderID == "" || o.ClientOrderId == clientOrderID {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+// AttachAll - どのツールが発注したかに関わらず注文にクライアント注文IDを入れる、ツールを区別しない注文イベントと注文履歴で使う
+func (s *clientOrder) AttachAll(orders []*kabuspb.Order) []*kabuspb.Order {
+	for _, o := range orders {
+		_, o.ClientOrderId, _ = s.clientOrderStore.GetClientOrderID(o.Id)
+	}
+	return orders
+}
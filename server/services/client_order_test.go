@@ -0,0 +1,174 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewClientOrderService(t *testing.T) {
+	store := &testClientOrderStore{}
+	got := NewClientOrderService(store)
+	want := &clientOrder{clientOrderStore: store, sending: map[clientOrderKey]bool{}, unknown: map[clientOrderKey]bool{}}
+
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_clientOrder_Send(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		clientOrderID string
+		store         *testClientOrderStore
+		sending       map[clientOrderKey]bool
+		send1         *kabuspb.OrderResponse
+		send2         error
+		want1         *kabuspb.OrderResponse
+		wantCode      codes.Code
+		wantSendCount int
+		wantSaved     []*kabuspb.OrderResponse
+	}{
+		{name: "クライアント注文IDがなければ毎回発注する",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{{owner: "trader"}: {OrderId: "OLD-ORDER-ID"}}},
+			sending:       map[clientOrderKey]bool{},
+			send1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			want1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantSendCount: 1},
+		{name: "発注済みなら発注せずに最初の結果を返す",
+			clientOrderID: "client-1",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{{owner: "trader", clientOrderID: "client-1"}: {OrderId: "OLD-ORDER-ID"}}},
+			sending:       map[clientOrderKey]bool{},
+			send1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			want1:         &kabuspb.OrderResponse{OrderId: "OLD-ORDER-ID"}},
+		{name: "同じクライアント注文IDで発注中ならAbortedを返す",
+			clientOrderID: "client-1",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{}},
+			sending:       map[clientOrderKey]bool{{owner: "trader", clientOrderID: "client-1"}: true},
+			send1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantCode:      codes.Aborted},
+		{name: "他のツールが同じクライアント注文IDで発注済みでも発注する",
+			clientOrderID: "client-1",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{{owner: "screener", clientOrderID: "client-1"}: {OrderId: "OLD-ORDER-ID"}}},
+			sending:       map[clientOrderKey]bool{{owner: "screener", clientOrderID: "client-1"}: true},
+			send1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			want1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantSendCount: 1,
+			wantSaved:     []*kabuspb.OrderResponse{{OrderId: "ORDER-ID"}}},
+		{name: "kabusapiが受け付けなかったエラーなら保存しない",
+			clientOrderID: "client-1",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{}},
+			sending:       map[clientOrderKey]bool{},
+			send2:         status.Error(codes.Unavailable, "unavailable"),
+			wantCode:      codes.Unavailable,
+			wantSendCount: 1},
+		{name: "発注できたら結果を保存して返す",
+			clientOrderID: "client-1",
+			store:         &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{}},
+			sending:       map[clientOrderKey]bool{},
+			send1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			want1:         &kabuspb.OrderResponse{OrderId: "ORDER-ID"},
+			wantSendCount: 1,
+			wantSaved:     []*kabuspb.OrderResponse{{OrderId: "ORDER-ID"}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &clientOrder{clientOrderStore: test.store, sending: test.sending, unknown: map[clientOrderKey]bool{}}
+			var sendCount int
+			got1, got2 := service.Send("trader", test.clientOrderID, func() (*kabuspb.OrderResponse, error) {
+				sendCount++
+				return test.send1, test.send2
+			})
+			if !reflect.DeepEqual(test.want1, got1) || status.Code(got2) != test.wantCode || test.wantSendCount != sendCount || !reflect.DeepEqual(test.wantSaved, test.store.saved) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.want1, test.wantCode, test.wantSendCount, test.wantSaved, got1, got2, sendCount, test.store.saved)
+			}
+		})
+	}
+}
+
+func Test_clientOrder_Send_unknown(t *testing.T) {
+	t.Parallel()
+	service := &clientOrder{clientOrderStore: &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{}}, sending: map[clientOrderKey]bool{}, unknown: map[clientOrderKey]bool{}}
+	timeout := status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	var sendCount int
+	send := func() (*kabuspb.OrderResponse, error) {
+		sendCount++
+		return nil, OrderResultUnknown(timeout)
+	}
+
+	_, got1 := service.Send("trader", "client-1", send)
+	_, got2 := service.Send("trader", "client-1", send)
+	_, got3 := service.Send("screener", "client-1", send)
+	_, got4 := service.Send("trader", "", send)
+	if got1 != timeout || status.Code(got2) != codes.Aborted || got3 != timeout || got4 != timeout || sendCount != 3 {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v, %+v\n", t.Name(),
+			timeout, codes.Aborted, timeout, timeout, 3, got1, got2, got3, got4, sendCount)
+	}
+}
+
+func Test_clientOrder_Send_release(t *testing.T) {
+	t.Parallel()
+	service := &clientOrder{clientOrderStore: &testClientOrderStore{responses: map[clientOrderKey]*kabuspb.OrderResponse{}}, sending: map[clientOrderKey]bool{}, unknown: map[clientOrderKey]bool{}}
+	_, _ = service.Send("trader", "client-1", func() (*kabuspb.OrderResponse, error) { return nil, errors.New("error message") })
+	if len(service.sending) != 0 {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), map[clientOrderKey]bool{}, service.sending)
+	}
+}
+
+func Test_clientOrder_Attach(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		clientOrderID string
+		want          []*kabuspb.Order
+	}{
+		{name: "クライアント注文IDがなければ全ての注文を返し、ツールが発注した注文にだけクライアント注文IDを入れる",
+			want: []*kabuspb.Order{{Id: "ORDER-1", ClientOrderId: "client-1"}, {Id: "ORDER-2"}, {Id: "ORDER-3", ClientOrderId: "client-3"}, {Id: "ORDER-4"}}},
+		{name: "クライアント注文IDがあればツールが発注した一致する注文だけを返す",
+			clientOrderID: "client-1",
+			want:          []*kabuspb.Order{{Id: "ORDER-1", ClientOrderId: "client-1"}}},
+		{name: "一致する注文がなければ空配列を返す",
+			clientOrderID: "client-2",
+			want:          []*kabuspb.Order{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &clientOrder{clientOrderStore: &testClientOrderStore{clientOrderIDs: map[string]clientOrderKey{
+				"ORDER-1": {owner: "trader", clientOrderID: "client-1"},
+				"ORDER-3": {owner: "trader", clientOrderID: "client-3"},
+				"ORDER-4": {owner: "screener", clientOrderID: "client-1"},
+			}}}
+			got := service.Attach("trader", test.clientOrderID, []*kabuspb.Order{{Id: "ORDER-1"}, {Id: "ORDER-2"}, {Id: "ORDER-3"}, {Id: "ORDER-4"}})
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_clientOrder_AttachAll(t *testing.T) {
+	t.Parallel()
+	service := &clientOrder{clientOrderStore: &testClientOrderStore{clientOrderIDs: map[string]clientOrderKey{
+		"ORDER-1": {owner: "trader", clientOrderID: "client-1"},
+		"ORDER-3": {owner: "screener", clientOrderID: "client-3"},
+	}}}
+	want := []*kabuspb.Order{{Id: "ORDER-1", ClientOrderId: "client-1"}, {Id: "ORDER-2"}, {Id: "ORDER-3", ClientOrderId: "client-3"}}
+	got := service.AttachAll([]*kabuspb.Order{{Id: "ORDER-1"}, {Id: "ORDER-2"}, {Id: "ORDER-3"}})
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewKillSwitchService(
+	killSwitchStore repositories.KillSwitchStore,
+	security repositories.Security,
+	tokenService TokenService,
+	throttleService ThrottleService,
+	clock repositories.Clock) KillSwitchService {
+	return &killSwitch{
+		killSwitchStore: killSwitchStore,
+		security:        security,
+		tokenService:    tokenService,
+		throttleService: throttleService,
+		clock:           clock,
+	}
+}
+
+// KillSwitchService - 実注文の新規発注を止める緊急停止
+type KillSwitchService interface {
+	Activate(ctx context.Context, req *kabuspb.ActivateKillSwitchRequest) (*kabuspb.KillSwitchStatus, error)
+	Deactivate() (*kabuspb.KillSwitchStatus, error)
+	Status() *kabuspb.KillSwitchStatus
+	Check() error
+	IsVirtualOnly() bool
+}
+
+type killSwitch struct {
+	killSwitchStore repositories.KillSwitchStore
+	security        repositories.Security
+	tokenService    TokenService
+	throttleService ThrottleService
+	clock           repositories.Clock
+	mtx             sync.Mutex
+}
+
+// Activate - 停止中にして保存する、指定があれば未約定の注文を全て取り消して結果を保存する、保存できなくても停止は解除しない
+func (s *killSwitch) Activate(ctx context.Context, req *kabuspb.ActivateKillSwitchRequest) (*kabuspb.KillSwitchStatus, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	st := &kabuspb.KillSwitchStatus{
+		Active:      true,
+		VirtualOnly: req.VirtualOnly,
+		Reason:      req.Reason,
+		ActivatedAt: timestamppb.New(s.clock.Now()),
+		Cancels:     []*kabuspb.KillSwitchCancel{},
+	}
+	s.save(st)
+	if !req.CancelOrders {
+		return st, nil
+	}
+
+	cancels, err := s.cancelOrders(ctx, req.Password)
+	if err != nil {
+		return nil, err // 停止はしているが、注文一覧が取れないので取消できていない
+	}
+	st.Cancels = cancels
+	s.save(st)
+	return st, nil
+}
+
+// save - 状態を保存する、ストアは保存できなくても状態を変えるので、エラーは状態に含める
+func (s *killSwitch) save(st *kabuspb.KillSwitchStatus) {
+	st.SaveError = ""
+	if err := s.killSwitchStore.Save(st); err != nil {
+		st.SaveError = err.Error()
+	}
+}
+
+// cancelOrders - 未約定の注文を全て取り消す、取消できなかった注文があっても残りの注文の取消を続ける
+func (s *killSwitch) cancelOrders(ctx context.Context, password string) ([]*kabuspb.KillSwitchCancel, error) {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	var orders *kabuspb.Orders
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		orders, err = s.security.Orders(ctx, token, &kabuspb.GetOrdersRequest{Product: kabuspb.Product_PRODUCT_ALL})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cancels := make([]*kabuspb.KillSwitchCancel, 0)
+	for _, o := range orders.Orders {
+		if o.OrderState == kabuspb.OrderState_ORDER_STATE_DONE {
+			continue
+		}
+
+		cancel := &kabuspb.KillSwitchCancel{OrderId: o.Id}
+		err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH)
+		if err != nil {
+			err = status.FromContextError(err).Err()
+		} else {
+			err = s.tokenService.Do(ctx, func(token string) error {
+				_, err := s.security.CancelOrder(ctx, token, &kabuspb.CancelOrderRequest{Password: password, OrderId: o.Id})
+				return err
+			})
+		}
+		if err != nil {
+			cancel.Error = err.Error()
+		} else {
+			cancel.Canceled = true
+		}
+		cancels = append(cancels, cancel)
+	}
+	return cancels, nil
+}
+
+// Deactivate - 停止を解除して保存する、保存できなければ再起動で停止中に戻るので、保存のエラーを状態に含めて返す
+func (s *killSwitch) Deactivate() (*kabuspb.KillSwitchStatus, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	st := &kabuspb.KillSwitchStatus{}
+	s.save(st)
+	return st, nil
+}
+
+func (s *killSwitch) Status() *kabuspb.KillSwitchStatus {
+	return s.killSwitchStore.Get()
+}
+
+// Check - 停止中ならFailedPreconditionのエラーを返す
+func (s *killSwitch) Check() error {
+	if st := s.killSwitchStore.Get(); st.Active {
+		return status.Errorf(codes.FailedPrecondition, "kill switch is active: %s", st.Reason)
+	}
+	return nil
+}
+
+// IsVirtualOnly - 停止中で、現物・信用の発注を仮想証券会社に送るか
+func (s *killSwitch) IsVirtualOnly() bool {
+	st := s.killSwitchStore.Get()
+	return st.Active && st.VirtualOnly
+}
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewKillSwitchService(t *testing.T) {
+	store := &testKillSwitchStore{}
+	security := &testSecurity{}
+	tokenService := &testTokenService{}
+	throttleService := &testThrottleService{}
+	clock := &testClock{}
+	got := NewKillSwitchService(store, security, tokenService, throttleService, clock)
+	want := &killSwitch{killSwitchStore: store, security: security, tokenService: tokenService, throttleService: throttleService, clock: clock}
+
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_killSwitch_Activate(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	orders := &kabuspb.Orders{Orders: []*kabuspb.Order{
+		{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED},
+		{Id: "ORDER-2", OrderState: kabuspb.OrderState_ORDER_STATE_DONE},
+		{Id: "ORDER-3", OrderState: kabuspb.OrderState_ORDER_STATE_WAIT},
+	}}
+
+	tests := []struct {
+		name         string
+		store        *testKillSwitchStore
+		security     *testSecurity
+		req          *kabuspb.ActivateKillSwitchRequest
+		want         *kabuspb.KillSwitchStatus
+		wantCode     codes.Code
+		wantCanceled []string
+		wantSaved    int
+	}{
+		{name: "停止中にして保存する",
+			store:     &testKillSwitchStore{},
+			security:  &testSecurity{},
+			req:       &kabuspb.ActivateKillSwitchRequest{Reason: "emergency", VirtualOnly: true},
+			want:      &kabuspb.KillSwitchStatus{Active: true, VirtualOnly: true, Reason: "emergency", ActivatedAt: timestamppb.New(now), Cancels: []*kabuspb.KillSwitchCancel{}},
+			wantCode:  codes.OK,
+			wantSaved: 1},
+		{name: "保存できなくても停止して、保存のエラーを返す",
+			store:    &testKillSwitchStore{save: errors.New("save error message")},
+			security: &testSecurity{},
+			req:      &kabuspb.ActivateKillSwitchRequest{Reason: "emergency"},
+			want:     &kabuspb.KillSwitchStatus{Active: true, Reason: "emergency", ActivatedAt: timestamppb.New(now), Cancels: []*kabuspb.KillSwitchCancel{}, SaveError: "save error message"},
+			wantCode: codes.OK},
+		{name: "指定があれば未約定の注文を全て取り消して、取消の結果も保存する",
+			store:    &testKillSwitchStore{},
+			security: &testSecurity{orders: orders, cancel: map[string]error{"ORDER-3": status.Error(codes.FailedPrecondition, "already canceled")}},
+			req:      &kabuspb.ActivateKillSwitchRequest{Reason: "emergency", CancelOrders: true, Password: "Password1234"},
+			want: &kabuspb.KillSwitchStatus{Active: true, Reason: "emergency", ActivatedAt: timestamppb.New(now), Cancels: []*kabuspb.KillSwitchCancel{
+				{OrderId: "ORDER-1", Canceled: true},
+				{OrderId: "ORDER-3", Error: "rpc error: code = FailedPrecondition desc = already canceled"},
+			}},
+			wantCode:     codes.OK,
+			wantCanceled: []string{"ORDER-1", "ORDER-3"},
+			wantSaved:    2},
+		{name: "注文一覧が取れなければ停止したままエラーを返す",
+			store:     &testKillSwitchStore{},
+			security:  &testSecurity{ordersErr: status.Error(codes.Unavailable, "unavailable")},
+			req:       &kabuspb.ActivateKillSwitchRequest{Reason: "emergency", CancelOrders: true},
+			wantCode:  codes.Unavailable,
+			wantSaved: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &killSwitch{
+				killSwitchStore: test.store,
+				security:        test.security,
+				tokenService:    &testTokenService{},
+				throttleService: &testThrottleService{},
+				clock:           &testClock{now: now},
+			}
+			got, err := service.Activate(context.Background(), test.req)
+			if !proto.Equal(test.want, got) || status.Code(err) != test.wantCode || !reflect.DeepEqual(test.wantCanceled, test.security.canceled) || len(test.store.saved) != test.wantSaved || !test.store.status.Active {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.want, test.wantCode, test.wantCanceled, test.wantSaved,
+					got, err, test.security.canceled, len(test.store.saved))
+			}
+		})
+	}
+}
+
+func Test_killSwitch_Deactivate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		store    *testKillSwitchStore
+		want     *kabuspb.KillSwitchStatus
+		hasError bool
+	}{
+		{name: "停止を解除して保存する", store: &testKillSwitchStore{status: &kabuspb.KillSwitchStatus{Active: true}}, want: &kabuspb.KillSwitchStatus{}},
+		{name: "保存できなくても解除して、保存のエラーを返す", store: &testKillSwitchStore{status: &kabuspb.KillSwitchStatus{Active: true}, save: errors.New("save error message")}, want: &kabuspb.KillSwitchStatus{SaveError: "save error message"}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &killSwitch{killSwitchStore: test.store}
+			got, err := service.Deactivate()
+			if !proto.Equal(test.want, got) || (err != nil) != test.hasError || test.store.status.Active {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_killSwitch_Check(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		status          *kabuspb.KillSwitchStatus
+		wantCode        codes.Code
+		wantVirtualOnly bool
+	}{
+		{name: "停止していなければ通す", status: &kabuspb.KillSwitchStatus{VirtualOnly: true}, wantCode: codes.OK},
+		{name: "停止中ならFailedPrecondition", status: &kabuspb.KillSwitchStatus{Active: true}, wantCode: codes.FailedPrecondition},
+		{name: "仮想証券会社だけにしていればそれを返す", status: &kabuspb.KillSwitchStatus{Active: true, VirtualOnly: true}, wantCode: codes.FailedPrecondition, wantVirtualOnly: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &killSwitch{killSwitchStore: &testKillSwitchStore{status: test.status}}
+			got1, got2 := service.Check(), service.IsVirtualOnly()
+			if status.Code(got1) != test.wantCode || got2 != test.wantVirtualOnly {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantCode, test.wantVirtualOnly, got1, got2)
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package services
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
@@ -14,17 +17,24 @@ type testTokenStore struct {
 	getToken      string
 	getExpiredAt  time.Time
 	isExpired     bool
+	resetExpired  bool // Reset後はIsExpiredでtrueを返す
 	lastSetToken1 string
 	lastSetToken2 time.Time
 	resetCount    int
+	onSetToken    func(token string)
 }
 
-func (t *testTokenStore) GetToken() string         { return t.getToken }
-func (t *testTokenStore) GetExpiredAt() time.Time  { return t.getExpiredAt }
-func (t *testTokenStore) IsExpired(time.Time) bool { return t.isExpired }
+func (t *testTokenStore) GetToken() string        { return t.getToken }
+func (t *testTokenStore) GetExpiredAt() time.Time { return t.getExpiredAt }
+func (t *testTokenStore) IsExpired(time.Time) bool {
+	return t.isExpired || (t.resetExpired && t.resetCount > 0)
+}
 func (t *testTokenStore) SetToken(token string, expire time.Time) {
 	t.lastSetToken1 = token
 	t.lastSetToken2 = expire
+	if t.onSetToken != nil {
+		t.onSetToken(token)
+	}
 }
 func (t *testTokenStore) Reset() {
 	t.resetCount++
@@ -32,11 +42,56 @@ func (t *testTokenStore) Reset() {
 
 type testSecurity struct {
 	repositories.Security
-	token1 string
-	token2 error
+	token1    string
+	token2    error
+	errorCode int
+	softLimit error
+	tokenWait chan struct{} // closeされるまでTokenを返さない
+	tokenMtx  sync.Mutex
+	tokenCnt  int
+	board     *kabuspb.Board
+	symbol    *kabuspb.Symbol
+	orders    *kabuspb.Orders
+	ordersErr error
+	byProduct map[kabuspb.Product]*kabuspb.Orders // 指定があれば商品ごとの注文を返す
+	cancel    map[string]error                    // 注文番号ごとの取消のエラー
+	positions *kabuspb.Positions
+	posErr    error
+	canceled  []string
 }
 
-func (t *testSecurity) Token(context.Context, string) (string, error) { return t.token1, t.token2 }
+func (t *testSecurity) Token(context.Context, string) (string, error) {
+	t.tokenMtx.Lock()
+	t.tokenCnt++
+	t.tokenMtx.Unlock()
+	if t.tokenWait != nil {
+		<-t.tokenWait
+	}
+	return t.token1, t.token2
+}
+func (t *testSecurity) ErrorCode(error) int { return t.errorCode }
+func (t *testSecurity) Board(context.Context, string, *kabuspb.GetBoardRequest) (*kabuspb.Board, error) {
+	return t.board, nil
+}
+func (t *testSecurity) Symbol(context.Context, string, *kabuspb.GetSymbolRequest) (*kabuspb.Symbol, error) {
+	return t.symbol, nil
+}
+func (t *testSecurity) Orders(_ context.Context, _ string, req *kabuspb.GetOrdersRequest) (*kabuspb.Orders, error) {
+	if t.byProduct != nil {
+		return &kabuspb.Orders{Orders: t.byProduct[req.Product].GetOrders()}, t.ordersErr
+	}
+	return t.orders, t.ordersErr
+}
+func (t *testSecurity) Positions(context.Context, string, *kabuspb.GetPositionsRequest) (*kabuspb.Positions, error) {
+	return t.positions, t.posErr
+}
+func (t *testSecurity) CancelOrder(_ context.Context, _ string, req *kabuspb.CancelOrderRequest) (*kabuspb.OrderResponse, error) {
+	t.canceled = append(t.canceled, req.OrderId)
+	return &kabuspb.OrderResponse{OrderId: req.OrderId}, t.cancel[req.OrderId]
+}
+func (t *testSecurity) SoftLimit(context.Context, string, *kabuspb.GetSoftLimitRequest) (*kabuspb.SoftLimit, error) {
+	return &kabuspb.SoftLimit{}, t.softLimit
+}
 
 type testClock struct {
 	repositories.Clock
@@ -52,9 +107,55 @@ func (t *testClock) Now() time.Time {
 
 type testSetting struct {
 	repositories.Setting
+	perSecond   int
+	perMinute   int
+	maxAttempts int
+	retryCodes  []int
+	resetHour   int
+	resetMinute int
+	location    *time.Location
+	intervals   map[kabuspb.ThrottleCategory]time.Duration
+
+	authRequired   bool
+	apiKeyClients  map[string]AuthClient
+	subjectClients map[string]AuthClient
+	maxPriorities  map[string]kabuspb.RequestPriority
+	riskLimit      repositories.RiskLimit
+
+	boardBufferSize int
+	boardOverflow   kabuspb.BoardOverflowPolicy
+}
+
+func (t *testSetting) ThrottleInterval(category kabuspb.ThrottleCategory) time.Duration {
+	return t.intervals[category]
 }
 
-func (t *testSetting) Password() string { return "" }
+func (t *testSetting) BoardStreamBuffer() (int, kabuspb.BoardOverflowPolicy) {
+	return t.boardBufferSize, t.boardOverflow
+}
+func (t *testSetting) Password() string     { return "" }
+func (t *testSetting) IsAuthRequired() bool { return t.authRequired }
+func (t *testSetting) RiskLimit() repositories.RiskLimit {
+	return t.riskLimit
+}
+func (t *testSetting) ClientByAPIKey(apiKey string) (string, []kabuspb.Permission, bool) {
+	c, ok := t.apiKeyClients[apiKey]
+	return c.Name, c.Permissions, ok
+}
+func (t *testSetting) ClientByTLSSubject(subject string) (string, []kabuspb.Permission, bool) {
+	c, ok := t.subjectClients[subject]
+	return c.Name, c.Permissions, ok
+}
+func (t *testSetting) ClientMaxPriority(name string) kabuspb.RequestPriority {
+	return t.maxPriorities[name]
+}
+func (t *testSetting) TokenResetTime() (int, int, *time.Location) {
+	return t.resetHour, t.resetMinute, t.location
+}
+func (t *testSetting) TokenRetryPolicy() (int, []int) { return t.maxAttempts, t.retryCodes }
+func (t *testSetting) Quota(string, kabuspb.ThrottleCategory) (int, int) {
+	return t.perSecond, t.perMinute
+}
 
 type testRegisterSymbolStore struct {
 	repositories.RegisterSymbolStore
@@ -77,25 +178,58 @@ func (t *testRegisterSymbolStore) RemoveAll(string, []*kabuspb.RegisterSymbol) {
 
 type testBoardStreamStore struct {
 	repositories.BoardStreamStore
-	hasStream   bool
-	all         map[int]kabuspb.KabusService_GetBoardsStreamingServer
-	addCount    int
-	removeCount int
-	chErr       error
+	hasStream     bool
+	all           map[int]kabuspb.KabusService_GetBoardsStreamingServer
+	requests      map[int]*kabuspb.GetBoardsStreamingRequest
+	addCount      int
+	removeCount   int
+	lastRemoveErr error
+	chErr         error
+	onRemove      func() // ストリームの終了を待っている接続の代わりに呼ぶ
 }
 
 func (t *testBoardStreamStore) HasStream() bool { return t.hasStream }
 func (t *testBoardStreamStore) All() map[int]kabuspb.KabusService_GetBoardsStreamingServer {
 	return t.all
 }
-func (t *testBoardStreamStore) Add(_ kabuspb.KabusService_GetBoardsStreamingServer, ch chan error) {
+func (t *testBoardStreamStore) Request(seq int) *kabuspb.GetBoardsStreamingRequest {
+	return t.requests[seq]
+}
+func (t *testBoardStreamStore) Add(_ kabuspb.KabusService_GetBoardsStreamingServer, _ *kabuspb.GetBoardsStreamingRequest, ch chan error) int {
 	go func() {
 		<-time.After(time.Second)
 		close(ch)
 	}()
 	t.addCount++
+	return t.addCount - 1
+}
+func (t *testBoardStreamStore) Remove(_ int, err error) {
+	t.removeCount++
+	t.lastRemoveErr = err
+	if t.onRemove != nil {
+		t.onRemove()
+	}
+}
+
+type testBoardStore struct {
+	repositories.BoardStore
+	get1  *kabuspb.Board
+	get2  time.Time
+	get3  bool
+	all   []*kabuspb.Board
+	saved []*kabuspb.Board
+	mtx   sync.Mutex
+}
+
+func (t *testBoardStore) Save(board *kabuspb.Board, _ time.Time) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.saved = append(t.saved, board)
 }
-func (t *testBoardStreamStore) Remove(int, error) { t.removeCount++ }
+func (t *testBoardStore) Get(string, kabuspb.Exchange) (*kabuspb.Board, time.Time, bool) {
+	return t.get1, t.get2, t.get3
+}
+func (t *testBoardStore) All() []*kabuspb.Board { return t.all }
 
 type testBoardWS struct {
 	repositories.BoardWS
@@ -103,10 +237,21 @@ type testBoardWS struct {
 	connect         error
 	disconnect      error
 	disconnectCount int
+	boards          []*kabuspb.Board
+	connectCount    int
+	mtx             sync.Mutex
 }
 
-func (t *testBoardWS) IsConnected() bool                              { return t.isConnected }
-func (t *testBoardWS) Connect(func(board *kabuspb.Board) error) error { return t.connect }
+func (t *testBoardWS) IsConnected() bool { return t.isConnected }
+func (t *testBoardWS) Connect(onNext func(board *kabuspb.Board) error) error {
+	t.mtx.Lock()
+	t.connectCount++
+	t.mtx.Unlock()
+	for _, board := range t.boards {
+		_ = onNext(board)
+	}
+	return t.connect
+}
 func (t *testBoardWS) Disconnect() error {
 	t.disconnectCount++
 	return t.disconnect
@@ -116,10 +261,18 @@ type testGetBoardsStreamingServer struct {
 	kabuspb.KabusService_GetBoardsStreamingServer
 	send      error
 	sendCount int
+	sending   chan struct{} // 指定があればSendに入ったことを知らせる
+	block     chan struct{} // 指定があれば閉じられるまでSendから戻らない
 }
 
 func (t *testGetBoardsStreamingServer) Send(*kabuspb.Board) error {
 	t.sendCount++
+	if t.sending != nil {
+		t.sending <- struct{}{}
+	}
+	if t.block != nil {
+		<-t.block
+	}
 	return t.send
 }
 
@@ -127,9 +280,236 @@ type testVirtualSecurity struct {
 	repositories.VirtualSecurity
 	sendPrice      error
 	sendPriceCount int
+	orders         *kabuspb.Orders
+	ordersErr      error
+	byProduct      map[kabuspb.Product]*kabuspb.Orders // 指定があれば商品ごとの注文を返す
+	positions      *kabuspb.Positions
+	posErr         error
+	mtx            sync.Mutex
+}
+
+func (t *testVirtualSecurity) Orders(_ context.Context, _ string, req *kabuspb.GetOrdersRequest) (*kabuspb.Orders, error) {
+	if t.byProduct != nil {
+		return &kabuspb.Orders{Orders: t.byProduct[req.Product].GetOrders()}, t.ordersErr
+	}
+	return t.orders, t.ordersErr
+}
+
+func (t *testVirtualSecurity) Positions(context.Context, string, *kabuspb.GetPositionsRequest) (*kabuspb.Positions, error) {
+	return t.positions, t.posErr
 }
 
 func (t *testVirtualSecurity) SendPrice(_ context.Context, _ *kabuspb.Board) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
 	t.sendPriceCount++
 	return t.sendPrice
 }
+
+type testTokenService struct {
+	TokenService
+	getToken        error
+	getExpiredAt    time.Time
+	invalidateCount int
+}
+
+func (t *testTokenService) Invalidate() { t.invalidateCount++ }
+
+func (t *testTokenService) GetExpiredAt() time.Time { return t.getExpiredAt }
+func (t *testTokenService) Do(_ context.Context, f func(token string) error) error {
+	if t.getToken != nil {
+		return t.getToken
+	}
+	return f("TOKEN_STRING")
+}
+
+type testThrottleService struct {
+	ThrottleService
+	wait error
+}
+
+func (t *testThrottleService) Wait(context.Context, kabuspb.ThrottleCategory, kabuspb.RequestPriority) error {
+	return t.wait
+}
+
+type testKillSwitchStore struct {
+	repositories.KillSwitchStore
+	status *kabuspb.KillSwitchStatus
+	save   error
+	saved  []*kabuspb.KillSwitchStatus
+}
+
+func (t *testKillSwitchStore) Get() *kabuspb.KillSwitchStatus { return t.status }
+func (t *testKillSwitchStore) Save(status *kabuspb.KillSwitchStatus) error {
+	if t.save != nil {
+		t.status = proto.Clone(status).(*kabuspb.KillSwitchStatus)
+		t.status.SaveError = t.save.Error()
+		return t.save
+	}
+	t.saved = append(t.saved, proto.Clone(status).(*kabuspb.KillSwitchStatus))
+	t.status = status
+	return nil
+}
+
+type testClientOrderStore struct {
+	repositories.ClientOrderStore
+	responses      map[clientOrderKey]*kabuspb.OrderResponse
+	clientOrderIDs map[string]clientOrderKey
+	saved          []*kabuspb.OrderResponse
+}
+
+func (t *testClientOrderStore) Get(owner string, clientOrderID string) (*kabuspb.OrderResponse, bool) {
+	res, ok := t.responses[clientOrderKey{owner: owner, clientOrderID: clientOrderID}]
+	return res, ok
+}
+func (t *testClientOrderStore) GetClientOrderID(orderID string) (string, string, bool) {
+	key, ok := t.clientOrderIDs[orderID]
+	return key.owner, key.clientOrderID, ok
+}
+func (t *testClientOrderStore) Save(_ string, _ string, res *kabuspb.OrderResponse) {
+	t.saved = append(t.saved, res)
+}
+
+type testOrderEventStreamStore struct {
+	repositories.OrderEventStreamStore
+	hasStream     bool
+	all           map[int]kabuspb.KabusService_GetOrderEventsStreamingServer
+	addCount      int
+	removeCount   int
+	lastRemoveErr error
+	onRemove      func() // ストリームの終了を待っている接続の代わりに呼ぶ
+}
+
+func (t *testOrderEventStreamStore) HasStream() bool { return t.hasStream }
+func (t *testOrderEventStreamStore) All() map[int]kabuspb.KabusService_GetOrderEventsStreamingServer {
+	return t.all
+}
+func (t *testOrderEventStreamStore) Add(_ kabuspb.KabusService_GetOrderEventsStreamingServer, ch chan error) int {
+	go func() {
+		<-time.After(time.Second)
+		close(ch)
+	}()
+	t.addCount++
+	return t.addCount - 1
+}
+func (t *testOrderEventStreamStore) Remove(_ int, err error) {
+	t.removeCount++
+	t.lastRemoveErr = err
+	if t.onRemove != nil {
+		t.onRemove()
+	}
+}
+
+type testGetOrderEventsStreamingServer struct {
+	kabuspb.KabusService_GetOrderEventsStreamingServer
+	ctx  context.Context
+	send error
+	sent []*kabuspb.OrderEvent
+}
+
+func (t *testGetOrderEventsStreamingServer) Context() context.Context {
+	if t.ctx != nil {
+		return t.ctx
+	}
+	return context.Background()
+}
+
+func (t *testGetOrderEventsStreamingServer) Send(event *kabuspb.OrderEvent) error {
+	t.sent = append(t.sent, event)
+	return t.send
+}
+
+type testClientOrderService struct {
+	ClientOrderService
+}
+
+func (t *testClientOrderService) AttachAll(orders []*kabuspb.Order) []*kabuspb.Order {
+	return orders
+}
+
+type testOrderEventListener struct {
+	isListening bool
+	received    []*kabuspb.OrderEvent
+}
+
+func (t *testOrderEventListener) IsListening() bool { return t.isListening }
+func (t *testOrderEventListener) OnOrderEvent(event *kabuspb.OrderEvent) {
+	t.received = append(t.received, event)
+}
+
+type testPositionEventStreamStore struct {
+	repositories.PositionEventStreamStore
+	hasStream     bool
+	all           map[int]kabuspb.KabusService_GetPositionEventsStreamingServer
+	addCount      int
+	removeCount   int
+	lastRemoveErr error
+	onRemove      func() // ストリームの終了を待っている接続の代わりに呼ぶ
+}
+
+func (t *testPositionEventStreamStore) HasStream() bool { return t.hasStream }
+func (t *testPositionEventStreamStore) All() map[int]kabuspb.KabusService_GetPositionEventsStreamingServer {
+	return t.all
+}
+func (t *testPositionEventStreamStore) Add(_ kabuspb.KabusService_GetPositionEventsStreamingServer, ch chan error) int {
+	go func() {
+		<-time.After(time.Second)
+		close(ch)
+	}()
+	t.addCount++
+	return t.addCount - 1
+}
+func (t *testPositionEventStreamStore) Remove(_ int, err error) {
+	t.removeCount++
+	t.lastRemoveErr = err
+	if t.onRemove != nil {
+		t.onRemove()
+	}
+}
+
+type testGetPositionEventsStreamingServer struct {
+	kabuspb.KabusService_GetPositionEventsStreamingServer
+	ctx  context.Context
+	send error
+	sent []*kabuspb.PositionEvent
+}
+
+func (t *testGetPositionEventsStreamingServer) Context() context.Context {
+	if t.ctx != nil {
+		return t.ctx
+	}
+	return context.Background()
+}
+
+func (t *testGetPositionEventsStreamingServer) Send(event *kabuspb.PositionEvent) error {
+	t.sent = append(t.sent, event)
+	return t.send
+}
+
+type testAuditLogStore struct {
+	repositories.AuditLogStore
+	appendErr error
+	appended  []*kabuspb.AuditLog
+	all1      []*kabuspb.AuditLog
+	all2      error
+}
+
+func (t *testAuditLogStore) Append(log *kabuspb.AuditLog) error {
+	t.appended = append(t.appended, log)
+	return t.appendErr
+}
+func (t *testAuditLogStore) All() ([]*kabuspb.AuditLog, error) { return t.all1, t.all2 }
+
+type testOrderHistoryStore struct {
+	repositories.OrderHistoryStore
+	save  error
+	saved [][]*kabuspb.OrderHistoryRecord
+	all1  []*kabuspb.OrderHistoryRecord
+	all2  error
+}
+
+func (t *testOrderHistoryStore) Save(records []*kabuspb.OrderHistoryRecord) error {
+	t.saved = append(t.saved, records)
+	return t.save
+}
+func (t *testOrderHistoryStore) All() ([]*kabuspb.OrderHistoryRecord, error) { return t.all1, t.all2 }
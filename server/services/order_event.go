@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewOrderEventService(
+	streamStore repositories.OrderEventStreamStore,
+	security repositories.Security,
+	virtual repositories.VirtualSecurity,
+	tokenService TokenService,
+	throttleService ThrottleService,
+	clientOrderService ClientOrderService,
+	clock repositories.Clock) OrderEventService {
+	return &orderEvent{
+		streamStore:        streamStore,
+		security:           security,
+		virtual:            virtual,
+		tokenService:       tokenService,
+		throttleService:    throttleService,
+		clientOrderService: clientOrderService,
+		clock:              clock,
+		interval:           time.Second,
+		timeout:            5 * time.Second,
+		done:               make(chan struct{}),
+	}
+}
+
+// errOrderEventStreamClosed - サーバーの終了でストリームを終了したときのエラー
+var errOrderEventStreamClosed = status.Error(codes.Unavailable, "server is shutting down")
+
+// OrderEventService - 注文一覧をポーリングして、注文の変化をイベントとして配信する
+type OrderEventService interface {
+	Start()
+	Connect(stream kabuspb.KabusService_GetOrderEventsStreamingServer) error
+	AddListener(listener OrderEventListener)
+	Close()
+}
+
+// OrderEventListener - ストリーム以外で注文イベントを受け取るもの、IsListeningがtrueの間はストリームがなくてもポーリングを続ける
+type OrderEventListener interface {
+	IsListening() bool
+	OnOrderEvent(event *kabuspb.OrderEvent)
+}
+
+type orderEvent struct {
+	streamStore        repositories.OrderEventStreamStore
+	security           repositories.Security
+	virtual            repositories.VirtualSecurity
+	tokenService       TokenService
+	throttleService    ThrottleService
+	clientOrderService ClientOrderService
+	clock              repositories.Clock
+	interval           time.Duration             // ポーリングする間隔
+	timeout            time.Duration             // 1回のポーリングにかける時間の上限
+	realOrders         map[string]*kabuspb.Order // 前回の実注文、nilなら基準がない
+	virtualOrders      map[string]*kabuspb.Order // 前回の仮想証券会社の注文、nilなら基準がない
+	listeners          []OrderEventListener
+	started            bool
+	closed             bool
+	done               chan struct{}
+	mtx                sync.Mutex
+}
+
+// Start - 定期的なポーリングを始める、既に始まっているか終了していたら何もしない
+func (s *orderEvent) Start() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.started || s.closed {
+		return
+	}
+	s.started = true
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				s.poll()
+			}
+		}
+	}()
+}
+
+func (s *orderEvent) Connect(stream kabuspb.KabusService_GetOrderEventsStreamingServer) error {
+	s.Start()
+
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return errOrderEventStreamClosed
+	}
+	ch := make(chan error, 1) // Removeが受け取りを待たないようにする
+	seq := s.streamStore.Add(stream, ch)
+	s.mtx.Unlock()
+
+	select {
+	case err := <-ch:
+		return err
+	case <-stream.Context().Done():
+		// 注文イベントが起きて送れなくなるまで待つと、切断したクライアントのためにポーリングを続けてしまうので、すぐに外す
+		s.streamStore.Remove(seq, nil)
+		return status.FromContextError(stream.Context().Err()).Err()
+	}
+}
+
+// AddListener - ストリーム以外で注文イベントを受け取るものを追加する
+func (s *orderEvent) AddListener(listener OrderEventListener) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+// listening - 全ての受け取るものと、ポーリングを続けてほしいものがいるかを返す
+func (s *orderEvent) listening() ([]OrderEventListener, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	listeners := make([]OrderEventListener, len(s.listeners))
+	copy(listeners, s.listeners)
+	for _, l := range listeners {
+		if l.IsListening() {
+			return listeners, true
+		}
+	}
+	return listeners, false
+}
+
+// Close - 全てのストリームをUnavailableで終了してポーリングを止める、以降は接続できない
+func (s *orderEvent) Close() {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	seqs := make([]int, 0)
+	for i := range s.streamStore.All() {
+		seqs = append(seqs, i)
+	}
+	s.mtx.Unlock()
+
+	// ストリームの終了を待つ間に接続やポーリングを止めないように、ロックを外してから終了させる
+	for _, i := range seqs {
+		s.streamStore.Remove(i, errOrderEventStreamClosed)
+	}
+}
+
+// poll - 実注文と仮想証券会社の注文を取得して、前回からの変化を配信する、ストリームもポーリングを続けてほしいものもなければ取得せずに基準を捨てる
+func (s *orderEvent) poll() {
+	listeners, listening := s.listening()
+	if !s.streamStore.HasStream() && !listening {
+		s.mtx.Lock()
+		s.realOrders, s.virtualOrders = nil, nil
+		s.mtx.Unlock()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	events := make([]*kabuspb.OrderEvent, 0)
+	if orders, err := s.fetchRealOrders(ctx); err == nil {
+		events = append(events, s.update(&s.realOrders, orders, false)...)
+	}
+	if orders, err := s.virtual.Orders(ctx, "", &kabuspb.GetOrdersRequest{Product: kabuspb.Product_PRODUCT_ALL}); err == nil {
+		events = append(events, s.update(&s.virtualOrders, orders.Orders, true)...)
+	}
+
+	for _, event := range events {
+		for _, l := range listeners {
+			l.OnOrderEvent(event)
+		}
+		for i, stream := range s.streamStore.All() {
+			if err := stream.Send(event); err != nil {
+				log.Println(err) // デバッグのためにおいとく
+				s.streamStore.Remove(i, err)
+			}
+		}
+	}
+}
+
+func (s *orderEvent) fetchRealOrders(ctx context.Context) ([]*kabuspb.Order, error) {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_LOW); err != nil {
+		return nil, err
+	}
+
+	var orders *kabuspb.Orders
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		orders, err = s.security.Orders(ctx, token, &kabuspb.GetOrdersRequest{Product: kabuspb.Product_PRODUCT_ALL, GetDetails: true})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders.Orders, nil
+}
+
+// update - 前回の注文と比べてイベントを作り、今回の注文を次の基準にする、基準がなければイベントを作らない
+func (s *orderEvent) update(prev *map[string]*kabuspb.Order, orders []*kabuspb.Order, isVirtual bool) []*kabuspb.OrderEvent {
+	orders = s.clientOrderService.AttachAll(orders)
+	now := s.clock.Now()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	events := make([]*kabuspb.OrderEvent, 0)
+	current := make(map[string]*kabuspb.Order, len(orders))
+	for _, o := range orders {
+		current[o.Id] = o
+		if *prev != nil {
+			events = append(events, orderEvents((*prev)[o.Id], o, isVirtual, now)...)
+		}
+	}
+	*prev = current
+	return events
+}
+
+// orderEvents - 前回の注文と今回の注文の差分からイベントを作る、前回の注文がなければ新しい注文として扱う
+func orderEvents(prev *kabuspb.Order, cur *kabuspb.Order, isVirtual bool, now time.Time) []*kabuspb.OrderEvent {
+	types := make([]kabuspb.OrderEventType, 0)
+	if prev == nil {
+		prev = &kabuspb.Order{}
+		if !(cur.OrderState == kabuspb.OrderState_ORDER_STATE_DONE && terminalEventType(cur) == kabuspb.OrderEventType_ORDER_EVENT_TYPE_REJECTED) {
+			types = append(types, kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED)
+		}
+	}
+
+	executed := cur.CumulativeQuantity - prev.CumulativeQuantity
+	filled := cur.OrderQuantity > 0 && cur.CumulativeQuantity >= cur.OrderQuantity
+	if executed > 0 {
+		if filled {
+			types = append(types, kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED)
+		} else {
+			types = append(types, kabuspb.OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED)
+		}
+	} else {
+		executed = 0
+	}
+
+	if prev.OrderState != kabuspb.OrderState_ORDER_STATE_DONE && cur.OrderState == kabuspb.OrderState_ORDER_STATE_DONE && !filled {
+		types = append(types, terminalEventType(cur))
+	}
+
+	if len(types) == 0 {
+		return []*kabuspb.OrderEvent{}
+	}
+
+	events := make([]*kabuspb.OrderEvent, len(types))
+	for i, t := range types {
+		events[i] = &kabuspb.OrderEvent{EventType: t, Order: cur, IsVirtual: isVirtual, DetectedAt: timestamppb.New(now)}
+	}
+	events[len(events)-1].NewDetails = newOrderDetails(prev, cur)
+	events[len(events)-1].ExecutedQuantity = executed
+	return events
+}
+
+// terminalEventType - 全約定以外で終了した注文のイベントの種類を、最後の取消・失効・エラーの注文明細から決める、わからなければ取消とする
+func terminalEventType(order *kabuspb.Order) kabuspb.OrderEventType {
+	for i := len(order.Details) - 1; i >= 0; i-- {
+		d := order.Details[i]
+		switch {
+		case d.RecordType == kabuspb.RecordType_RECORD_TYPE_CANCELED:
+			return kabuspb.OrderEventType_ORDER_EVENT_TYPE_CANCELED
+		case d.RecordType == kabuspb.RecordType_RECORD_TYPE_EXPIRED, d.RecordType == kabuspb.RecordType_RECORD_TYPE_REVOCATION:
+			return kabuspb.OrderEventType_ORDER_EVENT_TYPE_EXPIRED
+		case d.State == kabuspb.OrderDetailState_ORDER_DETAIL_STATE_ERROR:
+			return kabuspb.OrderEventType_ORDER_EVENT_TYPE_REJECTED
+		}
+	}
+	return kabuspb.OrderEventType_ORDER_EVENT_TYPE_CANCELED
+}
+
+// newOrderDetails - 前回の注文になかった連番の注文明細を返す
+func newOrderDetails(prev *kabuspb.Order, cur *kabuspb.Order) []*kabuspb.OrderDetail {
+	seen := make(map[int32]bool, len(prev.Details))
+	for _, d := range prev.Details {
+		seen[d.SequenceNumber] = true
+	}
+
+	res := make([]*kabuspb.OrderDetail, 0)
+	for _, d := range cur.Details {
+		if !seen[d.SequenceNumber] {
+			res = append(res, d)
+		}
+	}
+	return res
+}
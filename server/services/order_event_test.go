@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewOrderEventService(t *testing.T) {
+	streamStore := &testOrderEventStreamStore{}
+	security := &testSecurity{}
+	virtual := &testVirtualSecurity{}
+	tokenService := &testTokenService{}
+	throttleService := &testThrottleService{}
+	clientOrderService := &testClientOrderService{}
+	clock := &testClock{}
+	got := NewOrderEventService(streamStore, security, virtual, tokenService, throttleService, clientOrderService, clock).(*orderEvent)
+
+	t.Parallel()
+	if got.streamStore != streamStore || got.security != security || got.virtual != virtual || got.tokenService != tokenService ||
+		got.throttleService != throttleService || got.clientOrderService != clientOrderService || got.clock != clock ||
+		got.interval != time.Second || got.timeout != 5*time.Second || got.done == nil {
+		t.Errorf("%s error\ngot: %+v\n", t.Name(), got)
+	}
+}
+
+func Test_orderEvent_Connect(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		closed       bool
+		want         error
+		wantAddCount int
+	}{
+		{name: "終了していたらストリームを追加せずにエラーを返す", closed: true, want: errOrderEventStreamClosed},
+		{name: "ストリームを追加して終了を待つ", wantAddCount: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &testOrderEventStreamStore{}
+			service := &orderEvent{streamStore: store, closed: test.closed, interval: time.Hour, done: make(chan struct{})}
+			got := service.Connect(&testGetOrderEventsStreamingServer{})
+			if !errors.Is(got, test.want) || store.addCount != test.wantAddCount {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantAddCount, got, store.addCount)
+			}
+			close(service.done)
+		})
+	}
+}
+
+func Test_orderEvent_Connect_canceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	store := &testOrderEventStreamStore{}
+	service := &orderEvent{streamStore: store, interval: time.Hour, done: make(chan struct{})}
+	defer close(service.done)
+
+	// クライアントが切断したら、終了を待たずにストリームを外す
+	got := service.Connect(&testGetOrderEventsStreamingServer{ctx: ctx})
+	if status.Code(got) != codes.Canceled || store.removeCount != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), codes.Canceled, 1, got, store.removeCount)
+	}
+}
+
+func Test_orderEvent_Close(t *testing.T) {
+	t.Parallel()
+	store := &testOrderEventStreamStore{all: map[int]kabuspb.KabusService_GetOrderEventsStreamingServer{
+		0: &testGetOrderEventsStreamingServer{},
+		1: &testGetOrderEventsStreamingServer{},
+	}}
+	service := &orderEvent{streamStore: store, done: make(chan struct{})}
+	service.Close()
+	service.Close()
+
+	select {
+	case <-service.done:
+	default:
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "closed", "not closed")
+	}
+	if !service.closed || store.removeCount != 2 || !errors.Is(store.lastRemoveErr, errOrderEventStreamClosed) {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), true, 2, errOrderEventStreamClosed, service.closed, store.removeCount, store.lastRemoveErr)
+	}
+}
+
+func Test_orderEvent_Close_unlocked(t *testing.T) {
+	t.Parallel()
+	store := &testOrderEventStreamStore{all: map[int]kabuspb.KabusService_GetOrderEventsStreamingServer{0: &testGetOrderEventsStreamingServer{}}}
+	service := &orderEvent{streamStore: store, done: make(chan struct{})}
+	// 終了を待っている接続がロックを取れなければ、ストリームを終了できずに止まる
+	store.onRemove = func() {
+		service.mtx.Lock()
+		defer service.mtx.Unlock()
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		service.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "closed", "deadlock")
+	}
+}
+
+func Test_orderEvent_poll(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	accepted := &kabuspb.Order{Id: "ORDER-2", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, OrderQuantity: 100}
+	virtualFilled := &kabuspb.Order{Id: "VIRTUAL-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, CumulativeQuantity: 100}
+
+	tests := []struct {
+		name              string
+		hasStream         bool
+		realOrders        map[string]*kabuspb.Order
+		virtualOrders     map[string]*kabuspb.Order
+		security          *testSecurity
+		virtual           *testVirtualSecurity
+		want              []*kabuspb.OrderEvent
+		wantRealOrders    map[string]*kabuspb.Order
+		wantVirtualOrders map[string]*kabuspb.Order
+	}{
+		{name: "ストリームがなければ基準を捨てる",
+			realOrders:    map[string]*kabuspb.Order{"ORDER-1": {Id: "ORDER-1"}},
+			virtualOrders: map[string]*kabuspb.Order{"VIRTUAL-1": {Id: "VIRTUAL-1"}},
+			security:      &testSecurity{},
+			virtual:       &testVirtualSecurity{},
+			want:          []*kabuspb.OrderEvent{}},
+		{name: "基準がなければイベントを作らずに基準にする",
+			hasStream:         true,
+			security:          &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{accepted}}},
+			virtual:           &testVirtualSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{virtualFilled}}},
+			want:              []*kabuspb.OrderEvent{},
+			wantRealOrders:    map[string]*kabuspb.Order{"ORDER-2": accepted},
+			wantVirtualOrders: map[string]*kabuspb.Order{"VIRTUAL-1": virtualFilled}},
+		{name: "実注文と仮想証券会社の注文の変化を配信する",
+			hasStream:     true,
+			realOrders:    map[string]*kabuspb.Order{},
+			virtualOrders: map[string]*kabuspb.Order{"VIRTUAL-1": {Id: "VIRTUAL-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, OrderQuantity: 100}},
+			security:      &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{accepted}}},
+			virtual:       &testVirtualSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{virtualFilled}}},
+			want: []*kabuspb.OrderEvent{
+				{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED, Order: accepted, NewDetails: []*kabuspb.OrderDetail{}, DetectedAt: timestamppb.New(now)},
+				{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED, Order: virtualFilled, NewDetails: []*kabuspb.OrderDetail{}, ExecutedQuantity: 100, IsVirtual: true, DetectedAt: timestamppb.New(now)},
+			},
+			wantRealOrders:    map[string]*kabuspb.Order{"ORDER-2": accepted},
+			wantVirtualOrders: map[string]*kabuspb.Order{"VIRTUAL-1": virtualFilled}},
+		{name: "取得でエラーがあれば前回の基準を残す",
+			hasStream:         true,
+			realOrders:        map[string]*kabuspb.Order{"ORDER-1": {Id: "ORDER-1"}},
+			virtualOrders:     map[string]*kabuspb.Order{"VIRTUAL-1": {Id: "VIRTUAL-1"}},
+			security:          &testSecurity{ordersErr: errors.New("error message")},
+			virtual:           &testVirtualSecurity{ordersErr: errors.New("error message")},
+			want:              []*kabuspb.OrderEvent{},
+			wantRealOrders:    map[string]*kabuspb.Order{"ORDER-1": {Id: "ORDER-1"}},
+			wantVirtualOrders: map[string]*kabuspb.Order{"VIRTUAL-1": {Id: "VIRTUAL-1"}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			stream := &testGetOrderEventsStreamingServer{}
+			service := &orderEvent{
+				streamStore:        &testOrderEventStreamStore{hasStream: test.hasStream, all: map[int]kabuspb.KabusService_GetOrderEventsStreamingServer{0: stream}},
+				security:           test.security,
+				virtual:            test.virtual,
+				tokenService:       &testTokenService{},
+				throttleService:    &testThrottleService{},
+				clientOrderService: &testClientOrderService{},
+				clock:              &testClock{now: now},
+				timeout:            time.Second,
+				realOrders:         test.realOrders,
+				virtualOrders:      test.virtualOrders,
+			}
+			service.poll()
+			got := stream.sent
+			if got == nil {
+				got = []*kabuspb.OrderEvent{}
+			}
+			if !reflect.DeepEqual(test.want, got) || !reflect.DeepEqual(test.wantRealOrders, service.realOrders) || !reflect.DeepEqual(test.wantVirtualOrders, service.virtualOrders) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
+					test.want, test.wantRealOrders, test.wantVirtualOrders, got, service.realOrders, service.virtualOrders)
+			}
+		})
+	}
+}
+
+func Test_orderEvent_poll_sendError(t *testing.T) {
+	t.Parallel()
+	store := &testOrderEventStreamStore{hasStream: true, all: map[int]kabuspb.KabusService_GetOrderEventsStreamingServer{0: &testGetOrderEventsStreamingServer{send: errors.New("send error")}}}
+	service := &orderEvent{
+		streamStore:        store,
+		security:           &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "ORDER-1"}}}},
+		virtual:            &testVirtualSecurity{ordersErr: errors.New("disabled")},
+		tokenService:       &testTokenService{},
+		throttleService:    &testThrottleService{},
+		clientOrderService: &testClientOrderService{},
+		clock:              &testClock{},
+		timeout:            time.Second,
+		realOrders:         map[string]*kabuspb.Order{},
+	}
+	service.poll()
+	if store.removeCount != 1 || store.lastRemoveErr == nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), 1, store.removeCount, store.lastRemoveErr)
+	}
+}
+
+func Test_orderEvent_poll_listener(t *testing.T) {
+	t.Parallel()
+	filled := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, CumulativeQuantity: 100}
+	listening := &testOrderEventListener{isListening: true}
+	notListening := &testOrderEventListener{}
+	service := &orderEvent{
+		streamStore:        &testOrderEventStreamStore{},
+		security:           &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{filled}}},
+		virtual:            &testVirtualSecurity{ordersErr: errors.New("disabled")},
+		tokenService:       &testTokenService{},
+		throttleService:    &testThrottleService{},
+		clientOrderService: &testClientOrderService{},
+		clock:              &testClock{},
+		timeout:            time.Second,
+		realOrders:         map[string]*kabuspb.Order{"ORDER-1": {Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, OrderQuantity: 100}},
+	}
+	service.AddListener(listening)
+	service.AddListener(notListening)
+	service.poll()
+
+	if len(listening.received) != 1 || listening.received[0].EventType != kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED || len(notListening.received) != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), 1, 1, listening.received, notListening.received)
+	}
+}
+
+func Test_orderEvent_poll_notListening(t *testing.T) {
+	t.Parallel()
+	listener := &testOrderEventListener{}
+	service := &orderEvent{
+		streamStore: &testOrderEventStreamStore{},
+		realOrders:  map[string]*kabuspb.Order{"ORDER-1": {Id: "ORDER-1"}},
+	}
+	service.AddListener(listener)
+	service.poll() // 取得すればsecurityがnilでpanicする
+
+	if service.realOrders != nil || len(listener.received) != 0 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), nil, 0, service.realOrders, listener.received)
+	}
+}
+
+func Test_orderEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	detail1 := &kabuspb.OrderDetail{SequenceNumber: 1, RecordType: kabuspb.RecordType_RECORD_TYPE_RECEIVE, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_PROCESSED}
+	detail2 := &kabuspb.OrderDetail{SequenceNumber: 2, RecordType: kabuspb.RecordType_RECORD_TYPE_ORDERED, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_PROCESSED}
+	contracted := &kabuspb.OrderDetail{SequenceNumber: 3, RecordType: kabuspb.RecordType_RECORD_TYPE_CONTRACTED, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_PROCESSED, Quantity: 30}
+	canceled := &kabuspb.OrderDetail{SequenceNumber: 3, RecordType: kabuspb.RecordType_RECORD_TYPE_CANCELED, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_PROCESSED}
+	expired := &kabuspb.OrderDetail{SequenceNumber: 3, RecordType: kabuspb.RecordType_RECORD_TYPE_EXPIRED, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_PROCESSED}
+	errored := &kabuspb.OrderDetail{SequenceNumber: 2, RecordType: kabuspb.RecordType_RECORD_TYPE_ORDERED, State: kabuspb.OrderDetailState_ORDER_DETAIL_STATE_ERROR}
+
+	working := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, OrderQuantity: 100, Details: []*kabuspb.OrderDetail{detail1, detail2}}
+	partial := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, OrderQuantity: 100, CumulativeQuantity: 30, Details: []*kabuspb.OrderDetail{detail1, detail2, contracted}}
+	filled := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, CumulativeQuantity: 100, Details: []*kabuspb.OrderDetail{detail1, detail2, contracted}}
+	canceledOrder := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, Details: []*kabuspb.OrderDetail{detail1, detail2, canceled}}
+	expiredOrder := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, Details: []*kabuspb.OrderDetail{detail1, detail2, expired}}
+	rejectedOrder := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, Details: []*kabuspb.OrderDetail{detail1, errored}}
+	virtualCanceled := &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, OrderQuantity: 100, CumulativeQuantity: 30, Details: []*kabuspb.OrderDetail{contracted}}
+
+	event := func(eventType kabuspb.OrderEventType, order *kabuspb.Order, executed float64, details ...*kabuspb.OrderDetail) *kabuspb.OrderEvent {
+		return &kabuspb.OrderEvent{EventType: eventType, Order: order, ExecutedQuantity: executed, NewDetails: details, DetectedAt: timestamppb.New(now)}
+	}
+	withoutDetails := func(eventType kabuspb.OrderEventType, order *kabuspb.Order) *kabuspb.OrderEvent {
+		return &kabuspb.OrderEvent{EventType: eventType, Order: order, DetectedAt: timestamppb.New(now)}
+	}
+
+	tests := []struct {
+		name string
+		prev *kabuspb.Order
+		cur  *kabuspb.Order
+		want []*kabuspb.OrderEvent
+	}{
+		{name: "変化がなければイベントを作らない", prev: working, cur: working, want: []*kabuspb.OrderEvent{}},
+		{name: "新しい注文なら受付", cur: working,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED, working, 0, detail1, detail2)}},
+		{name: "新しい注文が一部約定していれば受付と一部約定", cur: partial,
+			want: []*kabuspb.OrderEvent{
+				withoutDetails(kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED, partial),
+				event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED, partial, 30, detail1, detail2, contracted)}},
+		{name: "新しい注文が発注エラーなら受付にせずに発注エラー", cur: rejectedOrder,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_REJECTED, rejectedOrder, 0, detail1, errored)}},
+		{name: "約定数量が増えて注文数量に届かなければ一部約定", prev: working, cur: partial,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED, partial, 30, contracted)}},
+		{name: "約定数量が注文数量に届けば全約定", prev: partial, cur: filled,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED, filled, 70)}},
+		{name: "取消の明細で終了したら取消", prev: working, cur: canceledOrder,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_CANCELED, canceledOrder, 0, canceled)}},
+		{name: "期限切れの明細で終了したら失効", prev: working, cur: expiredOrder,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_EXPIRED, expiredOrder, 0, expired)}},
+		{name: "エラーの明細で終了したら発注エラー", prev: &kabuspb.Order{Id: "ORDER-1", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSING, Details: []*kabuspb.OrderDetail{detail1}}, cur: rejectedOrder,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_REJECTED, rejectedOrder, 0, errored)}},
+		{name: "理由の明細がなく全約定せずに終了したら取消", prev: partial, cur: virtualCanceled,
+			want: []*kabuspb.OrderEvent{event(kabuspb.OrderEventType_ORDER_EVENT_TYPE_CANCELED, virtualCanceled, 0)}},
+		{name: "終了済みの注文は変化がなければイベントを作らない", prev: canceledOrder, cur: canceledOrder, want: []*kabuspb.OrderEvent{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := orderEvents(test.prev, test.cur, false, now)
+			for _, e := range got {
+				if e.NewDetails != nil && len(e.NewDetails) == 0 {
+					e.NewDetails = nil
+				}
+			}
+			for _, e := range test.want {
+				if len(e.NewDetails) == 0 {
+					e.NewDetails = nil
+				}
+			}
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewOrderHistoryService(
+	store repositories.OrderHistoryStore,
+	security repositories.Security,
+	virtual repositories.VirtualSecurity,
+	tokenService TokenService,
+	throttleService ThrottleService,
+	clientOrderService ClientOrderService,
+	clock repositories.Clock) OrderHistoryService {
+	return &orderHistory{
+		store:              store,
+		security:           security,
+		virtual:            virtual,
+		tokenService:       tokenService,
+		throttleService:    throttleService,
+		clientOrderService: clientOrderService,
+		clock:              clock,
+		interval:           time.Minute,
+		timeout:            10 * time.Second,
+	}
+}
+
+// orderHistoryProducts - 注文に商品がないので、商品ごとに注文を取得して商品を覚える
+var orderHistoryProducts = []kabuspb.Product{
+	kabuspb.Product_PRODUCT_STOCK,
+	kabuspb.Product_PRODUCT_MARGIN,
+	kabuspb.Product_PRODUCT_FUTURE,
+	kabuspb.Product_PRODUCT_OPTION,
+}
+
+// OrderHistoryService - 実注文と仮想証券会社の注文を定期的と注文の変化の検知時に取得して保存し、kabusapiが返さなくなった注文も返せるようにする
+type OrderHistoryService interface {
+	OrderEventListener
+	Start()
+	Find(req *kabuspb.GetOrderHistoryRequest) (*kabuspb.OrderHistory, error)
+}
+
+type orderHistory struct {
+	store              repositories.OrderHistoryStore
+	security           repositories.Security
+	virtual            repositories.VirtualSecurity
+	tokenService       TokenService
+	throttleService    ThrottleService
+	clientOrderService ClientOrderService
+	clock              repositories.Clock
+	interval           time.Duration // 定期的に取得する間隔
+	timeout            time.Duration // 1回の取得にかける時間の上限
+	started            bool
+	pending            map[bool]bool // 実注文か仮想証券会社の注文かごとに、取得し直すのを待っているか
+	mtx                sync.Mutex
+	refreshMtx         sync.Mutex // 同じ注文を古い内容で上書きしないように取得と保存を直列にする
+}
+
+// Start - 定期的な取得を始める、既に始まっていたら何もしない
+func (s *orderHistory) Start() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+
+	go func() {
+		s.refreshAll()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			s.refreshAll()
+		}
+	}()
+}
+
+// IsListening - 注文の変化の検知のためだけにポーリングはさせず、他でポーリングしているときだけ受け取る
+func (s *orderHistory) IsListening() bool {
+	return false
+}
+
+// OnOrderEvent - 注文の変化を検知したら、変化した側の注文を取得し直して保存する、既に取得し直すのを待っていれば何もしない
+func (s *orderHistory) OnOrderEvent(event *kabuspb.OrderEvent) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.pending[event.IsVirtual] {
+		return
+	}
+	if s.pending == nil {
+		s.pending = map[bool]bool{}
+	}
+	s.pending[event.IsVirtual] = true
+
+	go s.refresh(event.IsVirtual)
+}
+
+// Find - 保存している注文から、受注日時・銘柄コード・商品・状態の条件を全て満たすものを受注日時の古い順に返す
+func (s *orderHistory) Find(req *kabuspb.GetOrderHistoryRequest) (*kabuspb.OrderHistory, error) {
+	records, err := s.store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*kabuspb.OrderHistoryRecord, 0)
+	for _, r := range records {
+		if orderHistoryMatches(req, r) {
+			res = append(res, r)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Order.GetReceiveTime().AsTime().Before(res[j].Order.GetReceiveTime().AsTime())
+	})
+	return &kabuspb.OrderHistory{Records: res}, nil
+}
+
+func (s *orderHistory) refreshAll() {
+	s.refresh(false)
+	s.refresh(true)
+}
+
+// refresh - 商品ごとに注文を取得して保存する、取得でエラーがあった商品は飛ばす
+func (s *orderHistory) refresh(isVirtual bool) {
+	s.refreshMtx.Lock()
+	defer s.refreshMtx.Unlock()
+
+	s.mtx.Lock()
+	delete(s.pending, isVirtual)
+	s.mtx.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	records := make([]*kabuspb.OrderHistoryRecord, 0)
+	for _, product := range orderHistoryProducts {
+		orders, err := s.fetch(ctx, product, isVirtual)
+		if err != nil {
+			continue
+		}
+
+		now := timestamppb.New(s.clock.Now())
+		for _, o := range s.clientOrderService.AttachAll(orders) {
+			records = append(records, &kabuspb.OrderHistoryRecord{Order: o, Product: product, IsVirtual: isVirtual, SavedAt: now})
+		}
+	}
+	if len(records) == 0 {
+		return
+	}
+
+	if err := s.store.Save(records); err != nil {
+		log.Println(err) // デバッグのためにおいとく
+	}
+}
+
+func (s *orderHistory) fetch(ctx context.Context, product kabuspb.Product, isVirtual bool) ([]*kabuspb.Order, error) {
+	req := &kabuspb.GetOrdersRequest{Product: product, GetDetails: true}
+	if isVirtual {
+		orders, err := s.virtual.Orders(ctx, "", req)
+		if err != nil {
+			return nil, err
+		}
+		return orders.Orders, nil
+	}
+
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_LOW); err != nil {
+		return nil, err
+	}
+	var orders *kabuspb.Orders
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		orders, err = s.security.Orders(ctx, token, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orders.Orders, nil
+}
+
+// orderHistoryMatches - 注文履歴がリクエストの条件を全て満たしているか、条件がゼロ値なら制限しない
+func orderHistoryMatches(req *kabuspb.GetOrderHistoryRequest, r *kabuspb.OrderHistoryRecord) bool {
+	receiveTime := r.Order.GetReceiveTime().AsTime()
+	if req.From != nil && !req.From.AsTime().IsZero() && receiveTime.Before(req.From.AsTime()) {
+		return false
+	}
+	if req.To != nil && !req.To.AsTime().IsZero() && !receiveTime.Before(req.To.AsTime()) {
+		return false
+	}
+	if req.SymbolCode != "" && req.SymbolCode != r.Order.GetSymbolCode() {
+		return false
+	}
+	if req.Product != kabuspb.Product_PRODUCT_UNSPECIFIED && req.Product != kabuspb.Product_PRODUCT_ALL && req.Product != r.Product {
+		return false
+	}
+	if req.State != kabuspb.OrderState_ORDER_STATE_UNSPECIFIED && req.State != r.Order.GetOrderState() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewOrderHistoryService(t *testing.T) {
+	store := &testOrderHistoryStore{}
+	security := &testSecurity{}
+	virtual := &testVirtualSecurity{}
+	tokenService := &testTokenService{}
+	throttleService := &testThrottleService{}
+	clientOrderService := &testClientOrderService{}
+	clock := &testClock{}
+	want := &orderHistory{
+		store:              store,
+		security:           security,
+		virtual:            virtual,
+		tokenService:       tokenService,
+		throttleService:    throttleService,
+		clientOrderService: clientOrderService,
+		clock:              clock,
+		interval:           time.Minute,
+		timeout:            10 * time.Second,
+	}
+	got := NewOrderHistoryService(store, security, virtual, tokenService, throttleService, clientOrderService, clock)
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_orderHistory_refresh(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	stock := &kabuspb.Order{Id: "ORDER-1"}
+	future := &kabuspb.Order{Id: "ORDER-2"}
+	virtualMargin := &kabuspb.Order{Id: "VIRTUAL-1"}
+
+	tests := []struct {
+		name      string
+		isVirtual bool
+		security  *testSecurity
+		virtual   *testVirtualSecurity
+		want      [][]*kabuspb.OrderHistoryRecord
+	}{
+		{name: "実注文を商品ごとに取得して商品と一緒に保存する",
+			security: &testSecurity{byProduct: map[kabuspb.Product]*kabuspb.Orders{
+				kabuspb.Product_PRODUCT_STOCK:  {Orders: []*kabuspb.Order{stock}},
+				kabuspb.Product_PRODUCT_FUTURE: {Orders: []*kabuspb.Order{future}},
+			}},
+			want: [][]*kabuspb.OrderHistoryRecord{{
+				{Order: stock, Product: kabuspb.Product_PRODUCT_STOCK, SavedAt: timestamppb.New(now)},
+				{Order: future, Product: kabuspb.Product_PRODUCT_FUTURE, SavedAt: timestamppb.New(now)},
+			}}},
+		{name: "仮想証券会社の注文も保存する",
+			isVirtual: true,
+			virtual: &testVirtualSecurity{byProduct: map[kabuspb.Product]*kabuspb.Orders{
+				kabuspb.Product_PRODUCT_MARGIN: {Orders: []*kabuspb.Order{virtualMargin}},
+			}},
+			want: [][]*kabuspb.OrderHistoryRecord{{
+				{Order: virtualMargin, Product: kabuspb.Product_PRODUCT_MARGIN, IsVirtual: true, SavedAt: timestamppb.New(now)},
+			}}},
+		{name: "取得でエラーがあれば保存しない",
+			security: &testSecurity{ordersErr: errors.New("error message")},
+			want:     nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &testOrderHistoryStore{}
+			service := &orderHistory{
+				store:              store,
+				security:           test.security,
+				virtual:            test.virtual,
+				tokenService:       &testTokenService{},
+				throttleService:    &testThrottleService{},
+				clientOrderService: &testClientOrderService{},
+				clock:              &testClock{now: now},
+				timeout:            time.Second,
+				pending:            map[bool]bool{test.isVirtual: true},
+			}
+			service.refresh(test.isVirtual)
+			if !reflect.DeepEqual(test.want, store.saved) || service.pending[test.isVirtual] {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, store.saved, service.pending)
+			}
+		})
+	}
+}
+
+func Test_orderHistory_OnOrderEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		pending   map[bool]bool
+		wantSaved bool
+	}{
+		{name: "取得し直すのを待っていなければ取得し直す", wantSaved: true},
+		{name: "既に取得し直すのを待っていれば何もしない", pending: map[bool]bool{false: true}, wantSaved: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &testOrderHistoryStore{}
+			service := &orderHistory{
+				store:              store,
+				security:           &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{{Id: "ORDER-1"}}}},
+				tokenService:       &testTokenService{},
+				throttleService:    &testThrottleService{},
+				clientOrderService: &testClientOrderService{},
+				clock:              &testClock{},
+				timeout:            time.Second,
+				pending:            test.pending,
+			}
+			service.OnOrderEvent(&kabuspb.OrderEvent{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED})
+			time.Sleep(100 * time.Millisecond)
+
+			service.refreshMtx.Lock()
+			got := len(store.saved) > 0
+			service.refreshMtx.Unlock()
+			if test.wantSaved != got || service.IsListening() {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantSaved, got)
+			}
+		})
+	}
+}
+
+func Test_orderHistory_Find(t *testing.T) {
+	t.Parallel()
+	at := func(hour int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2021, 9, 13, hour, 0, 0, 0, time.UTC))
+	}
+	r1 := &kabuspb.OrderHistoryRecord{Order: &kabuspb.Order{Id: "ORDER-1", SymbolCode: "1320", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, ReceiveTime: at(10)}, Product: kabuspb.Product_PRODUCT_STOCK}
+	r2 := &kabuspb.OrderHistoryRecord{Order: &kabuspb.Order{Id: "ORDER-2", SymbolCode: "7203", OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED, ReceiveTime: at(9)}, Product: kabuspb.Product_PRODUCT_MARGIN}
+	r3 := &kabuspb.OrderHistoryRecord{Order: &kabuspb.Order{Id: "VIRTUAL-1", SymbolCode: "1320", OrderState: kabuspb.OrderState_ORDER_STATE_DONE, ReceiveTime: at(11)}, Product: kabuspb.Product_PRODUCT_STOCK, IsVirtual: true}
+
+	tests := []struct {
+		name     string
+		all1     []*kabuspb.OrderHistoryRecord
+		all2     error
+		req      *kabuspb.GetOrderHistoryRequest
+		want     *kabuspb.OrderHistory
+		hasError bool
+	}{
+		{name: "読めなければエラーを返す", all2: errors.New("error message"), req: &kabuspb.GetOrderHistoryRequest{}, hasError: true},
+		{name: "条件がなければ全て受注日時の古い順に返す", all1: []*kabuspb.OrderHistoryRecord{r1, r2, r3}, req: &kabuspb.GetOrderHistoryRequest{},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r2, r1, r3}}},
+		{name: "受注日時の下限は含み上限は含まない", all1: []*kabuspb.OrderHistoryRecord{r1, r2, r3}, req: &kabuspb.GetOrderHistoryRequest{From: at(10), To: at(11)},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r1}}},
+		{name: "銘柄コードで絞り込む", all1: []*kabuspb.OrderHistoryRecord{r1, r2, r3}, req: &kabuspb.GetOrderHistoryRequest{SymbolCode: "1320"},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r1, r3}}},
+		{name: "商品で絞り込む", all1: []*kabuspb.OrderHistoryRecord{r1, r2, r3}, req: &kabuspb.GetOrderHistoryRequest{Product: kabuspb.Product_PRODUCT_MARGIN},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r2}}},
+		{name: "すべての商品なら絞り込まない", all1: []*kabuspb.OrderHistoryRecord{r1, r2}, req: &kabuspb.GetOrderHistoryRequest{Product: kabuspb.Product_PRODUCT_ALL},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r2, r1}}},
+		{name: "状態で絞り込む", all1: []*kabuspb.OrderHistoryRecord{r1, r2, r3}, req: &kabuspb.GetOrderHistoryRequest{State: kabuspb.OrderState_ORDER_STATE_DONE},
+			want: &kabuspb.OrderHistory{Records: []*kabuspb.OrderHistoryRecord{r1, r3}}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &orderHistory{store: &testOrderHistoryStore{all1: test.all1, all2: test.all2}}
+			got1, got2 := service.Find(test.req)
+			if !reflect.DeepEqual(test.want, got1) || (got2 != nil) != test.hasError {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got1, got2)
+			}
+		})
+	}
+}
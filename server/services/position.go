@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewPositionService(
+	streamStore repositories.PositionEventStreamStore,
+	security repositories.Security,
+	virtual repositories.VirtualSecurity,
+	tokenService TokenService,
+	throttleService ThrottleService,
+	clock repositories.Clock) PositionService {
+	return &position{
+		streamStore:     streamStore,
+		security:        security,
+		virtual:         virtual,
+		tokenService:    tokenService,
+		throttleService: throttleService,
+		clock:           clock,
+		interval:        5 * time.Second,
+		timeout:         5 * time.Second,
+		listenFor:       time.Minute,
+		done:            make(chan struct{}),
+	}
+}
+
+// errPositionEventStreamClosed - サーバーの終了でストリームを終了したときのエラー
+var errPositionEventStreamClosed = status.Error(codes.Unavailable, "server is shutting down")
+
+// PositionService - 実建玉と仮想証券会社の建玉を定期的と約定の検知時に取得して保持し、建玉の変化をイベントとして配信する
+type PositionService interface {
+	OrderEventListener
+	Start()
+	Cached(req *kabuspb.GetPositionsRequest, maxAge time.Duration) (*kabuspb.Positions, bool)
+	Connect(stream kabuspb.KabusService_GetPositionEventsStreamingServer) error
+	Close()
+}
+
+// positionCache - 保持している建玉
+type positionCache struct {
+	positions []*kabuspb.Position
+	updatedAt time.Time // 取得した日時、ゼロ値なら取得していない
+	baseline  bool      // ストリームがある間に取得していて、イベントの基準にできるか
+}
+
+type position struct {
+	streamStore     repositories.PositionEventStreamStore
+	security        repositories.Security
+	virtual         repositories.VirtualSecurity
+	tokenService    TokenService
+	throttleService ThrottleService
+	clock           repositories.Clock
+	interval        time.Duration // 定期的に取得する間隔
+	timeout         time.Duration // 1回の取得にかける時間の上限
+	listenFor       time.Duration // キャッシュが使われてから約定の検知で取得し直す期間
+	realCache       positionCache
+	virtualCache    positionCache
+	cachedAt        time.Time     // 最後にキャッシュが使われた日時
+	pending         map[bool]bool // 実建玉か仮想証券会社の建玉かごとに、取得し直すのを待っているか
+	started         bool
+	closed          bool
+	done            chan struct{}
+	mtx             sync.Mutex
+	refreshMtx      sync.Mutex // 取得と基準の更新を直列にする
+}
+
+// Start - 定期的な取得を始める、既に始まっているか終了していたら何もしない、ストリームもキャッシュの利用もない間は取得しない
+func (s *position) Start() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.started || s.closed {
+		return
+	}
+	s.started = true
+
+	go func() {
+		s.refreshIfListening()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				s.refreshIfListening()
+			}
+		}
+	}()
+}
+
+// Cached - 保持している建玉がmaxAge以内に取得したものであれば、リクエストの条件で絞り込んで返す
+func (s *position) Cached(req *kabuspb.GetPositionsRequest, maxAge time.Duration) (*kabuspb.Positions, bool) {
+	now := s.clock.Now()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.cachedAt = now
+
+	cache := s.realCache
+	if req.IsVirtual {
+		cache = s.virtualCache
+	}
+	if cache.updatedAt.IsZero() || now.Sub(cache.updatedAt) > maxAge {
+		return nil, false
+	}
+
+	res := make([]*kabuspb.Position, 0)
+	for _, p := range cache.positions {
+		if positionMatches(req, p) {
+			res = append(res, p)
+		}
+	}
+	return &kabuspb.Positions{Positions: res}, true
+}
+
+// IsListening - ストリームがあるか、最近キャッシュが使われていれば約定の検知を受け取る
+func (s *position) IsListening() bool {
+	if s.streamStore.HasStream() {
+		return true
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return !s.closed && !s.cachedAt.IsZero() && s.clock.Now().Sub(s.cachedAt) <= s.listenFor
+}
+
+// OnOrderEvent - 約定を検知したら、約定した側の建玉を取得し直す、既に取得し直すのを待っていれば何もしない
+func (s *position) OnOrderEvent(event *kabuspb.OrderEvent) {
+	switch event.EventType {
+	case kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED, kabuspb.OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED:
+	default:
+		return
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.pending[event.IsVirtual] {
+		return
+	}
+	if s.pending == nil {
+		s.pending = map[bool]bool{}
+	}
+	s.pending[event.IsVirtual] = true
+
+	go s.refresh(event.IsVirtual)
+}
+
+func (s *position) Connect(stream kabuspb.KabusService_GetPositionEventsStreamingServer) error {
+	s.Start()
+
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return errPositionEventStreamClosed
+	}
+	ch := make(chan error, 1) // Removeが受け取りを待たないようにする
+	seq := s.streamStore.Add(stream, ch)
+	s.mtx.Unlock()
+
+	select {
+	case err := <-ch:
+		return err
+	case <-stream.Context().Done():
+		// 建玉が変化して送れなくなるまで待つと、切断したクライアントのためにイベントを作り続けてしまうので、すぐに外す
+		s.streamStore.Remove(seq, nil)
+		return status.FromContextError(stream.Context().Err()).Err()
+	}
+}
+
+// Close - 全てのストリームをUnavailableで終了して定期的な取得を止める、以降は接続できない
+func (s *position) Close() {
+	s.mtx.Lock()
+	if s.closed {
+		s.mtx.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.done)
+	seqs := make([]int, 0)
+	for i := range s.streamStore.All() {
+		seqs = append(seqs, i)
+	}
+	s.mtx.Unlock()
+
+	// ストリームの終了を待つ間に接続や建玉の取得を止めないように、ロックを外してから終了させる
+	for _, i := range seqs {
+		s.streamStore.Remove(i, errPositionEventStreamClosed)
+	}
+}
+
+// refreshIfListening - 誰も建玉を使っていなければ、APIの上限を使わないように取得しない
+func (s *position) refreshIfListening() {
+	if !s.IsListening() {
+		return
+	}
+	s.refreshAll()
+}
+
+func (s *position) refreshAll() {
+	s.refresh(false)
+	s.refresh(true)
+}
+
+// refresh - 建玉を取得して保持し、前回からの変化を配信する、取得でエラーがあれば前回の建玉を残す
+func (s *position) refresh(isVirtual bool) {
+	s.refreshMtx.Lock()
+	defer s.refreshMtx.Unlock()
+
+	s.mtx.Lock()
+	delete(s.pending, isVirtual)
+	s.mtx.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var positions []*kabuspb.Position
+	var err error
+	if isVirtual {
+		positions, err = s.fetchVirtualPositions(ctx)
+	} else {
+		positions, err = s.fetchRealPositions(ctx)
+	}
+	if err != nil {
+		return
+	}
+
+	for _, event := range s.update(isVirtual, positions) {
+		for i, stream := range s.streamStore.All() {
+			if err := stream.Send(event); err != nil {
+				log.Println(err) // デバッグのためにおいとく
+				s.streamStore.Remove(i, err)
+			}
+		}
+	}
+}
+
+func (s *position) fetchRealPositions(ctx context.Context) ([]*kabuspb.Position, error) {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_LOW); err != nil {
+		return nil, err
+	}
+
+	var positions *kabuspb.Positions
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		positions, err = s.security.Positions(ctx, token, &kabuspb.GetPositionsRequest{Product: kabuspb.Product_PRODUCT_ALL, GetInfo: true})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return positions.Positions, nil
+}
+
+func (s *position) fetchVirtualPositions(ctx context.Context) ([]*kabuspb.Position, error) {
+	positions, err := s.virtual.Positions(ctx, "", &kabuspb.GetPositionsRequest{Product: kabuspb.Product_PRODUCT_ALL, GetInfo: true})
+	if err != nil {
+		return nil, err
+	}
+	return positions.Positions, nil
+}
+
+// update - 保持している建玉と比べてイベントを作り、今回の建玉を保持する、基準がなければイベントを作らない
+func (s *position) update(isVirtual bool, positions []*kabuspb.Position) []*kabuspb.PositionEvent {
+	now := s.clock.Now()
+	hasStream := s.streamStore.HasStream()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	cache := &s.realCache
+	if isVirtual {
+		cache = &s.virtualCache
+	}
+
+	events := make([]*kabuspb.PositionEvent, 0)
+	if hasStream && cache.baseline {
+		events = positionEvents(cache.positions, positions, isVirtual, now)
+	}
+	*cache = positionCache{positions: positions, updatedAt: now, baseline: hasStream}
+	return events
+}
+
+// positionEvents - 前回の建玉と今回の建玉を約定番号で突き合わせてイベントを作る
+func positionEvents(prev []*kabuspb.Position, cur []*kabuspb.Position, isVirtual bool, now time.Time) []*kabuspb.PositionEvent {
+	before := make(map[string]*kabuspb.Position, len(prev))
+	for _, p := range prev {
+		before[p.ExecutionId] = p
+	}
+
+	events := make([]*kabuspb.PositionEvent, 0)
+	seen := make(map[string]bool, len(cur))
+	for _, p := range cur {
+		seen[p.ExecutionId] = true
+		b, ok := before[p.ExecutionId]
+		switch {
+		case !ok && p.LeavesQuantity > 0:
+			events = append(events, &kabuspb.PositionEvent{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_OPENED, Position: p, IsVirtual: isVirtual, DetectedAt: timestamppb.New(now)})
+		case !ok:
+		case b.LeavesQuantity > 0 && p.LeavesQuantity <= 0:
+			events = append(events, &kabuspb.PositionEvent{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CLOSED, Position: p, Previous: b, IsVirtual: isVirtual, DetectedAt: timestamppb.New(now)})
+		case b.LeavesQuantity != p.LeavesQuantity || b.HoldQuantity != p.HoldQuantity:
+			events = append(events, &kabuspb.PositionEvent{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CHANGED, Position: p, Previous: b, IsVirtual: isVirtual, DetectedAt: timestamppb.New(now)})
+		}
+	}
+
+	for _, p := range prev {
+		if !seen[p.ExecutionId] && p.LeavesQuantity > 0 {
+			events = append(events, &kabuspb.PositionEvent{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CLOSED, Position: p, Previous: p, IsVirtual: isVirtual, DetectedAt: timestamppb.New(now)})
+		}
+	}
+	return events
+}
+
+// positionMatches - 建玉がリクエストの商品・銘柄コード・売買区分の条件に合っているか
+func positionMatches(req *kabuspb.GetPositionsRequest, p *kabuspb.Position) bool {
+	if req.Product != kabuspb.Product_PRODUCT_UNSPECIFIED && req.Product != kabuspb.Product_PRODUCT_ALL && req.Product != positionProduct(p) {
+		return false
+	}
+	if req.SymbolCode != "" && req.SymbolCode != p.SymbolCode {
+		return false
+	}
+	if req.Side != kabuspb.Side_SIDE_UNSPECIFIED && req.Side != p.Side {
+		return false
+	}
+	return true
+}
+
+// positionProduct - 建玉の信用取引区分と銘柄種別から商品を決める
+func positionProduct(p *kabuspb.Position) kabuspb.Product {
+	switch {
+	case p.MarginTradeType != kabuspb.MarginTradeType_MARGIN_TRADE_TYPE_UNSPECIFIED:
+		return kabuspb.Product_PRODUCT_MARGIN
+	case p.SecurityType == kabuspb.SecurityType_SECURITY_TYPE_NK225_OP:
+		return kabuspb.Product_PRODUCT_OPTION
+	case p.SecurityType == kabuspb.SecurityType_SECURITY_TYPE_UNSPECIFIED, p.SecurityType == kabuspb.SecurityType_SECURITY_TYPE_STOCK:
+		return kabuspb.Product_PRODUCT_STOCK
+	default:
+		return kabuspb.Product_PRODUCT_FUTURE
+	}
+}
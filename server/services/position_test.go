@@ -0,0 +1,428 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewPositionService(t *testing.T) {
+	streamStore := &testPositionEventStreamStore{}
+	security := &testSecurity{}
+	virtual := &testVirtualSecurity{}
+	tokenService := &testTokenService{}
+	throttleService := &testThrottleService{}
+	clock := &testClock{}
+	got := NewPositionService(streamStore, security, virtual, tokenService, throttleService, clock).(*position)
+
+	t.Parallel()
+	if got.streamStore != streamStore || got.security != security || got.virtual != virtual || got.tokenService != tokenService ||
+		got.throttleService != throttleService || got.clock != clock ||
+		got.interval != 5*time.Second || got.timeout != 5*time.Second || got.listenFor != time.Minute || got.done == nil {
+		t.Errorf("%s error\ngot: %+v\n", t.Name(), got)
+	}
+}
+
+func Test_position_Cached(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	stock := &kabuspb.Position{ExecutionId: "E1", SymbolCode: "1320", Side: kabuspb.Side_SIDE_BUY, SecurityType: kabuspb.SecurityType_SECURITY_TYPE_STOCK}
+	margin := &kabuspb.Position{ExecutionId: "E2", SymbolCode: "1320", Side: kabuspb.Side_SIDE_SELL, MarginTradeType: kabuspb.MarginTradeType_MARGIN_TRADE_TYPE_SYSTEM}
+	future := &kabuspb.Position{ExecutionId: "E3", SymbolCode: "167090019", Side: kabuspb.Side_SIDE_BUY, SecurityType: kabuspb.SecurityType_SECURITY_TYPE_NK225_MINI}
+	virtual := &kabuspb.Position{ExecutionId: "V1", SymbolCode: "1320", Side: kabuspb.Side_SIDE_BUY}
+
+	tests := []struct {
+		name   string
+		req    *kabuspb.GetPositionsRequest
+		maxAge time.Duration
+		want1  *kabuspb.Positions
+		want2  bool
+	}{
+		{name: "許容する古さを超えていたら返さない", req: &kabuspb.GetPositionsRequest{}, maxAge: time.Second, want1: nil, want2: false},
+		{name: "許容する古さ以内なら全て返す", req: &kabuspb.GetPositionsRequest{Product: kabuspb.Product_PRODUCT_ALL}, maxAge: 3 * time.Second,
+			want1: &kabuspb.Positions{Positions: []*kabuspb.Position{stock, margin, future}}, want2: true},
+		{name: "商品で絞り込む", req: &kabuspb.GetPositionsRequest{Product: kabuspb.Product_PRODUCT_MARGIN}, maxAge: 3 * time.Second,
+			want1: &kabuspb.Positions{Positions: []*kabuspb.Position{margin}}, want2: true},
+		{name: "銘柄コードと売買区分で絞り込む", req: &kabuspb.GetPositionsRequest{SymbolCode: "1320", Side: kabuspb.Side_SIDE_BUY}, maxAge: 3 * time.Second,
+			want1: &kabuspb.Positions{Positions: []*kabuspb.Position{stock}}, want2: true},
+		{name: "仮想売買なら仮想証券会社の建玉を返す", req: &kabuspb.GetPositionsRequest{IsVirtual: true}, maxAge: time.Minute,
+			want1: &kabuspb.Positions{Positions: []*kabuspb.Position{virtual}}, want2: true},
+		{name: "仮想証券会社の建玉も許容する古さを超えていたら返さない", req: &kabuspb.GetPositionsRequest{IsVirtual: true}, maxAge: 3 * time.Second, want1: nil, want2: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &position{
+				clock:        &testClock{now: now},
+				realCache:    positionCache{positions: []*kabuspb.Position{stock, margin, future}, updatedAt: now.Add(-2 * time.Second)},
+				virtualCache: positionCache{positions: []*kabuspb.Position{virtual}, updatedAt: now.Add(-10 * time.Second)},
+			}
+			got1, got2 := service.Cached(test.req, test.maxAge)
+			if !reflect.DeepEqual(test.want1, got1) || test.want2 != got2 || !service.cachedAt.Equal(now) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want1, test.want2, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_position_IsListening(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	tests := []struct {
+		name      string
+		hasStream bool
+		cachedAt  time.Time
+		closed    bool
+		want      bool
+	}{
+		{name: "ストリームがあれば受け取る", hasStream: true, want: true},
+		{name: "キャッシュが使われていなければ受け取らない", want: false},
+		{name: "最近キャッシュが使われていれば受け取る", cachedAt: now.Add(-time.Minute), want: true},
+		{name: "キャッシュが使われてから時間が経っていれば受け取らない", cachedAt: now.Add(-time.Minute - time.Second), want: false},
+		{name: "終了していれば受け取らない", cachedAt: now, closed: true, want: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &position{
+				streamStore: &testPositionEventStreamStore{hasStream: test.hasStream},
+				clock:       &testClock{now: now},
+				listenFor:   time.Minute,
+				cachedAt:    test.cachedAt,
+				closed:      test.closed,
+			}
+			got := service.IsListening()
+			if test.want != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_position_refreshIfListening(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	tests := []struct {
+		name        string
+		hasStream   bool
+		cachedAt    time.Time
+		wantRefresh bool
+	}{
+		{name: "ストリームがあれば取得する", hasStream: true, wantRefresh: true},
+		{name: "最近キャッシュが使われていれば取得する", cachedAt: now, wantRefresh: true},
+		{name: "ストリームもキャッシュの利用もなければ取得しない", wantRefresh: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &position{
+				streamStore:     &testPositionEventStreamStore{hasStream: test.hasStream},
+				security:        &testSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "E1"}}}},
+				virtual:         &testVirtualSecurity{positions: &kabuspb.Positions{}},
+				tokenService:    &testTokenService{},
+				throttleService: &testThrottleService{},
+				clock:           &testClock{now: now},
+				timeout:         time.Second,
+				listenFor:       time.Minute,
+				cachedAt:        test.cachedAt,
+			}
+			service.refreshIfListening()
+			got := !service.realCache.updatedAt.IsZero()
+			if test.wantRefresh != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantRefresh, got)
+			}
+		})
+	}
+}
+
+func Test_position_OnOrderEvent(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		event       *kabuspb.OrderEvent
+		pending     map[bool]bool
+		wantRefresh bool
+	}{
+		{name: "全約定なら取得し直す", event: &kabuspb.OrderEvent{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED}, wantRefresh: true},
+		{name: "一部約定なら取得し直す", event: &kabuspb.OrderEvent{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_PARTIALLY_FILLED}, wantRefresh: true},
+		{name: "受付なら取得し直さない", event: &kabuspb.OrderEvent{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_ACCEPTED}, wantRefresh: false},
+		{name: "既に取得し直すのを待っていれば何もしない", event: &kabuspb.OrderEvent{EventType: kabuspb.OrderEventType_ORDER_EVENT_TYPE_FILLED}, pending: map[bool]bool{false: true}, wantRefresh: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &position{
+				streamStore:     &testPositionEventStreamStore{},
+				security:        &testSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{{ExecutionId: "E1"}}}},
+				tokenService:    &testTokenService{},
+				throttleService: &testThrottleService{},
+				clock:           &testClock{},
+				timeout:         time.Second,
+				pending:         test.pending,
+			}
+			service.OnOrderEvent(test.event)
+			time.Sleep(100 * time.Millisecond)
+
+			service.mtx.Lock()
+			got := !service.realCache.updatedAt.IsZero()
+			service.mtx.Unlock()
+			if test.wantRefresh != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantRefresh, got)
+			}
+		})
+	}
+}
+
+func Test_position_Connect(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		closed       bool
+		want         error
+		wantAddCount int
+	}{
+		{name: "終了していたらストリームを追加せずにエラーを返す", closed: true, want: errPositionEventStreamClosed},
+		{name: "ストリームを追加して終了を待つ", wantAddCount: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			store := &testPositionEventStreamStore{}
+			service := &position{streamStore: store, closed: test.closed, started: true, done: make(chan struct{})}
+			got := service.Connect(&testGetPositionEventsStreamingServer{})
+			if !errors.Is(got, test.want) || store.addCount != test.wantAddCount {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantAddCount, got, store.addCount)
+			}
+		})
+	}
+}
+
+func Test_position_Connect_canceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	store := &testPositionEventStreamStore{}
+	service := &position{streamStore: store, started: true, done: make(chan struct{})}
+
+	// クライアントが切断したら、終了を待たずにストリームを外す
+	got := service.Connect(&testGetPositionEventsStreamingServer{ctx: ctx})
+	if status.Code(got) != codes.Canceled || store.removeCount != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), codes.Canceled, 1, got, store.removeCount)
+	}
+}
+
+func Test_position_Close(t *testing.T) {
+	t.Parallel()
+	store := &testPositionEventStreamStore{all: map[int]kabuspb.KabusService_GetPositionEventsStreamingServer{
+		0: &testGetPositionEventsStreamingServer{},
+		1: &testGetPositionEventsStreamingServer{},
+	}}
+	service := &position{streamStore: store, done: make(chan struct{})}
+	service.Close()
+	service.Close()
+
+	select {
+	case <-service.done:
+	default:
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "closed", "not closed")
+	}
+	if !service.closed || store.removeCount != 2 || !errors.Is(store.lastRemoveErr, errPositionEventStreamClosed) {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), true, 2, errPositionEventStreamClosed, service.closed, store.removeCount, store.lastRemoveErr)
+	}
+}
+
+func Test_position_Close_unlocked(t *testing.T) {
+	t.Parallel()
+	store := &testPositionEventStreamStore{all: map[int]kabuspb.KabusService_GetPositionEventsStreamingServer{0: &testGetPositionEventsStreamingServer{}}}
+	service := &position{streamStore: store, done: make(chan struct{})}
+	// 終了を待っている接続がロックを取れなければ、ストリームを終了できずに止まる
+	store.onRemove = func() {
+		service.mtx.Lock()
+		defer service.mtx.Unlock()
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		service.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "closed", "deadlock")
+	}
+}
+
+func Test_position_refresh(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	before := &kabuspb.Position{ExecutionId: "E1", LeavesQuantity: 100}
+	after := &kabuspb.Position{ExecutionId: "E1", LeavesQuantity: 100, HoldQuantity: 100}
+
+	tests := []struct {
+		name      string
+		hasStream bool
+		isVirtual bool
+		cache     positionCache
+		security  *testSecurity
+		virtual   *testVirtualSecurity
+		want      []*kabuspb.PositionEvent
+		wantCache positionCache
+	}{
+		{name: "ストリームがなければ保持するだけで基準にしない",
+			cache:     positionCache{positions: []*kabuspb.Position{before}, baseline: true},
+			security:  &testSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{after}}},
+			want:      []*kabuspb.PositionEvent{},
+			wantCache: positionCache{positions: []*kabuspb.Position{after}, updatedAt: now}},
+		{name: "基準がなければイベントを作らずに基準にする",
+			hasStream: true,
+			cache:     positionCache{positions: []*kabuspb.Position{before}},
+			security:  &testSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{after}}},
+			want:      []*kabuspb.PositionEvent{},
+			wantCache: positionCache{positions: []*kabuspb.Position{after}, updatedAt: now, baseline: true}},
+		{name: "基準があれば変化を配信する",
+			hasStream: true,
+			cache:     positionCache{positions: []*kabuspb.Position{before}, baseline: true},
+			security:  &testSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{after}}},
+			want: []*kabuspb.PositionEvent{
+				{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CHANGED, Position: after, Previous: before, DetectedAt: timestamppb.New(now)},
+			},
+			wantCache: positionCache{positions: []*kabuspb.Position{after}, updatedAt: now, baseline: true}},
+		{name: "仮想証券会社の建玉の変化を配信する",
+			hasStream: true,
+			isVirtual: true,
+			cache:     positionCache{positions: []*kabuspb.Position{}, baseline: true},
+			virtual:   &testVirtualSecurity{positions: &kabuspb.Positions{Positions: []*kabuspb.Position{before}}},
+			want: []*kabuspb.PositionEvent{
+				{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_OPENED, Position: before, IsVirtual: true, DetectedAt: timestamppb.New(now)},
+			},
+			wantCache: positionCache{positions: []*kabuspb.Position{before}, updatedAt: now, baseline: true}},
+		{name: "取得でエラーがあれば前回の建玉を残す",
+			hasStream: true,
+			cache:     positionCache{positions: []*kabuspb.Position{before}, baseline: true},
+			security:  &testSecurity{posErr: errors.New("error message")},
+			want:      []*kabuspb.PositionEvent{},
+			wantCache: positionCache{positions: []*kabuspb.Position{before}, baseline: true}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			stream := &testGetPositionEventsStreamingServer{}
+			service := &position{
+				streamStore:     &testPositionEventStreamStore{hasStream: test.hasStream, all: map[int]kabuspb.KabusService_GetPositionEventsStreamingServer{0: stream}},
+				security:        test.security,
+				virtual:         test.virtual,
+				tokenService:    &testTokenService{},
+				throttleService: &testThrottleService{},
+				clock:           &testClock{now: now},
+				timeout:         time.Second,
+			}
+			if test.isVirtual {
+				service.virtualCache = test.cache
+			} else {
+				service.realCache = test.cache
+			}
+			service.refresh(test.isVirtual)
+
+			got := stream.sent
+			if got == nil {
+				got = []*kabuspb.PositionEvent{}
+			}
+			gotCache := service.realCache
+			if test.isVirtual {
+				gotCache = service.virtualCache
+			}
+			if !reflect.DeepEqual(test.want, got) || !reflect.DeepEqual(test.wantCache, gotCache) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantCache, got, gotCache)
+			}
+		})
+	}
+}
+
+func Test_positionEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)
+	opened := &kabuspb.Position{ExecutionId: "E1", LeavesQuantity: 100}
+	held := &kabuspb.Position{ExecutionId: "E1", LeavesQuantity: 100, HoldQuantity: 100}
+	partial := &kabuspb.Position{ExecutionId: "E1", LeavesQuantity: 50}
+	zero := &kabuspb.Position{ExecutionId: "E1"}
+
+	tests := []struct {
+		name      string
+		prev      []*kabuspb.Position
+		cur       []*kabuspb.Position
+		isVirtual bool
+		want      []*kabuspb.PositionEvent
+	}{
+		{name: "変化がなければイベントはない", prev: []*kabuspb.Position{opened}, cur: []*kabuspb.Position{opened}, want: []*kabuspb.PositionEvent{}},
+		{name: "新しい建玉は新規", prev: []*kabuspb.Position{}, cur: []*kabuspb.Position{opened}, isVirtual: true,
+			want: []*kabuspb.PositionEvent{{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_OPENED, Position: opened, IsVirtual: true, DetectedAt: timestamppb.New(now)}}},
+		{name: "残数量のない新しい建玉はイベントにしない", prev: []*kabuspb.Position{}, cur: []*kabuspb.Position{zero}, want: []*kabuspb.PositionEvent{}},
+		{name: "拘束数量の変化は変化", prev: []*kabuspb.Position{opened}, cur: []*kabuspb.Position{held},
+			want: []*kabuspb.PositionEvent{{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CHANGED, Position: held, Previous: opened, DetectedAt: timestamppb.New(now)}}},
+		{name: "一部決済は変化", prev: []*kabuspb.Position{opened}, cur: []*kabuspb.Position{partial},
+			want: []*kabuspb.PositionEvent{{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CHANGED, Position: partial, Previous: opened, DetectedAt: timestamppb.New(now)}}},
+		{name: "残数量がなくなれば決済", prev: []*kabuspb.Position{opened}, cur: []*kabuspb.Position{zero},
+			want: []*kabuspb.PositionEvent{{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CLOSED, Position: zero, Previous: opened, DetectedAt: timestamppb.New(now)}}},
+		{name: "一覧からなくなれば最後の建玉で決済", prev: []*kabuspb.Position{opened}, cur: []*kabuspb.Position{},
+			want: []*kabuspb.PositionEvent{{EventType: kabuspb.PositionEventType_POSITION_EVENT_TYPE_CLOSED, Position: opened, Previous: opened, DetectedAt: timestamppb.New(now)}}},
+		{name: "残数量のない建玉が一覧からなくなってもイベントにしない", prev: []*kabuspb.Position{zero}, cur: []*kabuspb.Position{}, want: []*kabuspb.PositionEvent{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := positionEvents(test.prev, test.cur, test.isVirtual, now)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_positionProduct(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		position *kabuspb.Position
+		want     kabuspb.Product
+	}{
+		{name: "信用取引区分があれば信用", position: &kabuspb.Position{MarginTradeType: kabuspb.MarginTradeType_MARGIN_TRADE_TYPE_GENERAL_DAY}, want: kabuspb.Product_PRODUCT_MARGIN},
+		{name: "銘柄種別がなければ現物", position: &kabuspb.Position{}, want: kabuspb.Product_PRODUCT_STOCK},
+		{name: "株式なら現物", position: &kabuspb.Position{SecurityType: kabuspb.SecurityType_SECURITY_TYPE_STOCK}, want: kabuspb.Product_PRODUCT_STOCK},
+		{name: "日経225OPならオプション", position: &kabuspb.Position{SecurityType: kabuspb.SecurityType_SECURITY_TYPE_NK225_OP}, want: kabuspb.Product_PRODUCT_OPTION},
+		{name: "それ以外の銘柄種別なら先物", position: &kabuspb.Position{SecurityType: kabuspb.SecurityType_SECURITY_TYPE_TOPIX}, want: kabuspb.Product_PRODUCT_FUTURE},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := positionProduct(test.position)
+			if test.want != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewQuotaService(clock repositories.Clock, setting repositories.Setting) QuotaService {
+	return &quota{clock: clock, setting: setting, idle: time.Hour, usages: map[quotaKey]*quotaUsage{}}
+}
+
+// QuotaService - ツールごとのkabusapi呼出しの利用枠の管理と利用状況の集計
+type QuotaService interface {
+	Use(requester string, category kabuspb.ThrottleCategory) error
+	Usage(requester string) []*kabuspb.Usage
+}
+
+type quota struct {
+	clock   repositories.Clock
+	setting repositories.Setting
+	idle    time.Duration // これより長く使われていない集計は捨てる
+	sweptAt time.Time     // 最後に使われていない集計を捨てた日時
+	usages  map[quotaKey]*quotaUsage
+	mtx     sync.Mutex
+}
+
+type quotaKey struct {
+	requester string
+	category  kabuspb.ThrottleCategory
+}
+
+type quotaUsage struct {
+	usedAt      time.Time // 最後に利用枠を確認した日時
+	calls       int64
+	rejections  int64
+	second      time.Time
+	secondCalls int
+	minute      time.Time
+	minuteCalls int
+}
+
+// roll - 集計中の1秒間・1分間が過ぎていたら数え直す
+func (u *quotaUsage) roll(now time.Time) {
+	if second := now.Truncate(time.Second); !u.second.Equal(second) {
+		u.second = second
+		u.secondCalls = 0
+	}
+	if minute := now.Truncate(time.Minute); !u.minute.Equal(minute) {
+		u.minute = minute
+		u.minuteCalls = 0
+	}
+}
+
+// Use - 利用枠に空きがあれば1回分使う、空きがなければResourceExhaustedのエラーを返す
+func (s *quota) Use(requester string, category kabuspb.ThrottleCategory) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.clock.Now()
+	s.sweep(now)
+
+	key := quotaKey{requester: requester, category: category}
+	usage, ok := s.usages[key]
+	if !ok {
+		usage = &quotaUsage{}
+		s.usages[key] = usage
+	}
+	usage.usedAt = now
+	usage.roll(now)
+
+	perSecond, perMinute := s.setting.Quota(requester, category)
+	if perSecond > 0 && usage.secondCalls >= perSecond {
+		usage.rejections++
+		return status.Errorf(codes.ResourceExhausted, "quota exceeded: requester=%q, category=%s, %d calls per second", requester, category, perSecond)
+	}
+	if perMinute > 0 && usage.minuteCalls >= perMinute {
+		usage.rejections++
+		return status.Errorf(codes.ResourceExhausted, "quota exceeded: requester=%q, category=%s, %d calls per minute", requester, category, perMinute)
+	}
+
+	usage.calls++
+	usage.secondCalls++
+	usage.minuteCalls++
+	return nil
+}
+
+// sweep - ツール名は呼出し元が自由に付けられるので、使われなくなった集計をidleごとに捨てて溜め続けないようにする (要ロック)
+func (s *quota) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < s.idle {
+		return
+	}
+	s.sweptAt = now
+	for key, usage := range s.usages {
+		// 捨てる集計は今の1分間の利用枠を使っていないので、捨てても利用枠の判定は変わらない
+		if now.Sub(usage.usedAt) >= s.idle {
+			delete(s.usages, key)
+		}
+	}
+}
+
+// Usage - ツールの利用状況を返す、ツール名が空なら全ツール分を返す
+func (s *quota) Usage(requester string) []*kabuspb.Usage {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.clock.Now()
+	res := make([]*kabuspb.Usage, 0)
+	for key, usage := range s.usages {
+		if requester != "" && key.requester != requester {
+			continue
+		}
+		usage.roll(now)
+		perSecond, perMinute := s.setting.Quota(key.requester, key.category)
+		res = append(res, &kabuspb.Usage{
+			RequesterName:      key.requester,
+			Category:           key.category,
+			Calls:              usage.calls,
+			Rejections:         usage.rejections,
+			PerSecondLimit:     int32(perSecond),
+			PerMinuteLimit:     int32(perMinute),
+			CurrentSecondCalls: int32(usage.secondCalls),
+			CurrentMinuteCalls: int32(usage.minuteCalls),
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].RequesterName != res[j].RequesterName {
+			return res[i].RequesterName < res[j].RequesterName
+		}
+		return res[i].Category < res[j].Category
+	})
+	return res
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewQuotaService(t *testing.T) {
+	t.Parallel()
+	clock := &testClock{}
+	setting := &testSetting{}
+	got := NewQuotaService(clock, setting)
+	want := &quota{clock: clock, setting: setting, idle: time.Hour, usages: map[quotaKey]*quotaUsage{}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_quota_Use(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 10, 0, 30, 500, time.Local)
+	key := quotaKey{requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO}
+	tests := []struct {
+		name      string
+		perSecond int
+		perMinute int
+		usages    map[quotaKey]*quotaUsage
+		want      codes.Code
+		wantUsage *quotaUsage
+	}{
+		{name: "初めての呼出しなら集計を作って受け付ける",
+			perSecond: 1, perMinute: 1,
+			usages:    map[quotaKey]*quotaUsage{},
+			want:      codes.OK,
+			wantUsage: &quotaUsage{usedAt: now, calls: 1, second: now.Truncate(time.Second), secondCalls: 1, minute: now.Truncate(time.Minute), minuteCalls: 1}},
+		{name: "利用枠が無制限なら受け付ける",
+			usages:    map[quotaKey]*quotaUsage{key: {calls: 100, second: now.Truncate(time.Second), secondCalls: 50, minute: now.Truncate(time.Minute), minuteCalls: 100}},
+			want:      codes.OK,
+			wantUsage: &quotaUsage{usedAt: now, calls: 101, second: now.Truncate(time.Second), secondCalls: 51, minute: now.Truncate(time.Minute), minuteCalls: 101}},
+		{name: "秒間の利用枠を使い切っていたら拒否する",
+			perSecond: 2, perMinute: 10,
+			usages:    map[quotaKey]*quotaUsage{key: {calls: 2, second: now.Truncate(time.Second), secondCalls: 2, minute: now.Truncate(time.Minute), minuteCalls: 2}},
+			want:      codes.ResourceExhausted,
+			wantUsage: &quotaUsage{usedAt: now, calls: 2, rejections: 1, second: now.Truncate(time.Second), secondCalls: 2, minute: now.Truncate(time.Minute), minuteCalls: 2}},
+		{name: "分間の利用枠を使い切っていたら拒否する",
+			perSecond: 2, perMinute: 10,
+			usages:    map[quotaKey]*quotaUsage{key: {calls: 10, second: now.Truncate(time.Second), secondCalls: 0, minute: now.Truncate(time.Minute), minuteCalls: 10}},
+			want:      codes.ResourceExhausted,
+			wantUsage: &quotaUsage{usedAt: now, calls: 10, rejections: 1, second: now.Truncate(time.Second), secondCalls: 0, minute: now.Truncate(time.Minute), minuteCalls: 10}},
+		{name: "集計中の1秒間が過ぎていたら数え直して受け付ける",
+			perSecond: 2, perMinute: 10,
+			usages:    map[quotaKey]*quotaUsage{key: {calls: 2, second: now.Add(-time.Second).Truncate(time.Second), secondCalls: 2, minute: now.Truncate(time.Minute), minuteCalls: 2}},
+			want:      codes.OK,
+			wantUsage: &quotaUsage{usedAt: now, calls: 3, second: now.Truncate(time.Second), secondCalls: 1, minute: now.Truncate(time.Minute), minuteCalls: 3}},
+		{name: "集計中の1分間が過ぎていたら数え直して受け付ける",
+			perSecond: 2, perMinute: 10,
+			usages:    map[quotaKey]*quotaUsage{key: {calls: 10, second: now.Add(-time.Minute).Truncate(time.Second), secondCalls: 1, minute: now.Add(-time.Minute).Truncate(time.Minute), minuteCalls: 10}},
+			want:      codes.OK,
+			wantUsage: &quotaUsage{usedAt: now, calls: 11, second: now.Truncate(time.Second), secondCalls: 1, minute: now.Truncate(time.Minute), minuteCalls: 1}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &quota{clock: &testClock{now: now}, setting: &testSetting{perSecond: test.perSecond, perMinute: test.perMinute}, idle: time.Hour, sweptAt: now, usages: test.usages}
+			got := status.Code(service.Use(key.requester, key.category))
+			if !reflect.DeepEqual(test.want, got) || !reflect.DeepEqual(test.wantUsage, service.usages[key]) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantUsage, got, service.usages[key])
+			}
+		})
+	}
+}
+
+func Test_quota_Use_sweep(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 10, 0, 30, 0, time.Local)
+	active := quotaKey{requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO}
+	idle := quotaKey{requester: "old-tool", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO}
+	tests := []struct {
+		name     string
+		sweptAt  time.Time
+		wantIdle bool
+	}{
+		{name: "前に捨ててからidleが経っていなければ捨てない", sweptAt: now.Add(-time.Minute), wantIdle: true},
+		{name: "前に捨ててからidleが経っていればidleより長く使われていない集計を捨てる", sweptAt: now.Add(-time.Hour), wantIdle: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &quota{clock: &testClock{now: now}, setting: &testSetting{}, idle: time.Hour, sweptAt: test.sweptAt, usages: map[quotaKey]*quotaUsage{
+				active: {usedAt: now.Add(-time.Minute)},
+				idle:   {usedAt: now.Add(-time.Hour)},
+			}}
+			_ = service.Use("screener", kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER)
+			_, gotActive := service.usages[active]
+			_, gotIdle := service.usages[idle]
+			if !gotActive || test.wantIdle != gotIdle {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), true, test.wantIdle, gotActive, gotIdle)
+			}
+		})
+	}
+}
+
+func Test_quota_Usage(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 10, 0, 30, 500, time.Local)
+	usages := func() map[quotaKey]*quotaUsage {
+		return map[quotaKey]*quotaUsage{
+			{requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO}:   {calls: 10, rejections: 3, second: now.Truncate(time.Second), secondCalls: 2, minute: now.Truncate(time.Minute), minuteCalls: 10},
+			{requester: "bot", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO}:        {calls: 5, second: now.Add(-time.Second).Truncate(time.Second), secondCalls: 2, minute: now.Truncate(time.Minute), minuteCalls: 5},
+			{requester: "bot", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER}:       {calls: 1, second: now.Truncate(time.Second), secondCalls: 1, minute: now.Truncate(time.Minute), minuteCalls: 1},
+			{requester: "screener", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET}: {calls: 2, second: now.Truncate(time.Second), secondCalls: 1, minute: now.Add(-time.Minute).Truncate(time.Minute), minuteCalls: 2},
+		}
+	}
+	tests := []struct {
+		name      string
+		requester string
+		want      []*kabuspb.Usage
+	}{
+		{name: "集計がなければ空配列", requester: "foo", want: []*kabuspb.Usage{}},
+		{name: "ツール名が空なら全ツール分をツール名、区分の順に並べて返す", requester: "", want: []*kabuspb.Usage{
+			{RequesterName: "bot", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, Calls: 1, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 1, CurrentMinuteCalls: 1},
+			{RequesterName: "bot", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, Calls: 5, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 0, CurrentMinuteCalls: 5},
+			{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET, Calls: 2, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 1, CurrentMinuteCalls: 0},
+			{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, Calls: 10, Rejections: 3, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 2, CurrentMinuteCalls: 10},
+		}},
+		{name: "ツール名を指定したらそのツール分だけを返す", requester: "screener", want: []*kabuspb.Usage{
+			{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET, Calls: 2, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 1, CurrentMinuteCalls: 0},
+			{RequesterName: "screener", Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, Calls: 10, Rejections: 3, PerSecondLimit: 5, PerMinuteLimit: 100, CurrentSecondCalls: 2, CurrentMinuteCalls: 10},
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &quota{clock: &testClock{now: now}, setting: &testSetting{perSecond: 5, perMinute: 100}, usages: usages()}
+			got := service.Usage(test.requester)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewRiskService(
+	security repositories.Security,
+	tokenService TokenService,
+	throttleService ThrottleService,
+	clock repositories.Clock,
+	setting repositories.Setting) RiskService {
+	return &risk{
+		security:        security,
+		tokenService:    tokenService,
+		throttleService: throttleService,
+		clock:           clock,
+		setting:         setting,
+	}
+}
+
+// RiskService - 発注前のリスクチェック
+type RiskService interface {
+	Check(ctx context.Context, order *RiskOrder) error
+	Release(order *RiskOrder)
+}
+
+// RiskOrder - リスクチェックの対象の注文
+type RiskOrder struct {
+	Product    kabuspb.Product
+	SymbolCode string
+	Exchange   kabuspb.Exchange
+	Quantity   float64
+	Price      float64 // 指値、成行なら0
+
+	notional float64 // 日次の代金として予約した代金
+	date     string  // 日次の代金を予約した日
+}
+
+type risk struct {
+	security        repositories.Security
+	tokenService    TokenService
+	throttleService ThrottleService
+	clock           repositories.Clock
+	setting         repositories.Setting
+	date            string
+	dailyNotional   float64
+	mtx             sync.Mutex
+}
+
+// rejectRisk - リスクチェックで拒否したときのエラーを作る、FailedPreconditionの詳細に拒否の理由を入れる
+func rejectRisk(reason kabuspb.RiskRejectionReason, limit float64, actual float64, message string) error {
+	st := status.New(codes.FailedPrecondition, message)
+	dt, err := st.WithDetails(&kabuspb.RiskRejection{Reason: reason, Message: message, Limit: limit, Actual: actual})
+	if err != nil {
+		return st.Err()
+	}
+	return dt.Err()
+}
+
+// Check - 注文がリスクの上限に収まっているかを確認する、収まっていれば日次の代金を予約する、収まっていなければFailedPreconditionを返す
+func (s *risk) Check(ctx context.Context, order *RiskOrder) error {
+	limit := s.setting.RiskLimit()
+
+	if len(limit.AllowedProducts) > 0 && !containsProduct(limit.AllowedProducts, order.Product) {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRODUCT_NOT_ALLOWED, 0, 0, fmt.Sprintf("product %s is not allowed", order.Product))
+	}
+	if len(limit.AllowedSymbols) > 0 && !containsSymbol(limit.AllowedSymbols, order.SymbolCode) {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_SYMBOL_NOT_ALLOWED, 0, 0, fmt.Sprintf("symbol %s is not allowed", order.SymbolCode))
+	}
+	if limit.MaxQuantity > 0 && order.Quantity > limit.MaxQuantity {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_QUANTITY, limit.MaxQuantity, order.Quantity,
+			fmt.Sprintf("quantity %v exceeds the limit %v", order.Quantity, limit.MaxQuantity))
+	}
+
+	// 成行の代金と、指値の現値からの乖離には板情報の価格を使う
+	needNotional := limit.MaxNotional > 0 || limit.MaxDailyNotional > 0
+	var boardPrice float64
+	if (order.Price == 0 && needNotional) || (order.Price > 0 && limit.PriceBandPercent > 0) {
+		price, err := s.boardPrice(ctx, order)
+		if err != nil {
+			return err
+		}
+		boardPrice = price
+	}
+
+	if order.Price > 0 && limit.PriceBandPercent > 0 && boardPrice > 0 {
+		if diff := math.Abs(order.Price-boardPrice) / boardPrice * 100; diff > limit.PriceBandPercent {
+			return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_BAND, limit.PriceBandPercent, diff,
+				fmt.Sprintf("price %v is %.2f%% away from the current price %v", order.Price, diff, boardPrice))
+		}
+	}
+
+	// 値幅制限と、先物・オプションの代金の取引単位には銘柄情報を使う
+	needMultiplier := needNotional && (order.Product == kabuspb.Product_PRODUCT_FUTURE || order.Product == kabuspb.Product_PRODUCT_OPTION)
+	var symbol *kabuspb.Symbol
+	if (order.Price > 0 && limit.PriceLimitCheck) || needMultiplier {
+		sym, err := s.symbol(ctx, order)
+		if err != nil {
+			return err
+		}
+		symbol = sym
+	}
+
+	if order.Price > 0 && limit.PriceLimitCheck {
+		if err := checkPriceLimit(order, symbol); err != nil {
+			return err
+		}
+	}
+
+	price := order.Price
+	if price == 0 {
+		price = boardPrice
+	}
+	notional := order.Quantity * price
+	if needNotional && price == 0 {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_UNKNOWN, 0, 0, fmt.Sprintf("price of %s is unknown", order.SymbolCode))
+	}
+	if needMultiplier {
+		// 先物・オプションの価格は指数などの値なので、取引単位(乗数)を掛けて代金にする
+		if symbol.GetTradingUnit() <= 0 {
+			return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_UNKNOWN, 0, 0, fmt.Sprintf("trading unit of %s is unknown", order.SymbolCode))
+		}
+		notional *= symbol.GetTradingUnit()
+	}
+	if limit.MaxNotional > 0 && notional > limit.MaxNotional {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_NOTIONAL, limit.MaxNotional, notional,
+			fmt.Sprintf("notional %v exceeds the limit %v", notional, limit.MaxNotional))
+	}
+
+	if limit.MaxOpenOrdersPerSymbol > 0 {
+		if err := s.checkOpenOrders(ctx, order, limit.MaxOpenOrdersPerSymbol); err != nil {
+			return err
+		}
+	}
+
+	if limit.MaxDailyNotional > 0 {
+		return s.reserve(order, notional, limit.MaxDailyNotional)
+	}
+	return nil
+}
+
+// Release - 発注できなかった注文の日次の代金の予約を戻す
+func (s *risk) Release(order *RiskOrder) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if order.notional == 0 || order.date != s.date {
+		return
+	}
+	s.dailyNotional -= order.notional
+	order.notional = 0
+}
+
+// reserve - 日次の代金の合計が上限に収まるなら、注文の代金を予約する
+func (s *risk) reserve(order *RiskOrder, notional float64, maxDailyNotional float64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if date := s.clock.Now().Format("2006-01-02"); s.date != date {
+		s.date = date
+		s.dailyNotional = 0
+	}
+	if total := s.dailyNotional + notional; total > maxDailyNotional {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL, maxDailyNotional, total,
+			fmt.Sprintf("daily notional %v exceeds the limit %v", total, maxDailyNotional))
+	}
+	s.dailyNotional += notional
+	order.notional = notional
+	order.date = s.date
+	return nil
+}
+
+// boardPrice - 板情報から注文の基準にする価格を返す、現値がなければ前日終値を返す
+func (s *risk) boardPrice(ctx context.Context, order *RiskOrder) (float64, error) {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH); err != nil {
+		return 0, status.FromContextError(err).Err()
+	}
+
+	var board *kabuspb.Board
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		board, err = s.security.Board(ctx, token, &kabuspb.GetBoardRequest{SymbolCode: order.SymbolCode, Exchange: order.Exchange})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	if board.CurrentPrice > 0 {
+		return board.CurrentPrice, nil
+	}
+	return board.PreviousClose, nil
+}
+
+// symbol - 注文の銘柄情報を返す
+func (s *risk) symbol(ctx context.Context, order *RiskOrder) (*kabuspb.Symbol, error) {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	var symbol *kabuspb.Symbol
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		symbol, err = s.security.Symbol(ctx, token, &kabuspb.GetSymbolRequest{SymbolCode: order.SymbolCode, Exchange: order.Exchange})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return symbol, nil
+}
+
+// checkPriceLimit - 指値が銘柄情報の値幅制限の中にあるかを確認する
+func checkPriceLimit(order *RiskOrder, symbol *kabuspb.Symbol) error {
+	if symbol.UpperLimit > 0 && order.Price > symbol.UpperLimit {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_LIMIT, symbol.UpperLimit, order.Price,
+			fmt.Sprintf("price %v is above the upper limit %v", order.Price, symbol.UpperLimit))
+	}
+	if symbol.LowerLimit > 0 && order.Price < symbol.LowerLimit {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_LIMIT, symbol.LowerLimit, order.Price,
+			fmt.Sprintf("price %v is below the lower limit %v", order.Price, symbol.LowerLimit))
+	}
+	return nil
+}
+
+// checkOpenOrders - 銘柄の未約定の注文数が上限に達していないかを確認する
+func (s *risk) checkOpenOrders(ctx context.Context, order *RiskOrder, maxOpenOrders int) error {
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	var orders *kabuspb.Orders
+	err := s.tokenService.Do(ctx, func(token string) (err error) {
+		orders, err = s.security.Orders(ctx, token, &kabuspb.GetOrdersRequest{Product: kabuspb.Product_PRODUCT_ALL, SymbolCode: order.SymbolCode})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var open int
+	for _, o := range orders.Orders {
+		if o.OrderState != kabuspb.OrderState_ORDER_STATE_DONE {
+			open++
+		}
+	}
+	if open >= maxOpenOrders {
+		return rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_OPEN_ORDERS, float64(maxOpenOrders), float64(open),
+			fmt.Sprintf("symbol %s already has %d open orders", order.SymbolCode, open))
+	}
+	return nil
+}
+
+func containsProduct(products []kabuspb.Product, product kabuspb.Product) bool {
+	for _, p := range products {
+		if p == product {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSymbol(symbols []string, symbol string) bool {
+	for _, s := range symbols {
+		if s == symbol {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func Test_NewRiskService(t *testing.T) {
+	security := &testSecurity{}
+	tokenService := &testTokenService{}
+	throttleService := &testThrottleService{}
+	clock := &testClock{}
+	setting := &testSetting{}
+	got := NewRiskService(security, tokenService, throttleService, clock, setting)
+	want := &risk{security: security, tokenService: tokenService, throttleService: throttleService, clock: clock, setting: setting}
+
+	t.Parallel()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+// riskRejectionReason - リスクチェックのエラーから拒否の理由を取り出す
+func riskRejectionReason(err error) kabuspb.RiskRejectionReason {
+	st, _ := status.FromError(err)
+	for _, d := range st.Details() {
+		if r, ok := d.(*kabuspb.RiskRejection); ok {
+			return r.Reason
+		}
+	}
+	return kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_UNSPECIFIED
+}
+
+func Test_risk_Check(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		limit        repositories.RiskLimit
+		security     *testSecurity
+		tokenService *testTokenService
+		order        *RiskOrder
+		wantCode     codes.Code
+		wantReason   kabuspb.RiskRejectionReason
+		wantNotional float64
+	}{
+		{name: "上限がなければ通す",
+			order:    &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 1000},
+			wantCode: codes.OK},
+		{name: "許可されていない商品なら拒否する",
+			limit:      repositories.RiskLimit{AllowedProducts: []kabuspb.Product{kabuspb.Product_PRODUCT_STOCK}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_MARGIN, SymbolCode: "1320", Quantity: 1},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRODUCT_NOT_ALLOWED},
+		{name: "許可されていない銘柄なら拒否する",
+			limit:      repositories.RiskLimit{AllowedSymbols: []string{"1321"}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 1},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_SYMBOL_NOT_ALLOWED},
+		{name: "数量が上限を超えたら拒否する",
+			limit:      repositories.RiskLimit{MaxQuantity: 100},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 101},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_QUANTITY},
+		{name: "指値の代金が上限を超えたら拒否する",
+			limit:      repositories.RiskLimit{MaxNotional: 100000},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1001},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_NOTIONAL},
+		{name: "成行の代金は現値で計算する",
+			limit:      repositories.RiskLimit{MaxNotional: 100000},
+			security:   &testSecurity{board: &kabuspb.Board{CurrentPrice: 1001, PreviousClose: 900}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_NOTIONAL},
+		{name: "現値がなければ前日終値で計算する",
+			limit:    repositories.RiskLimit{MaxNotional: 100000},
+			security: &testSecurity{board: &kabuspb.Board{PreviousClose: 900}},
+			order:    &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100},
+			wantCode: codes.OK},
+		{name: "成行で価格がわからなければ拒否する",
+			limit:      repositories.RiskLimit{MaxNotional: 100000},
+			security:   &testSecurity{board: &kabuspb.Board{}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_UNKNOWN},
+		{name: "板情報の取得でエラーがあればエラーを返す",
+			limit:        repositories.RiskLimit{MaxNotional: 100000},
+			tokenService: &testTokenService{getToken: status.Error(codes.Unavailable, "unavailable")},
+			order:        &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100},
+			wantCode:     codes.Unavailable},
+		{name: "指値が現値から離れすぎていたら拒否する",
+			limit:      repositories.RiskLimit{PriceBandPercent: 5},
+			security:   &testSecurity{board: &kabuspb.Board{CurrentPrice: 1000}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1051},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_BAND},
+		{name: "指値が現値からの乖離の範囲内なら通す",
+			limit:    repositories.RiskLimit{PriceBandPercent: 5},
+			security: &testSecurity{board: &kabuspb.Board{CurrentPrice: 1000}},
+			order:    &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 950},
+			wantCode: codes.OK},
+		{name: "指値が値幅制限の上限を超えていたら拒否する",
+			limit:      repositories.RiskLimit{PriceLimitCheck: true},
+			security:   &testSecurity{symbol: &kabuspb.Symbol{UpperLimit: 1300, LowerLimit: 700}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1301},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_LIMIT},
+		{name: "指値が値幅制限の下限を下回っていたら拒否する",
+			limit:      repositories.RiskLimit{PriceLimitCheck: true},
+			security:   &testSecurity{symbol: &kabuspb.Symbol{UpperLimit: 1300, LowerLimit: 700}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 699},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_LIMIT},
+		{name: "未約定の注文数が上限に達していたら拒否する",
+			limit: repositories.RiskLimit{MaxOpenOrdersPerSymbol: 2},
+			security: &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{
+				{OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED},
+				{OrderState: kabuspb.OrderState_ORDER_STATE_DONE},
+				{OrderState: kabuspb.OrderState_ORDER_STATE_WAIT},
+			}}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_OPEN_ORDERS},
+		{name: "未約定の注文数が上限未満なら通す",
+			limit: repositories.RiskLimit{MaxOpenOrdersPerSymbol: 2},
+			security: &testSecurity{orders: &kabuspb.Orders{Orders: []*kabuspb.Order{
+				{OrderState: kabuspb.OrderState_ORDER_STATE_PROCESSED},
+				{OrderState: kabuspb.OrderState_ORDER_STATE_DONE},
+			}}},
+			order:    &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000},
+			wantCode: codes.OK},
+		{name: "先物の代金は取引単位を掛けて計算する",
+			limit:      repositories.RiskLimit{MaxNotional: 1000000},
+			security:   &testSecurity{symbol: &kabuspb.Symbol{TradingUnit: 100}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_FUTURE, SymbolCode: "167060019", Quantity: 1, Price: 30000},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_NOTIONAL},
+		{name: "オプションの日次の代金は取引単位を掛けて予約する",
+			limit:        repositories.RiskLimit{MaxDailyNotional: 1000000},
+			security:     &testSecurity{symbol: &kabuspb.Symbol{TradingUnit: 1000}},
+			order:        &RiskOrder{Product: kabuspb.Product_PRODUCT_OPTION, SymbolCode: "137092818", Quantity: 2, Price: 100},
+			wantCode:     codes.OK,
+			wantNotional: 200000},
+		{name: "先物・オプションで取引単位がわからなければ拒否する",
+			limit:      repositories.RiskLimit{MaxNotional: 1000000},
+			security:   &testSecurity{symbol: &kabuspb.Symbol{}},
+			order:      &RiskOrder{Product: kabuspb.Product_PRODUCT_FUTURE, SymbolCode: "167060019", Quantity: 1, Price: 30000},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_PRICE_UNKNOWN},
+		{name: "日次の代金の上限に収まれば予約する",
+			limit:        repositories.RiskLimit{MaxDailyNotional: 100000},
+			order:        &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000},
+			wantCode:     codes.OK,
+			wantNotional: 100000},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			security := test.security
+			if security == nil {
+				security = &testSecurity{}
+			}
+			tokenService := test.tokenService
+			if tokenService == nil {
+				tokenService = &testTokenService{}
+			}
+			service := &risk{
+				security:        security,
+				tokenService:    tokenService,
+				throttleService: &testThrottleService{},
+				clock:           &testClock{now: time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)},
+				setting:         &testSetting{riskLimit: test.limit},
+			}
+			got := service.Check(context.Background(), test.order)
+			if status.Code(got) != test.wantCode || riskRejectionReason(got) != test.wantReason || service.dailyNotional != test.wantNotional {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
+					test.wantCode, test.wantReason, test.wantNotional, got, riskRejectionReason(got), service.dailyNotional)
+			}
+		})
+	}
+}
+
+func Test_risk_Check_throttle(t *testing.T) {
+	t.Parallel()
+	service := &risk{
+		security:        &testSecurity{},
+		tokenService:    &testTokenService{},
+		throttleService: &testThrottleService{wait: context.DeadlineExceeded},
+		clock:           &testClock{now: time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)},
+		setting:         &testSetting{riskLimit: repositories.RiskLimit{PriceLimitCheck: true}},
+	}
+	got := service.Check(context.Background(), &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000})
+	if status.Code(got) != codes.DeadlineExceeded {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), codes.DeadlineExceeded, got)
+	}
+}
+
+func Test_risk_dailyNotional(t *testing.T) {
+	t.Parallel()
+	clock := &testClock{now: time.Date(2021, 9, 13, 9, 0, 0, 0, time.Local)}
+	service := &risk{
+		security:        &testSecurity{},
+		tokenService:    &testTokenService{},
+		throttleService: &testThrottleService{},
+		clock:           clock,
+		setting:         &testSetting{riskLimit: repositories.RiskLimit{MaxDailyNotional: 150000}},
+	}
+
+	order1 := &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000}
+	if err := service.Check(context.Background(), order1); err != nil {
+		t.Fatalf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+
+	// 予約済みの代金と合わせて上限を超えるので拒否する
+	order2 := &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 100, Price: 1000}
+	if got := service.Check(context.Background(), order2); riskRejectionReason(got) != kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_DAILY_NOTIONAL, got)
+	}
+
+	// 予約を戻せば通る
+	service.Release(order1)
+	service.Release(order1) // 2回戻しても二重には戻さない
+	if err := service.Check(context.Background(), order2); err != nil || service.dailyNotional != 100000 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), nil, 100000, err, service.dailyNotional)
+	}
+
+	// 日付が変われば数え直し、前日の予約は戻さない
+	clock.now = time.Date(2021, 9, 14, 9, 0, 0, 0, time.Local)
+	order3 := &RiskOrder{Product: kabuspb.Product_PRODUCT_STOCK, SymbolCode: "1320", Quantity: 150, Price: 1000}
+	if err := service.Check(context.Background(), order3); err != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), nil, err)
+	}
+	service.Release(order2)
+	if service.dailyNotional != 150000 {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), 150000, service.dailyNotional)
+	}
+}
+
+func Test_rejectRisk(t *testing.T) {
+	t.Parallel()
+	got := rejectRisk(kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_QUANTITY, 100, 101, "quantity 101 exceeds the limit 100")
+	st, _ := status.FromError(got)
+	want := &kabuspb.RiskRejection{Reason: kabuspb.RiskRejectionReason_RISK_REJECTION_REASON_MAX_QUANTITY, Message: "quantity 101 exceeds the limit 100", Limit: 100, Actual: 101}
+	if st.Code() != codes.FailedPrecondition || len(st.Details()) != 1 || !proto.Equal(want, st.Details()[0].(*kabuspb.RiskRejection)) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, st.Details())
+	}
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewStationService(
+	tokenService TokenService,
+	security repositories.Security,
+	throttleService ThrottleService,
+	clock repositories.Clock) StationService {
+	return &station{
+		tokenService:    tokenService,
+		security:        security,
+		throttleService: throttleService,
+		clock:           clock,
+		interval:        10 * time.Second,
+		timeout:         5 * time.Second,
+		available:       true,
+	}
+}
+
+// StationService - バックグラウンドでトークンを先行取得しながらkabuステーションの死活を監視する
+type StationService interface {
+	Start()
+	Check() error
+	Status() *kabuspb.StationStatus
+}
+
+type station struct {
+	tokenService    TokenService
+	security        repositories.Security
+	throttleService ThrottleService
+	clock           repositories.Clock
+	interval        time.Duration // 確認する間隔
+	timeout         time.Duration // 1回の確認にかける時間の上限
+	started         bool
+	available       bool
+	checkedAt       time.Time
+	lastError       string
+	mtx             sync.Mutex
+}
+
+// Start - 定期的な確認を始める、既に始まっていたら何もしない
+func (s *station) Start() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+
+	go func() {
+		for {
+			s.probe()
+			<-time.After(s.nextProbe())
+		}
+	}()
+}
+
+// nextProbe - 次の確認までの時間を返す、間隔の間にトークンが切れるなら切れた時点ですぐに取り直せるように切れるまでの時間を返す
+func (s *station) nextProbe() time.Duration {
+	if until := s.tokenService.GetExpiredAt().Sub(s.clock.Now()); until >= 0 && until < s.interval {
+		return until
+	}
+	return s.interval
+}
+
+// Check - 最後の確認でkabuステーションが利用できなかったらUnavailableのエラーを返す
+func (s *station) Check() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if !s.available {
+		return status.Errorf(codes.Unavailable, "kabu station is unavailable: %s", s.lastError)
+	}
+	return nil
+}
+
+func (s *station) Status() *kabuspb.StationStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &kabuspb.StationStatus{
+		Available:      s.available,
+		CheckedAt:      timestamppb.New(s.checkedAt),
+		LastError:      s.lastError,
+		TokenExpiredAt: timestamppb.New(s.tokenService.GetExpiredAt()),
+	}
+}
+
+// probe - トークンを取得してソフトリミットを取得できるかで死活を確認する、トークンが切れていればここで取り直される
+func (s *station) probe() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	// 順番待ちやトークン取得の待ちで時間切れになったらkabuステーションに問い合わせていないので、確認しなかったことにして状態を変えない
+	if err := s.throttleService.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_LOW); err != nil {
+		return
+	}
+
+	var called bool
+	err := s.tokenService.Do(ctx, func(token string) error {
+		called = true
+		_, err := s.security.SoftLimit(ctx, token, &kabuspb.GetSoftLimitRequest{})
+		return err
+	})
+	if !called && isContextError(ctx, err) {
+		return
+	}
+
+	// ソフトリミットの取得でkabusapiのエラーが返ってこないならkabuステーションに繋がっていない、再起動されるとトークンが無効になるので取り直させる
+	// 時間切れは繋がっていないとは限らないので、トークンは無効にしない
+	if called && err != nil && s.security.ErrorCode(err) == 0 && !isContextError(ctx, err) {
+		s.tokenService.Invalidate()
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.checkedAt = s.clock.Now()
+	s.available = err == nil
+	s.lastError = ""
+	if err != nil {
+		s.lastError = err.Error()
+	}
+}
+
+// isContextError - ctxの終了によるエラーか
+func isContextError(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
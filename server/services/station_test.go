@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewStationService(t *testing.T) {
+	t.Parallel()
+	tokenService := &testTokenService{}
+	security := &testSecurity{}
+	throttleService := &testThrottleService{}
+	clock := &testClock{}
+	got := NewStationService(tokenService, security, throttleService, clock)
+	want := &station{
+		tokenService:    tokenService,
+		security:        security,
+		throttleService: throttleService,
+		clock:           clock,
+		interval:        10 * time.Second,
+		timeout:         5 * time.Second,
+		available:       true,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_station_probe(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 6, 31, 0, 0, time.Local)
+	tests := []struct {
+		name          string
+		wait          error
+		getToken      error
+		softLimit     error
+		errorCode     int
+		available     bool
+		wantAvailable bool
+		wantLastError string
+		wantInvalid   int
+		notProbed     bool
+	}{
+		{name: "順番待ちでエラーがあれば確認しないで状態を変えない",
+			wait: context.DeadlineExceeded, available: true,
+			wantAvailable: true, wantLastError: "before error message", notProbed: true},
+		{name: "トークン取得の待ちで時間切れになったら確認しないで状態を変えない",
+			getToken: context.DeadlineExceeded, available: false,
+			wantAvailable: false, wantLastError: "before error message", notProbed: true},
+		{name: "トークンが取れなければ利用不可にする",
+			getToken: errors.New("token error message"), errorCode: 4001007, available: true,
+			wantAvailable: false, wantLastError: "token error message"},
+		{name: "ソフトリミットが取れなければ利用不可にする",
+			softLimit: errors.New("soft limit error message"), errorCode: 4001007, available: true,
+			wantAvailable: false, wantLastError: "soft limit error message"},
+		{name: "kabuステーションに繋がらなければトークンを無効にする",
+			softLimit: errors.New("connection refused"), errorCode: 0, available: true,
+			wantAvailable: false, wantLastError: "connection refused", wantInvalid: 1},
+		{name: "ソフトリミットの取得が時間切れならトークンを無効にしない",
+			softLimit: context.DeadlineExceeded, errorCode: 0, available: true,
+			wantAvailable: false, wantLastError: context.DeadlineExceeded.Error()},
+		{name: "トークンが取れなくてもソフトリミットを取得していなければトークンを無効にしない",
+			getToken: errors.New("connection refused"), errorCode: 0, available: true,
+			wantAvailable: false, wantLastError: "connection refused"},
+		{name: "ソフトリミットが取れたら利用可能に戻す",
+			available: false, wantAvailable: true, wantLastError: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			tokenService := &testTokenService{getToken: test.getToken}
+			service := &station{
+				tokenService:    tokenService,
+				security:        &testSecurity{softLimit: test.softLimit, errorCode: test.errorCode},
+				throttleService: &testThrottleService{wait: test.wait},
+				clock:           &testClock{now: now},
+				timeout:         time.Second,
+				available:       test.available,
+				lastError:       "before error message",
+			}
+			service.probe()
+			wantCheckedAt := now
+			if test.notProbed {
+				wantCheckedAt = time.Time{}
+			}
+			if !reflect.DeepEqual(test.wantAvailable, service.available) ||
+				!reflect.DeepEqual(test.wantLastError, service.lastError) ||
+				!reflect.DeepEqual(wantCheckedAt, service.checkedAt) ||
+				!reflect.DeepEqual(test.wantInvalid, tokenService.invalidateCount) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.wantAvailable, test.wantLastError, wantCheckedAt, test.wantInvalid,
+					service.available, service.lastError, service.checkedAt, tokenService.invalidateCount)
+			}
+		})
+	}
+}
+
+func Test_station_nextProbe(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 6, 29, 55, 0, time.Local)
+	tests := []struct {
+		name      string
+		expiredAt time.Time
+		want      time.Duration
+	}{
+		{name: "間隔の間にトークンが切れなければ間隔を返す", expiredAt: time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local), want: 10 * time.Second},
+		{name: "間隔の間にトークンが切れるなら切れるまでの時間を返す", expiredAt: time.Date(2021, 9, 10, 6, 30, 0, 0, time.Local), want: 5 * time.Second},
+		{name: "トークンが切れていれば間隔を返す", expiredAt: time.Date(2021, 9, 10, 6, 29, 0, 0, time.Local), want: 10 * time.Second},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &station{
+				tokenService: &testTokenService{getExpiredAt: test.expiredAt},
+				clock:        &testClock{now: now},
+				interval:     10 * time.Second,
+			}
+			got := service.nextProbe()
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_station_Check(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		available bool
+		lastError string
+		want      codes.Code
+	}{
+		{name: "利用可能ならnil", available: true, want: codes.OK},
+		{name: "利用不可ならUnavailable", available: false, lastError: "token error message", want: codes.Unavailable},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &station{available: test.available, lastError: test.lastError}
+			got := status.Code(service.Check())
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_station_Status(t *testing.T) {
+	t.Parallel()
+	checkedAt := time.Date(2021, 9, 10, 6, 31, 0, 0, time.Local)
+	expiredAt := time.Date(2021, 9, 11, 6, 30, 0, 0, time.Local)
+	service := &station{
+		tokenService: &testTokenService{getExpiredAt: expiredAt},
+		available:    false,
+		checkedAt:    checkedAt,
+		lastError:    "token error message",
+	}
+	want := &kabuspb.StationStatus{
+		Available:      false,
+		CheckedAt:      timestamppb.New(checkedAt),
+		LastError:      "token error message",
+		TokenExpiredAt: timestamppb.New(expiredAt),
+	}
+	got := service.Status()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_station_Start(t *testing.T) {
+	t.Parallel()
+	service := &station{
+		tokenService:    &testTokenService{getToken: errors.New("token error message")},
+		security:        &testSecurity{},
+		throttleService: &testThrottleService{},
+		clock:           &testClock{},
+		interval:        time.Hour,
+		timeout:         time.Second,
+		available:       true,
+	}
+	service.Start()
+	service.Start() // 2回目は何もしない
+	time.Sleep(50 * time.Millisecond)
+
+	if service.Check() == nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), "unavailable", service.Status())
+	}
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
+)
+
+func NewThrottleService(setting repositories.Setting) ThrottleService {
+	categories := []kabuspb.ThrottleCategory{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO,
+	}
+
+	lanes := make(map[kabuspb.ThrottleCategory]*throttleLane, len(categories))
+	for _, category := range categories {
+		lanes[category] = &throttleLane{interval: setting.ThrottleInterval(category), burst: 1, aging: time.Second}
+	}
+	return &throttle{lanes: lanes}
+}
+
+// ThrottleService - kabusapiの流量制限に合わせてリクエストを優先度順に通すスケジューラ
+type ThrottleService interface {
+	Wait(ctx context.Context, category kabuspb.ThrottleCategory, priority kabuspb.RequestPriority) error
+	QueueDepth(category kabuspb.ThrottleCategory) int
+	Status() *kabuspb.ThrottleStatus
+}
+
+type throttle struct {
+	lanes map[kabuspb.ThrottleCategory]*throttleLane
+}
+
+// Wait - 区分のトークンが取れるまで待つ、待っている間にctxが終了したら待ち行列から抜けてctxのエラーを返す
+func (s *throttle) Wait(ctx context.Context, category kabuspb.ThrottleCategory, priority kabuspb.RequestPriority) error {
+	lane, ok := s.lanes[category]
+	if !ok {
+		return fmt.Errorf("unknown throttle category: %s", category)
+	}
+	return lane.wait(ctx, priorityLevel(priority))
+}
+
+// priorityLevel - 優先度を待ち行列での比較に使う値にする、未指定は通常と同じ
+func priorityLevel(priority kabuspb.RequestPriority) int {
+	switch priority {
+	case kabuspb.RequestPriority_REQUEST_PRIORITY_LOW:
+		return 0
+	case kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func (s *throttle) QueueDepth(category kabuspb.ThrottleCategory) int {
+	lane, ok := s.lanes[category]
+	if !ok {
+		return 0
+	}
+	return lane.queueDepth()
+}
+
+func (s *throttle) Status() *kabuspb.ThrottleStatus {
+	categories := []kabuspb.ThrottleCategory{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO,
+	}
+
+	res := &kabuspb.ThrottleStatus{Lanes: make([]*kabuspb.ThrottleLaneStatus, 0, len(categories))}
+	for _, category := range categories {
+		lane, ok := s.lanes[category]
+		if !ok {
+			continue
+		}
+		res.Lanes = append(res.Lanes, &kabuspb.ThrottleLaneStatus{
+			Category:   category,
+			QueueDepth: int32(lane.queueDepth()),
+			IntervalMs: lane.interval.Milliseconds(),
+		})
+	}
+	return res
+}
+
+// throttleLane - 区分ごとのトークンバケットと待ち行列
+type throttleLane struct {
+	interval time.Duration // トークンが1つ補充されるまでの間隔
+	burst    int           // バケットに貯められるトークンの上限
+	aging    time.Duration // 待ち時間がこの長さを超えるごとに優先度を1つ上げる
+	tokens   int
+	filledAt time.Time
+	queue    []*throttleWaiter
+	timer    *time.Timer
+	mtx      sync.Mutex
+}
+
+// throttleWaiter - 待ち行列に積まれたリクエスト
+type throttleWaiter struct {
+	ready      chan struct{}
+	level      int
+	enqueuedAt time.Time
+}
+
+func (l *throttleLane) wait(ctx context.Context, level int) error {
+	l.mtx.Lock()
+	if err := ctx.Err(); err != nil {
+		l.mtx.Unlock()
+		return err
+	}
+	waiter := &throttleWaiter{ready: make(chan struct{}), level: level, enqueuedAt: time.Now()}
+	l.queue = append(l.queue, waiter)
+	l.dispatch()
+	l.mtx.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+		l.mtx.Lock()
+		defer l.mtx.Unlock()
+
+		select {
+		case <-waiter.ready: // キャンセルと同時に順番が来ていたらトークンを返す
+			if l.tokens < l.burst {
+				l.tokens++
+			}
+		default:
+			l.remove(waiter)
+		}
+		l.dispatch()
+		return ctx.Err()
+	}
+}
+
+func (l *throttleLane) queueDepth() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return len(l.queue)
+}
+
+// refill - 経過時間分のトークンをバケットに補充する
+func (l *throttleLane) refill(now time.Time) {
+	if l.filledAt.IsZero() {
+		l.tokens = l.burst
+		l.filledAt = now
+		return
+	}
+
+	n := int(now.Sub(l.filledAt) / l.interval)
+	if n <= 0 {
+		return
+	}
+	l.tokens += n
+	if l.tokens >= l.burst {
+		l.tokens = l.burst
+		l.filledAt = now
+	} else {
+		l.filledAt = l.filledAt.Add(time.Duration(n) * l.interval)
+	}
+}
+
+// dispatch - トークンがある限り優先度の高いものから通し、残りがあれば次のトークンの補充時に再実行する (要ロック)
+func (l *throttleLane) dispatch() {
+	now := time.Now()
+	l.refill(now)
+	for l.tokens > 0 && len(l.queue) > 0 {
+		waiter := l.next(now)
+		close(waiter.ready)
+		l.remove(waiter)
+		l.tokens--
+	}
+
+	if len(l.queue) > 0 && l.timer == nil {
+		l.timer = time.AfterFunc(l.filledAt.Add(l.interval).Sub(now), func() {
+			l.mtx.Lock()
+			defer l.mtx.Unlock()
+			l.timer = nil
+			l.dispatch()
+		})
+	}
+}
+
+// next - 待ち時間による底上げを加えた優先度が最も高いものを返す、同じ優先度なら先に積まれたものを返す (要ロック)
+func (l *throttleLane) next(now time.Time) *throttleWaiter {
+	var res *throttleWaiter
+	resLevel := 0
+	for _, w := range l.queue {
+		level := w.level
+		if l.aging > 0 {
+			level += int(now.Sub(w.enqueuedAt) / l.aging)
+		}
+		if res == nil || level > resLevel {
+			res = w
+			resLevel = level
+		}
+	}
+	return res
+}
+
+// remove - 待ち行列から抜ける (要ロック)
+func (l *throttleLane) remove(waiter *throttleWaiter) {
+	for i, q := range l.queue {
+		if q == waiter {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"gitlab.com/tsuchinaga/kabus-grpc-server/kabuspb"
+)
+
+func Test_NewThrottleService(t *testing.T) {
+	t.Parallel()
+	got := NewThrottleService(&testSetting{intervals: map[kabuspb.ThrottleCategory]time.Duration{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER:  200 * time.Millisecond,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: 100 * time.Millisecond,
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:   100 * time.Millisecond,
+	}})
+	want := &throttle{
+		lanes: map[kabuspb.ThrottleCategory]*throttleLane{
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER:  {interval: 200 * time.Millisecond, burst: 1, aging: time.Second},
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: {interval: 100 * time.Millisecond, burst: 1, aging: time.Second},
+			kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:   {interval: 100 * time.Millisecond, burst: 1, aging: time.Second},
+		},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_throttle_Wait(t *testing.T) {
+	t.Parallel()
+	// 実行環境の負荷で遅れることはあっても早まることはないので、経過時間は下限だけを確かめる
+	tests := []struct {
+		name     string
+		category kabuspb.ThrottleCategory
+		interval time.Duration
+		count    int
+		timeout  time.Duration
+		want     time.Duration
+		hasError bool
+	}{
+		{name: "未知の区分ならエラーを返す", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_UNSPECIFIED, interval: 20 * time.Millisecond, count: 1, timeout: time.Second, hasError: true},
+		{name: "1件目は待たずに通る", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, interval: time.Hour, count: 1, timeout: time.Second, want: 0},
+		{name: "連続したリクエストは間隔をあけて通る", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, interval: 20 * time.Millisecond, count: 3, timeout: time.Second, want: 40 * time.Millisecond},
+		{name: "順番が来る前にctxが終了したらエラーを返す", category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, interval: time.Hour, count: 2, timeout: 30 * time.Millisecond, want: 30 * time.Millisecond, hasError: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &throttle{lanes: map[kabuspb.ThrottleCategory]*throttleLane{
+				kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER: {interval: test.interval, burst: 1},
+			}}
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+			defer cancel()
+
+			start := time.Now()
+			var err error
+			for i := 0; i < test.count; i++ {
+				if err = service.Wait(ctx, test.category, kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED); err != nil {
+					break
+				}
+			}
+			got := time.Since(start)
+			if (err != nil) != test.hasError || got < test.want {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.hasError, got, err)
+			}
+		})
+	}
+}
+
+func Test_throttle_Wait_fifo(t *testing.T) {
+	t.Parallel()
+	service := &throttle{lanes: map[kabuspb.ThrottleCategory]*throttleLane{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO: {interval: 10 * time.Millisecond, burst: 1},
+	}}
+
+	var mtx sync.Mutex
+	got := make([]int, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = service.Wait(context.Background(), kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED)
+			mtx.Lock()
+			defer mtx.Unlock()
+			got = append(got, i)
+		}(i)
+		time.Sleep(time.Millisecond) // 積まれる順番を固定する
+	}
+	wg.Wait()
+
+	want := []int{0, 1, 2, 3, 4}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_throttle_Wait_priority(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		priorities []kabuspb.RequestPriority
+		want       []int
+	}{
+		{name: "優先度の高いものから通る",
+			priorities: []kabuspb.RequestPriority{
+				kabuspb.RequestPriority_REQUEST_PRIORITY_LOW,
+				kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED,
+				kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH,
+				kabuspb.RequestPriority_REQUEST_PRIORITY_NORMAL,
+				kabuspb.RequestPriority_REQUEST_PRIORITY_HIGH,
+			},
+			want: []int{2, 4, 1, 3, 0}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lane := &throttleLane{interval: 20 * time.Millisecond, burst: 1}
+			service := &throttle{lanes: map[kabuspb.ThrottleCategory]*throttleLane{kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO: lane}}
+
+			// 1件目でトークンを使い切っておく
+			_ = service.Wait(context.Background(), kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED)
+
+			var mtx sync.Mutex
+			got := make([]int, 0)
+			var wg sync.WaitGroup
+			for i, priority := range test.priorities {
+				wg.Add(1)
+				go func(i int, priority kabuspb.RequestPriority) {
+					defer wg.Done()
+					_ = service.Wait(context.Background(), kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, priority)
+					mtx.Lock()
+					defer mtx.Unlock()
+					got = append(got, i)
+				}(i, priority)
+				time.Sleep(time.Millisecond) // 積まれる順番を固定する
+			}
+			wg.Wait()
+
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_throttleLane_next(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 9, 10, 10, 0, 0, 0, time.Local)
+	low := &throttleWaiter{level: 0, enqueuedAt: now.Add(-3 * time.Second)}
+	normal := &throttleWaiter{level: 1, enqueuedAt: now.Add(-1 * time.Second)}
+	high1 := &throttleWaiter{level: 2, enqueuedAt: now.Add(-500 * time.Millisecond)}
+	high2 := &throttleWaiter{level: 2, enqueuedAt: now}
+	tests := []struct {
+		name  string
+		aging time.Duration
+		queue []*throttleWaiter
+		want  *throttleWaiter
+	}{
+		{name: "待ち行列が空ならnil", aging: time.Second, queue: []*throttleWaiter{}, want: nil},
+		{name: "底上げがなければ優先度の高いものを返す", queue: []*throttleWaiter{low, normal, high2}, want: high2},
+		{name: "同じ優先度なら先に積まれたものを返す", queue: []*throttleWaiter{high1, high2}, want: high1},
+		{name: "待ち時間で底上げされた優先度が高いものを返す", aging: time.Second, queue: []*throttleWaiter{normal, high2, low}, want: low},
+		{name: "底上げ後に同じ優先度なら待ち行列の前にあるものを返す", aging: time.Second, queue: []*throttleWaiter{high2, normal}, want: high2},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			lane := &throttleLane{aging: test.aging, queue: test.queue}
+			got := lane.next(now)
+			if test.want != got {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_throttle_QueueDepth(t *testing.T) {
+	t.Parallel()
+	service := &throttle{lanes: map[kabuspb.ThrottleCategory]*throttleLane{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET: {interval: time.Second, burst: 1},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := 0; i < 4; i++ {
+		go func() {
+			_ = service.Wait(ctx, kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET, kabuspb.RequestPriority_REQUEST_PRIORITY_UNSPECIFIED)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	got1 := service.QueueDepth(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET)
+	got2 := service.QueueDepth(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER)
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	got3 := service.QueueDepth(kabuspb.ThrottleCategory_THROTTLE_CATEGORY_WALLET)
+
+	if got1 != 3 || got2 != 0 || got3 != 0 {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), 3, 0, 0, got1, got2, got3)
+	}
+}
+
+func Test_throttle_Status(t *testing.T) {
+	t.Parallel()
+	service := &throttle{lanes: map[kabuspb.ThrottleCategory]*throttleLane{
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO:  {interval: 100 * time.Millisecond, burst: 1, queue: []*throttleWaiter{{}, {}}},
+		kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER: {interval: 200 * time.Millisecond, burst: 1},
+	}}
+	got := service.Status()
+	want := &kabuspb.ThrottleStatus{Lanes: []*kabuspb.ThrottleLaneStatus{
+		{Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_ORDER, QueueDepth: 0, IntervalMs: 200},
+		{Category: kabuspb.ThrottleCategory_THROTTLE_CATEGORY_INFO, QueueDepth: 2, IntervalMs: 100},
+	}}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"gitlab.com/tsuchinaga/kabus-grpc-server/server/repositories"
@@ -19,6 +20,8 @@ type TokenService interface {
 	GetToken(ctx context.Context) (string, error)
 	GetExpiredAt() time.Time
 	Refresh(ctx context.Context) (string, error)
+	Do(ctx context.Context, f func(token string) error) error
+	Invalidate()
 }
 
 type token struct {
@@ -26,23 +29,27 @@ type token struct {
 	security   repositories.Security
 	clock      repositories.Clock
 	setting    repositories.Setting
+	flight     *tokenFlight
+	mtx        sync.Mutex
+}
+
+// tokenFlight - 実行中のkabusapiのトークン取得、取得を待っている全員で結果を共有する
+type tokenFlight struct {
+	done  chan struct{}
+	token string
+	err   error
 }
 
 func (s *token) GetToken(ctx context.Context) (string, error) {
-	now := s.clock.Now()
-	if s.tokenStore.IsExpired(now) {
-		token, err := s.security.Token(ctx, s.setting.Password())
-		if err != nil {
-			return "", err
-		}
-		expire := time.Date(now.Year(), now.Month(), now.Day(), 6, 30, 0, 0, now.Location())
-		if expire.Before(now) {
-			expire = expire.AddDate(0, 0, 1)
-		}
-		s.tokenStore.SetToken(token, expire)
+	s.mtx.Lock()
+	if s.flight == nil && !s.tokenStore.IsExpired(s.clock.Now()) {
+		defer s.mtx.Unlock()
+		return s.tokenStore.GetToken(), nil
 	}
+	flight := s.fetch()
+	s.mtx.Unlock()
 
-	return s.tokenStore.GetToken(), nil
+	return flight.wait(ctx)
 }
 
 func (s *token) GetExpiredAt() time.Time {
@@ -50,6 +57,116 @@ func (s *token) GetExpiredAt() time.Time {
 }
 
 func (s *token) Refresh(ctx context.Context) (string, error) {
+	return s.refresh(ctx, "")
+}
+
+// refresh - トークンを取り直す、staleを指定していて既に別のトークンに取り直されていればそのトークンを返す
+func (s *token) refresh(ctx context.Context, stale string) (string, error) {
+	s.mtx.Lock()
+	if s.flight == nil {
+		if stale == "" || s.tokenStore.IsExpired(s.clock.Now()) || s.tokenStore.GetToken() == stale {
+			s.tokenStore.Reset()
+		}
+		if !s.tokenStore.IsExpired(s.clock.Now()) {
+			defer s.mtx.Unlock()
+			return s.tokenStore.GetToken(), nil
+		}
+	}
+	flight := s.fetch()
+	s.mtx.Unlock()
+
+	return flight.wait(ctx)
+}
+
+// fetch - 実行中のトークン取得があればそれを返し、なければ新しく取得を始める (要ロック)
+func (s *token) fetch() *tokenFlight {
+	if s.flight != nil {
+		return s.flight
+	}
+
+	flight := &tokenFlight{done: make(chan struct{})}
+	s.flight = flight
+	go func() {
+		// 待っている誰かのctxが終了しても他の人が結果を使えるように、取得自体は最後まで行う
+		token, err := s.security.Token(context.Background(), s.setting.Password())
+
+		s.mtx.Lock()
+		if err == nil {
+			s.tokenStore.SetToken(token, s.nextExpiredAt(s.clock.Now()))
+			flight.token = s.tokenStore.GetToken()
+		}
+		flight.err = err
+		s.flight = nil
+		s.mtx.Unlock()
+
+		close(flight.done)
+	}()
+	return flight
+}
+
+// nextExpiredAt - 設定された毎日のリセット時刻のうち、now以降で最初の日時を返す、タイムゾーンの指定がなければnowのタイムゾーンで計算する
+func (s *token) nextExpiredAt(now time.Time) time.Time {
+	hour, minute, location := s.setting.TokenResetTime()
+	if location == nil {
+		location = now.Location()
+	}
+
+	t := now.In(location)
+	expire := time.Date(t.Year(), t.Month(), t.Day(), hour, minute, 0, 0, location)
+	if expire.Before(now) {
+		expire = expire.AddDate(0, 0, 1)
+	}
+	return expire
+}
+
+// Invalidate - 現在のトークンを無効にして、次に使うときにkabusapiから取り直させる
+func (s *token) Invalidate() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
 	s.tokenStore.Reset()
-	return s.GetToken(ctx)
+}
+
+// wait - トークン取得が終わるのを待つ、待っている間にctxが終了したらctxのエラーを返す
+func (f *tokenFlight) wait(ctx context.Context) (string, error) {
+	select {
+	case <-f.done:
+		return f.token, f.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Do - トークンを渡してfを実行し、再実行の対象のエラーならトークンを再発行して最大実行回数までfを再実行する
+func (s *token) Do(ctx context.Context, f func(token string) error) error {
+	token, err := s.GetToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxAttempts, codes := s.setting.TokenRetryPolicy()
+	for attempt := 1; ; attempt++ {
+		err = f(token)
+		if err == nil || attempt >= maxAttempts || !s.isRetryable(err, codes) {
+			return err
+		}
+
+		token, err = s.refresh(ctx, token)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// isRetryable - エラーがkabusapiのエラーで、エラーコードが再実行の対象に含まれているか
+func (s *token) isRetryable(err error, codes []int) bool {
+	code := s.security.ErrorCode(err)
+	if code == 0 {
+		return false
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -74,7 +75,7 @@ func Test_token_GetToken(t *testing.T) {
 			tokenStore := &testTokenStore{getToken: test.getToken, isExpired: test.isExpired}
 			security := &testSecurity{token1: test.token1, token2: test.token2}
 			clock := &testClock{now: test.now}
-			setting := &testSetting{}
+			setting := &testSetting{resetHour: 6, resetMinute: 30}
 			token := &token{tokenStore: tokenStore, security: security, clock: clock, setting: setting}
 			got, err := token.GetToken(context.Background())
 			if !reflect.DeepEqual(test.want, got) ||
@@ -149,3 +150,203 @@ func Test_token_Refresh(t *testing.T) {
 		})
 	}
 }
+
+func Test_token_Do(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		isExpired    bool
+		resetExpired bool
+		token2       error
+		errorCode    int
+		maxAttempts  int
+		retryCodes   []int
+		errs         []error
+		hasError     bool
+		wantTokens   []string
+		resetCount   int
+	}{
+		{name: "トークン取得に失敗したらfを実行せずにエラーを返す",
+			isExpired: true, token2: errors.New("token error message"), maxAttempts: 2, retryCodes: []int{4001009},
+			hasError: true, wantTokens: []string{}},
+		{name: "fが成功したら再実行しない",
+			maxAttempts: 2, retryCodes: []int{4001009},
+			errs:       []error{nil},
+			wantTokens: []string{"TOKEN_STRING"}},
+		{name: "kabusapiのエラーでなければ再実行しない",
+			errorCode: 0, maxAttempts: 2, retryCodes: []int{4001009},
+			errs:     []error{errors.New("error message")},
+			hasError: true, wantTokens: []string{"TOKEN_STRING"}},
+		{name: "再実行の対象ではないエラーコードなら再実行しない",
+			errorCode: 4001007, maxAttempts: 2, retryCodes: []int{4001009},
+			errs:     []error{errors.New("error message")},
+			hasError: true, wantTokens: []string{"TOKEN_STRING"}},
+		{name: "最大実行回数が1なら再実行しない",
+			errorCode: 4001009, maxAttempts: 1, retryCodes: []int{4001009},
+			errs:     []error{errors.New("error message")},
+			hasError: true, wantTokens: []string{"TOKEN_STRING"}},
+		{name: "再実行の対象のエラーコードならトークンを再発行して再実行する",
+			errorCode: 4001009, maxAttempts: 2, retryCodes: []int{4001009},
+			errs:       []error{errors.New("error message"), nil},
+			wantTokens: []string{"TOKEN_STRING", "TOKEN_STRING"}, resetCount: 1},
+		{name: "再実行しても再実行の対象のエラーなら最大実行回数まで再実行する",
+			errorCode: 4001009, maxAttempts: 3, retryCodes: []int{4001017, 4001009},
+			errs:     []error{errors.New("error message"), errors.New("error message"), errors.New("error message"), nil},
+			hasError: true, wantTokens: []string{"TOKEN_STRING", "TOKEN_STRING", "TOKEN_STRING"}, resetCount: 2},
+		{name: "トークンの再発行に失敗したら再実行せずにエラーを返す",
+			resetExpired: true, token2: errors.New("token error message"), errorCode: 4001009, maxAttempts: 2, retryCodes: []int{4001009},
+			errs:     []error{errors.New("error message")},
+			hasError: true, wantTokens: []string{"TOKEN_STRING"}, resetCount: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			tokenStore := &testTokenStore{getToken: "TOKEN_STRING", isExpired: test.isExpired, resetExpired: test.resetExpired}
+			security := &testSecurity{token2: test.token2, errorCode: test.errorCode}
+			setting := &testSetting{maxAttempts: test.maxAttempts, retryCodes: test.retryCodes}
+			service := &token{tokenStore: tokenStore, security: security, clock: &testClock{}, setting: setting}
+
+			gotTokens := make([]string, 0)
+			err := service.Do(context.Background(), func(token string) error {
+				gotTokens = append(gotTokens, token)
+				return test.errs[len(gotTokens)-1]
+			})
+			if (err != nil) != test.hasError || !reflect.DeepEqual(test.wantTokens, gotTokens) || !reflect.DeepEqual(test.resetCount, tokenStore.resetCount) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.hasError, test.wantTokens, test.resetCount, err, gotTokens, tokenStore.resetCount)
+			}
+		})
+	}
+}
+
+func Test_token_GetToken_singleFlight(t *testing.T) {
+	t.Parallel()
+	tokenStore := &testTokenStore{getToken: "NEW_TOKEN_STRING", isExpired: true}
+	security := &testSecurity{token1: "NEW_TOKEN_STRING", tokenWait: make(chan struct{})}
+	service := &token{tokenStore: tokenStore, security: security, clock: &testClock{}, setting: &testSetting{}}
+
+	var wg sync.WaitGroup
+	var mtx sync.Mutex
+	got := make([]string, 0)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, _ := service.GetToken(context.Background())
+			mtx.Lock()
+			defer mtx.Unlock()
+			got = append(got, token)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond) // 全員が取得待ちになるまで待つ
+	close(security.tokenWait)
+	wg.Wait()
+
+	want := []string{"NEW_TOKEN_STRING", "NEW_TOKEN_STRING", "NEW_TOKEN_STRING", "NEW_TOKEN_STRING", "NEW_TOKEN_STRING"}
+	if !reflect.DeepEqual(want, got) || security.tokenCnt != 1 {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, 1, got, security.tokenCnt)
+	}
+}
+
+func Test_token_GetToken_cancel(t *testing.T) {
+	t.Parallel()
+	tokenStore := &testTokenStore{getToken: "NEW_TOKEN_STRING", isExpired: true}
+	security := &testSecurity{token1: "NEW_TOKEN_STRING", tokenWait: make(chan struct{})}
+	service := &token{tokenStore: tokenStore, security: security, clock: &testClock{}, setting: &testSetting{}}
+	defer close(security.tokenWait)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	got, err := service.GetToken(ctx)
+	if got != "" || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), "", context.DeadlineExceeded, got, err)
+	}
+}
+
+func Test_token_refresh(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		stale          string
+		getToken       string
+		want           string
+		wantResetCount int
+		wantTokenCnt   int
+	}{
+		{name: "staleの指定がなければ取り直す", stale: "", getToken: "TOKEN_STRING", want: "NEW_TOKEN_STRING", wantResetCount: 1, wantTokenCnt: 1},
+		{name: "staleが現在のトークンなら取り直す", stale: "TOKEN_STRING", getToken: "TOKEN_STRING", want: "NEW_TOKEN_STRING", wantResetCount: 1, wantTokenCnt: 1},
+		{name: "既に別のトークンに取り直されていれば取り直さずにそのトークンを返す", stale: "OLD_TOKEN_STRING", getToken: "TOKEN_STRING", want: "TOKEN_STRING", wantResetCount: 0, wantTokenCnt: 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			tokenStore := &testTokenStore{getToken: test.getToken, resetExpired: true}
+			security := &testSecurity{token1: "NEW_TOKEN_STRING"}
+			service := &token{tokenStore: tokenStore, security: security, clock: &testClock{}, setting: &testSetting{}}
+			// SetTokenされたら新しいトークンを返すようにする
+			tokenStore.onSetToken = func(token string) { tokenStore.getToken = token }
+
+			got, err := service.refresh(context.Background(), test.stale)
+			if !reflect.DeepEqual(test.want, got) || err != nil || test.wantResetCount != tokenStore.resetCount || test.wantTokenCnt != security.tokenCnt {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(), test.want, test.wantResetCount, test.wantTokenCnt, got, err, tokenStore.resetCount, security.tokenCnt)
+			}
+		})
+	}
+}
+
+func Test_token_nextExpiredAt(t *testing.T) {
+	t.Parallel()
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name     string
+		now      time.Time
+		hour     int
+		minute   int
+		location *time.Location
+		want     time.Time
+	}{
+		{name: "リセット時刻前なら当日のリセット時刻",
+			now: time.Date(2021, 3, 25, 3, 0, 0, 0, time.UTC), hour: 6, minute: 30,
+			want: time.Date(2021, 3, 25, 6, 30, 0, 0, time.UTC)},
+		{name: "リセット時刻ちょうどなら当日のリセット時刻",
+			now: time.Date(2021, 3, 25, 6, 30, 0, 0, time.UTC), hour: 6, minute: 30,
+			want: time.Date(2021, 3, 25, 6, 30, 0, 0, time.UTC)},
+		{name: "リセット時刻を過ぎていたら翌日のリセット時刻",
+			now: time.Date(2021, 3, 25, 6, 31, 0, 0, time.UTC), hour: 6, minute: 30,
+			want: time.Date(2021, 3, 26, 6, 30, 0, 0, time.UTC)},
+		{name: "設定されたリセット時刻を使う",
+			now: time.Date(2021, 3, 25, 3, 0, 0, 0, time.UTC), hour: 5, minute: 0,
+			want: time.Date(2021, 3, 25, 5, 0, 0, 0, time.UTC)},
+		{name: "タイムゾーンの指定があればそのタイムゾーンで計算する",
+			now: time.Date(2021, 3, 25, 20, 0, 0, 0, time.UTC), hour: 6, minute: 30, location: jst,
+			want: time.Date(2021, 3, 26, 6, 30, 0, 0, jst)},
+		{name: "タイムゾーンの指定があって日付がずれていても、そのタイムゾーンの翌日で計算する",
+			now: time.Date(2021, 3, 25, 22, 0, 0, 0, time.UTC), hour: 6, minute: 30, location: jst,
+			want: time.Date(2021, 3, 27, 6, 30, 0, 0, jst)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			service := &token{setting: &testSetting{resetHour: test.hour, resetMinute: test.minute, location: test.location}}
+			got := service.nextExpiredAt(test.now)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_token_Invalidate(t *testing.T) {
+	t.Parallel()
+	tokenStore := &testTokenStore{getToken: "TOKEN_STRING"}
+	service := &token{tokenStore: tokenStore}
+	service.Invalidate()
+	if tokenStore.resetCount != 1 {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), 1, tokenStore.resetCount)
+	}
+}